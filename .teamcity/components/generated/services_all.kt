@@ -110,6 +110,7 @@ val services = mapOf(
     "ivschat" to ServiceSpec("IVS (Interactive Video) Chat"),
     "kafka" to ServiceSpec("Managed Streaming for Kafka", vpcLock = true),
     "kafkaconnect" to ServiceSpec("Managed Streaming for Kafka Connect"),
+    "kendraranking" to ServiceSpec("Kendra Intelligent Ranking"),
     "keyspaces" to ServiceSpec("Keyspaces (for Apache Cassandra)"),
     "kinesis" to ServiceSpec("Kinesis"),
     "kinesisvideo" to ServiceSpec("Kinesis Video"),
@@ -139,7 +140,9 @@ val services = mapOf(
     "opsworks" to ServiceSpec("OpsWorks", vpcLock = true),
     "organizations" to ServiceSpec("Organizations"),
     "outposts" to ServiceSpec("Outposts"),
+    "paymentcryptography" to ServiceSpec("Payment Cryptography Control Plane"),
     "pinpoint" to ServiceSpec("Pinpoint"),
+    "pinpointsmsvoicev2" to ServiceSpec("Pinpoint SMS and Voice V2"),
     "pipes" to ServiceSpec("EventBridge Pipes"),
     "pricing" to ServiceSpec("Pricing Calculator", regionOverride = "us-east-1"),
     "qldb" to ServiceSpec("QLDB (Quantum Ledger Database)"),
@@ -150,6 +153,7 @@ val services = mapOf(
     "redshift" to ServiceSpec("Redshift", vpcLock = true),
     "redshiftdata" to ServiceSpec("Redshift Data"),
     "redshiftserverless" to ServiceSpec("Redshift Serverless"),
+    "resiliencehub" to ServiceSpec("Resilience Hub"),
     "resourceexplorer2" to ServiceSpec("Resource Explorer"),
     "resourcegroups" to ServiceSpec("Resource Groups"),
     "resourcegroupstaggingapi" to ServiceSpec("Resource Groups Tagging"),
@@ -199,5 +203,6 @@ val services = mapOf(
     "wafv2" to ServiceSpec("WAF"),
     "worklink" to ServiceSpec("WorkLink"),
     "workspaces" to ServiceSpec("WorkSpaces", vpcLock = true),
+    "workspacesweb" to ServiceSpec("WorkSpaces Web"),
     "xray" to ServiceSpec("X-Ray"),
 )
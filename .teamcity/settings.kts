@@ -20,6 +20,7 @@ val acctestParallelism = DslContext.getParameter("acctest_parallelism", "")
 val tfAccAssumeRoleArn = DslContext.getParameter("tf_acc_assume_role_arn", "")
 val awsAlternateAccountID = DslContext.getParameter("aws_alt_account.account_id", "")
 val tfLog = DslContext.getParameter("tf_log", "")
+val tfAccExpensive = DslContext.getParameter("tf_acc_expensive", "")
 
 // Legacy User credentials
 val legacyAWSAccessKeyID = DslContext.getParameter("aws_account.legacy_access_key_id", "")
@@ -84,6 +85,10 @@ project {
             text("env.TF_ACC_ASSUME_ROLE_ARN", tfAccAssumeRoleArn)
         }
 
+        if (tfAccExpensive != "") {
+            text("env.TF_ACC_EXPENSIVE", tfAccExpensive)
+        }
+
         // Legacy User credentials
         if (legacyAWSAccessKeyID != "") {
             password("env.AWS_ACCESS_KEY_ID", legacyAWSAccessKeyID, display = ParameterDisplay.HIDDEN)
@@ -163,6 +163,7 @@ variable "service_labels" {
     "kafka",
     "kafkaconnect",
     "kendra",
+    "kendraranking",
     "keyspaces",
     "kinesis",
     "kinesisanalytics",
@@ -224,6 +225,7 @@ variable "service_labels" {
     "organizations",
     "outposts",
     "panorama",
+    "paymentcryptography",
     "personalize",
     "personalizeevents",
     "personalizeruntime",
@@ -231,6 +233,7 @@ variable "service_labels" {
     "pinpoint",
     "pinpointemail",
     "pinpointsmsvoice",
+    "pinpointsmsvoicev2",
     "pipes",
     "polly",
     "pricing",
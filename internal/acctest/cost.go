@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"math"
+	"os"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/costreport"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+// Cost is the estimated on-demand hourly price of a resource provisioned by an acceptance test.
+// Prices are approximate us-east-1 list prices and are only intended to rank tests by cost.
+type Cost struct {
+	Resource  string
+	HourlyUSD float64
+}
+
+// Estimated costs of commonly tested expensive resources.
+var (
+	CostDirectoryServiceDirectory = Cost{Resource: "Directory Service Microsoft AD directory", HourlyUSD: 0.40}
+	CostEKSCluster                = Cost{Resource: "EKS cluster", HourlyUSD: 0.10}
+	CostElastiCacheCluster        = Cost{Resource: "ElastiCache cluster (cache.t3.small)", HourlyUSD: 0.034}
+	CostMSKCluster                = Cost{Resource: "MSK cluster (3 x kafka.t3.small)", HourlyUSD: 0.137}
+	CostNATGateway                = Cost{Resource: "NAT gateway", HourlyUSD: 0.045}
+	CostNetworkFirewall           = Cost{Resource: "Network Firewall endpoint", HourlyUSD: 0.395}
+	CostOpenSearchDomain          = Cost{Resource: "OpenSearch domain (t3.small.search)", HourlyUSD: 0.036}
+	CostRedshiftCluster           = Cost{Resource: "Redshift cluster (dc2.large)", HourlyUSD: 0.25}
+	CostTransitGatewayAttachment  = Cost{Resource: "Transit gateway attachment", HourlyUSD: 0.05}
+)
+
+// Expensive marks an acceptance test as provisioning costly resources.
+// The test is skipped unless the TF_ACC_EXPENSIVE environment variable is set.
+// When the TF_ACC_COST_REPORT environment variable is set, the estimated cost of each
+// run (or skip) of the test is appended to the report file at that path.
+//
+// Expensive should be called at the start of the test, before resource.Test or resource.ParallelTest.
+func Expensive(t *testing.T, costs ...Cost) {
+	t.Helper()
+
+	entry := costreport.Entry{
+		Test: t.Name(),
+	}
+
+	for _, v := range costs {
+		entry.Resources = append(entry.Resources, v.Resource)
+		entry.HourlyUSD += v.HourlyUSD
+	}
+
+	sort.Strings(entry.Resources)
+
+	if os.Getenv(envvar.AccExpensive) == "" {
+		entry.Skipped = true
+		recordCost(t, entry)
+
+		t.Skipf("skipping expensive acceptance test (estimated $%.2f/hour); environment variable %s must be set", entry.HourlyUSD, envvar.AccExpensive)
+	}
+
+	t.Logf("running expensive acceptance test (estimated $%.2f/hour)", entry.HourlyUSD)
+
+	start := time.Now()
+
+	t.Cleanup(func() {
+		duration := time.Since(start)
+
+		entry.DurationSeconds = duration.Seconds()
+		entry.EstimatedUSD = estimatedCost(entry.HourlyUSD, duration)
+
+		recordCost(t, entry)
+	})
+}
+
+// estimatedCost returns the estimated cost of running resources with the specified hourly price for the specified duration.
+// Usage is conservatively billed per started hour.
+func estimatedCost(hourlyUSD float64, duration time.Duration) float64 {
+	return hourlyUSD * math.Max(1, math.Ceil(duration.Hours()))
+}
+
+func recordCost(t *testing.T, entry costreport.Entry) {
+	t.Helper()
+
+	path := os.Getenv(envvar.AccCostReport)
+
+	if path == "" {
+		return
+	}
+
+	if err := costreport.AppendEntry(path, entry); err != nil {
+		t.Logf("[WARN] recording estimated cost: %s", err)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/costreport"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+func TestExpensive(t *testing.T) {
+	report := filepath.Join(t.TempDir(), "cost-report.jsonl")
+	t.Setenv(envvar.AccCostReport, report)
+
+	t.Setenv(envvar.AccExpensive, "")
+	var skipped bool
+	t.Run("skipped", func(t *testing.T) {
+		defer func() { skipped = t.Skipped() }()
+
+		acctest.Expensive(t, acctest.CostNATGateway)
+	})
+
+	if !skipped {
+		t.Errorf("expected test to be skipped when %s is not set", envvar.AccExpensive)
+	}
+
+	t.Setenv(envvar.AccExpensive, "1")
+	var ran bool
+	t.Run("ran", func(t *testing.T) {
+		acctest.Expensive(t, acctest.CostNATGateway, acctest.CostRedshiftCluster)
+		ran = true
+	})
+
+	if !ran {
+		t.Errorf("expected test to run when %s is set", envvar.AccExpensive)
+	}
+
+	f, err := os.Open(report)
+	if err != nil {
+		t.Fatalf("opening cost report: %s", err)
+	}
+	defer f.Close()
+
+	entries, err := costreport.ReadEntries(f)
+	if err != nil {
+		t.Fatalf("reading cost report: %s", err)
+	}
+
+	if got, want := len(entries), 2; got != want {
+		t.Fatalf("cost report entries = %d, want %d", got, want)
+	}
+
+	if got, want := entries[0].Test, "TestExpensive/skipped"; got != want {
+		t.Errorf("entries[0].Test = %q, want %q", got, want)
+	}
+	if !entries[0].Skipped {
+		t.Errorf("entries[0].Skipped = false, want true")
+	}
+
+	if got, want := entries[1].Test, "TestExpensive/ran"; got != want {
+		t.Errorf("entries[1].Test = %q, want %q", got, want)
+	}
+	if entries[1].Skipped {
+		t.Errorf("entries[1].Skipped = true, want false")
+	}
+	if got, want := entries[1].HourlyUSD, acctest.CostNATGateway.HourlyUSD+acctest.CostRedshiftCluster.HourlyUSD; got != want {
+		t.Errorf("entries[1].HourlyUSD = %v, want %v", got, want)
+	}
+	if got, want := entries[1].EstimatedUSD, entries[1].HourlyUSD; got != want {
+		t.Errorf("entries[1].EstimatedUSD = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package costreport records and summarizes the estimated cost of acceptance tests
+// that provision expensive resources.
+package costreport
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+)
+
+// Entry is the estimated cost of a single run of an acceptance test.
+type Entry struct {
+	Test            string   `json:"test"`
+	Resources       []string `json:"resources"`
+	HourlyUSD       float64  `json:"hourly_usd"`
+	DurationSeconds float64  `json:"duration_seconds"`
+	EstimatedUSD    float64  `json:"estimated_usd"`
+	Skipped         bool     `json:"skipped,omitempty"`
+}
+
+var mu sync.Mutex
+
+// AppendEntry appends an entry as a single line of JSON to the report file at the specified path.
+// The file is created if it does not exist.
+func AppendEntry(path string, entry Entry) error {
+	b, err := json.Marshal(entry)
+
+	if err != nil {
+		return err
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintln(f, string(b)); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// ReadEntries reads all entries from a report.
+func ReadEntries(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" {
+			continue
+		}
+
+		var entry Entry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			return nil, fmt.Errorf("reading cost report entry %q: %w", line, err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// TestSummary is the aggregated estimated cost of all runs of an acceptance test.
+type TestSummary struct {
+	Test         string
+	Resources    []string
+	Runs         int
+	Skipped      int
+	HourlyUSD    float64
+	EstimatedUSD float64
+}
+
+// Summarize aggregates entries by test, ordered by descending estimated cost.
+func Summarize(entries []Entry) []TestSummary {
+	byTest := make(map[string]*TestSummary)
+
+	for _, entry := range entries {
+		summary, ok := byTest[entry.Test]
+
+		if !ok {
+			summary = &TestSummary{
+				Test:      entry.Test,
+				Resources: entry.Resources,
+				HourlyUSD: entry.HourlyUSD,
+			}
+			byTest[entry.Test] = summary
+		}
+
+		if entry.Skipped {
+			summary.Skipped++
+			continue
+		}
+
+		summary.Runs++
+		summary.EstimatedUSD += entry.EstimatedUSD
+	}
+
+	summaries := make([]TestSummary, 0, len(byTest))
+	for _, v := range byTest {
+		summaries = append(summaries, *v)
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].EstimatedUSD != summaries[j].EstimatedUSD {
+			return summaries[i].EstimatedUSD > summaries[j].EstimatedUSD
+		}
+
+		return summaries[i].Test < summaries[j].Test
+	})
+
+	return summaries
+}
+
+// WriteSummary writes a human-readable summary of entries, ordered by descending estimated cost.
+func WriteSummary(w io.Writer, entries []Entry) error {
+	summaries := Summarize(entries)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "TEST\tRUNS\tSKIPPED\tHOURLY (USD)\tESTIMATED (USD)\tRESOURCES")
+
+	var total float64
+	for _, v := range summaries {
+		total += v.EstimatedUSD
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%.2f\t%.2f\t%s\n", v.Test, v.Runs, v.Skipped, v.HourlyUSD, v.EstimatedUSD, strings.Join(v.Resources, ", "))
+	}
+
+	fmt.Fprintf(tw, "TOTAL\t\t\t\t%.2f\t\n", total)
+
+	return tw.Flush()
+}
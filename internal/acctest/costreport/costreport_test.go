@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package costreport_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/costreport"
+)
+
+func TestAppendEntry(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "cost-report.jsonl")
+	want := []costreport.Entry{
+		{Test: "TestAccA", Resources: []string{"NAT gateway"}, HourlyUSD: 0.045, DurationSeconds: 120, EstimatedUSD: 0.045},
+		{Test: "TestAccB", Resources: []string{"Redshift cluster (dc2.large)"}, HourlyUSD: 0.25, Skipped: true},
+	}
+
+	for _, v := range want {
+		if err := costreport.AppendEntry(path, v); err != nil {
+			t.Fatalf("appending entry: %s", err)
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("opening report: %s", err)
+	}
+	defer f.Close()
+
+	got, err := costreport.ReadEntries(f)
+	if err != nil {
+		t.Fatalf("reading entries: %s", err)
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	t.Parallel()
+
+	entries := []costreport.Entry{
+		{Test: "TestAccNATGateway", Resources: []string{"NAT gateway"}, HourlyUSD: 0.045, EstimatedUSD: 0.045},
+		{Test: "TestAccRedshiftCluster", Resources: []string{"Redshift cluster (dc2.large)"}, HourlyUSD: 0.25, EstimatedUSD: 0.5},
+		{Test: "TestAccNATGateway", Resources: []string{"NAT gateway"}, HourlyUSD: 0.045, EstimatedUSD: 0.045},
+		{Test: "TestAccEKSCluster", Resources: []string{"EKS cluster"}, HourlyUSD: 0.1, Skipped: true},
+	}
+
+	got := costreport.Summarize(entries)
+	want := []costreport.TestSummary{
+		{Test: "TestAccRedshiftCluster", Resources: []string{"Redshift cluster (dc2.large)"}, Runs: 1, HourlyUSD: 0.25, EstimatedUSD: 0.5},
+		{Test: "TestAccNATGateway", Resources: []string{"NAT gateway"}, Runs: 2, HourlyUSD: 0.045, EstimatedUSD: 0.09},
+		{Test: "TestAccEKSCluster", Resources: []string{"EKS cluster"}, Skipped: 1, HourlyUSD: 0.1},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	var buf bytes.Buffer
+	if err := costreport.WriteSummary(&buf, entries); err != nil {
+		t.Fatalf("writing summary: %s", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+
+	if got, want := len(lines), 5; got != want {
+		t.Fatalf("summary lines = %d, want %d:\n%s", got, want, buf.String())
+	}
+
+	if !strings.HasPrefix(lines[1], "TestAccRedshiftCluster") {
+		t.Errorf("first summary row = %q, want TestAccRedshiftCluster", lines[1])
+	}
+
+	if !strings.HasPrefix(lines[4], "TOTAL") || !strings.Contains(lines[4], "0.59") {
+		t.Errorf("total row = %q, want TOTAL 0.59", lines[4])
+	}
+}
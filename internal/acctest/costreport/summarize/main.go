@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Summarizes an acceptance test cost report written by tests annotated with acctest.Expensive.
+//
+//	go run internal/acctest/costreport/summarize/main.go -report cost-report.jsonl
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/costreport"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+func main() {
+	report := flag.String("report", os.Getenv(envvar.AccCostReport), "path of the cost report")
+	flag.Parse()
+
+	if *report == "" {
+		log.Fatal("the path of the cost report must be specified with -report or " + envvar.AccCostReport)
+	}
+
+	f, err := os.Open(*report)
+
+	if err != nil {
+		log.Fatalf("opening cost report: %s", err)
+	}
+
+	defer f.Close()
+
+	entries, err := costreport.ReadEntries(f)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := costreport.WriteSummary(os.Stdout, entries); err != nil {
+		log.Fatalf("writing cost report summary: %s", err)
+	}
+}
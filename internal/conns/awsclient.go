@@ -22,15 +22,18 @@ import (
 
 type AWSClient struct {
 	AccountID               string
+	CheckServiceQuotas      bool
 	DefaultTagsConfig       *tftags.DefaultConfig
 	DNSSuffix               string
 	IgnoreTagsConfig        *tftags.IgnoreConfig
 	MediaConvertAccountConn *mediaconvert_sdkv1.MediaConvert
 	Partition               string
 	Region                  string
+	RetryableErrorCodes     map[string][]string // Additional retryable AWS API error codes, by resource type name.
 	ReverseDNSPrefix        string
 	ServicePackages         map[string]ServicePackage
 	Session                 *session_sdkv1.Session
+	TagPolicyConfig         *tftags.PolicyConfig
 	TerraformVersion        string
 
 	awsConfig      *aws_sdkv2.Config
@@ -39,8 +42,12 @@ type AWSClient struct {
 	endpoints      map[string]string // From provider configuration.
 	httpClient     *http.Client
 	lock           sync.Mutex
-	s3UsePathStyle bool   // From provider configuration.
-	stsRegion      string // From provider configuration.
+	readAWSConfig  *aws_sdkv2.Config      // Credentials used for Read operations, if configured.
+	readClients    map[string]any         // AWS SDK v2 API clients used for Read operations.
+	readConns      map[string]any         // AWS SDK v1 API clients used for Read operations.
+	readSession    *session_sdkv1.Session // Credentials used for Read operations, if configured.
+	s3UsePathStyle bool                   // From provider configuration.
+	stsRegion      string                 // From provider configuration.
 }
 
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
@@ -61,7 +68,7 @@ func (client *AWSClient) S3ConnURICleaningDisabled(ctx context.Context) *s3_sdkv
 	config := client.S3Conn(ctx).Config
 	config.DisableRestProtocolURICleaning = aws_sdkv1.Bool(true)
 
-	return s3_sdkv1.New(client.Session.Copy(&config))
+	return s3_sdkv1.New(client.APISession(ctx).Copy(&config))
 }
 
 // SetHTTPClient sets the http.Client used for AWS API calls.
@@ -134,13 +141,35 @@ func (client *AWSClient) GlobalAcceleratorHostedZoneID() string {
 	return "Z2BJ6XQ5FK7U4H" // See https://docs.aws.amazon.com/general/latest/gr/global_accelerator.html#global_accelerator_region
 }
 
+// HasReadCredentials returns whether separate credentials are configured for Read operations.
+func (client *AWSClient) HasReadCredentials() bool {
+	return client.readAWSConfig != nil
+}
+
+// useReadCredentials returns whether AWS API clients obtained with the specified Context use the credentials configured for Read operations.
+func (client *AWSClient) useReadCredentials(ctx context.Context) bool {
+	return client.HasReadCredentials() && OperationFromContext(ctx) == OperationRead
+}
+
+// APISession returns the AWS SDK for Go v1 Session used for API calls made with the specified Context.
+// Use it instead of Session when creating API clients directly, e.g. for another Region.
+func (client *AWSClient) APISession(ctx context.Context) *session_sdkv1.Session {
+	if client.useReadCredentials(ctx) {
+		return client.readSession
+	}
+	return client.Session
+}
+
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
-func (client *AWSClient) apiClientConfig(servicePackageName string) map[string]any {
+func (client *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
 	m := map[string]any{
 		"aws_sdkv2_config": client.awsConfig,
 		"endpoint":         client.endpoints[servicePackageName],
 		"partition":        client.Partition,
-		"session":          client.Session,
+		"session":          client.APISession(ctx),
+	}
+	if client.useReadCredentials(ctx) {
+		m["aws_sdkv2_config"] = client.readAWSConfig
 	}
 	switch servicePackageName {
 	case names.S3:
@@ -153,11 +182,17 @@ func (client *AWSClient) apiClientConfig(servicePackageName string) map[string]a
 }
 
 // conn returns the AWS SDK for Go v1 API client for the specified service.
+// API clients are cached separately for each set of credentials.
 func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string) (T, error) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	if raw, ok := c.conns[servicePackageName]; ok {
+	conns := c.conns
+	if c.useReadCredentials(ctx) {
+		conns = c.readConns
+	}
+
+	if raw, ok := conns[servicePackageName]; ok {
 		if conn, ok := raw.(T); ok {
 			return conn, nil
 		} else {
@@ -180,7 +215,7 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string) (
 		return zero, fmt.Errorf("no AWS SDK v1 API client factory: %s", servicePackageName)
 	}
 
-	conn, err := v.NewConn(ctx, c.apiClientConfig(servicePackageName))
+	conn, err := v.NewConn(ctx, c.apiClientConfig(ctx, servicePackageName))
 	if err != nil {
 		var zero T
 		return zero, err
@@ -196,17 +231,23 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string) (
 		}
 	}
 
-	c.conns[servicePackageName] = conn
+	conns[servicePackageName] = conn
 
 	return conn, nil
 }
 
 // client returns the AWS SDK for Go v2 API client for the specified service.
+// API clients are cached separately for each set of credentials.
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string) (T, error) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	if raw, ok := c.clients[servicePackageName]; ok {
+	clients := c.clients
+	if c.useReadCredentials(ctx) {
+		clients = c.readClients
+	}
+
+	if raw, ok := clients[servicePackageName]; ok {
 		if client, ok := raw.(T); ok {
 			return client, nil
 		} else {
@@ -229,7 +270,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string)
 		return zero, fmt.Errorf("no AWS SDK v2 API client factory: %s", servicePackageName)
 	}
 
-	client, err := v.NewClient(ctx, c.apiClientConfig(servicePackageName))
+	client, err := v.NewClient(ctx, c.apiClientConfig(ctx, servicePackageName))
 	if err != nil {
 		var zero T
 		return zero, err
@@ -237,7 +278,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string)
 
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
-	c.clients[servicePackageName] = client
+	clients[servicePackageName] = client
 
 	return client, nil
 }
@@ -198,6 +198,7 @@ import (
 	ivs_sdkv1 "github.com/aws/aws-sdk-go/service/ivs"
 	kafka_sdkv1 "github.com/aws/aws-sdk-go/service/kafka"
 	kafkaconnect_sdkv1 "github.com/aws/aws-sdk-go/service/kafkaconnect"
+	kendraranking_sdkv1 "github.com/aws/aws-sdk-go/service/kendraranking"
 	kinesis_sdkv1 "github.com/aws/aws-sdk-go/service/kinesis"
 	kinesisanalytics_sdkv1 "github.com/aws/aws-sdk-go/service/kinesisanalytics"
 	kinesisanalyticsv2_sdkv1 "github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
@@ -253,6 +254,7 @@ import (
 	organizations_sdkv1 "github.com/aws/aws-sdk-go/service/organizations"
 	outposts_sdkv1 "github.com/aws/aws-sdk-go/service/outposts"
 	panorama_sdkv1 "github.com/aws/aws-sdk-go/service/panorama"
+	paymentcryptography_sdkv1 "github.com/aws/aws-sdk-go/service/paymentcryptography"
 	personalize_sdkv1 "github.com/aws/aws-sdk-go/service/personalize"
 	personalizeevents_sdkv1 "github.com/aws/aws-sdk-go/service/personalizeevents"
 	personalizeruntime_sdkv1 "github.com/aws/aws-sdk-go/service/personalizeruntime"
@@ -260,6 +262,7 @@ import (
 	pinpoint_sdkv1 "github.com/aws/aws-sdk-go/service/pinpoint"
 	pinpointemail_sdkv1 "github.com/aws/aws-sdk-go/service/pinpointemail"
 	pinpointsmsvoice_sdkv1 "github.com/aws/aws-sdk-go/service/pinpointsmsvoice"
+	pinpointsmsvoicev2_sdkv1 "github.com/aws/aws-sdk-go/service/pinpointsmsvoicev2"
 	polly_sdkv1 "github.com/aws/aws-sdk-go/service/polly"
 	prometheusservice_sdkv1 "github.com/aws/aws-sdk-go/service/prometheusservice"
 	proton_sdkv1 "github.com/aws/aws-sdk-go/service/proton"
@@ -985,6 +988,10 @@ func (c *AWSClient) KendraClient(ctx context.Context) *kendra_sdkv2.Client {
 	return errs.Must(client[*kendra_sdkv2.Client](ctx, c, names.Kendra))
 }
 
+func (c *AWSClient) KendraRankingConn(ctx context.Context) *kendraranking_sdkv1.KendraRanking {
+	return errs.Must(conn[*kendraranking_sdkv1.KendraRanking](ctx, c, names.KendraRanking))
+}
+
 func (c *AWSClient) KeyspacesClient(ctx context.Context) *keyspaces_sdkv2.Client {
 	return errs.Must(client[*keyspaces_sdkv2.Client](ctx, c, names.Keyspaces))
 }
@@ -1233,6 +1240,10 @@ func (c *AWSClient) PanoramaConn(ctx context.Context) *panorama_sdkv1.Panorama {
 	return errs.Must(conn[*panorama_sdkv1.Panorama](ctx, c, names.Panorama))
 }
 
+func (c *AWSClient) PaymentCryptographyConn(ctx context.Context) *paymentcryptography_sdkv1.PaymentCryptography {
+	return errs.Must(conn[*paymentcryptography_sdkv1.PaymentCryptography](ctx, c, names.PaymentCryptography))
+}
+
 func (c *AWSClient) PersonalizeConn(ctx context.Context) *personalize_sdkv1.Personalize {
 	return errs.Must(conn[*personalize_sdkv1.Personalize](ctx, c, names.Personalize))
 }
@@ -1257,6 +1268,10 @@ func (c *AWSClient) PinpointSMSVoiceConn(ctx context.Context) *pinpointsmsvoice_
 	return errs.Must(conn[*pinpointsmsvoice_sdkv1.PinpointSMSVoice](ctx, c, names.PinpointSMSVoice))
 }
 
+func (c *AWSClient) PinpointSMSVoiceV2Conn(ctx context.Context) *pinpointsmsvoicev2_sdkv1.PinpointSMSVoiceV2 {
+	return errs.Must(conn[*pinpointsmsvoicev2_sdkv1.PinpointSMSVoiceV2](ctx, c, names.PinpointSMSVoiceV2))
+}
+
 func (c *AWSClient) PipesClient(ctx context.Context) *pipes_sdkv2.Client {
 	return errs.Must(client[*pipes_sdkv2.Client](ctx, c, names.Pipes))
 }
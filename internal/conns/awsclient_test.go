@@ -4,7 +4,12 @@
 package conns
 
 import (
+	"context"
 	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-name
@@ -90,3 +95,63 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 		})
 	}
 }
+
+func TestAWSClientAPIClientConfigReadCredentials(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.Background()
+	readCtx := NewOperationContext(ctx, OperationRead)
+	writeCfg, readCfg := &aws_sdkv2.Config{}, &aws_sdkv2.Config{}
+	writeSess, readSess := &session_sdkv1.Session{}, &session_sdkv1.Session{}
+
+	testCases := []struct {
+		Name            string
+		AWSClient       *AWSClient
+		Context         context.Context
+		ExpectedConfig  *aws_sdkv2.Config
+		ExpectedSession *session_sdkv1.Session
+	}{
+		{
+			Name:            "no read credentials",
+			AWSClient:       &AWSClient{Session: writeSess, awsConfig: writeCfg},
+			Context:         readCtx,
+			ExpectedConfig:  writeCfg,
+			ExpectedSession: writeSess,
+		},
+		{
+			Name:            "read credentials write operation",
+			AWSClient:       &AWSClient{Session: writeSess, awsConfig: writeCfg, readAWSConfig: readCfg, readSession: readSess},
+			Context:         ctx,
+			ExpectedConfig:  writeCfg,
+			ExpectedSession: writeSess,
+		},
+		{
+			Name:            "read credentials read operation",
+			AWSClient:       &AWSClient{Session: writeSess, awsConfig: writeCfg, readAWSConfig: readCfg, readSession: readSess},
+			Context:         readCtx,
+			ExpectedConfig:  readCfg,
+			ExpectedSession: readSess,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			m := testCase.AWSClient.apiClientConfig(testCase.Context, names.EC2)
+
+			if got, want := m["aws_sdkv2_config"], testCase.ExpectedConfig; got != want {
+				t.Errorf("got AWS SDK v2 config %p, expected %p", got, want)
+			}
+
+			if got, want := m["session"], testCase.ExpectedSession; got != want {
+				t.Errorf("got AWS SDK v1 session %p, expected %p", got, want)
+			}
+
+			if got, want := testCase.AWSClient.APISession(testCase.Context), testCase.ExpectedSession; got != want {
+				t.Errorf("got AWS SDK v1 API session %p, expected %p", got, want)
+			}
+		})
+	}
+}
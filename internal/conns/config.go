@@ -23,6 +23,7 @@ type Config struct {
 	AllowedAccountIds              []string
 	AssumeRole                     *awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	CheckServiceQuotas             bool
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
@@ -35,7 +36,9 @@ type Config struct {
 	Insecure                       bool
 	MaxRetries                     int
 	Profile                        string
+	ReadAssumeRole                 *awsbase.AssumeRole
 	Region                         string
+	RetryableErrorCodes            map[string][]string
 	RetryMode                      aws_sdkv2.RetryMode
 	S3UsePathStyle                 bool
 	SecretKey                      string
@@ -46,6 +49,7 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
 	Token                          string
 	UseDualStackEndpoint           bool
@@ -123,6 +127,15 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diag.Errorf("creating AWS SDK v1 session: %s", err)
 	}
 
+	cfg.APIOptions = append(cfg.APIOptions, recordNotFoundErrorV2, retryableErrorCodesV2)
+	sess.Handlers.Complete.PushBack(recordNotFoundErrorV1)
+	sess.Handlers.Retry.PushBack(retryableErrorCodesV1)
+
+	if LifecycleLoggingEnabled() {
+		cfg.APIOptions = append(cfg.APIOptions, recordLifecycleAPICallV2)
+		sess.Handlers.Complete.PushBack(recordLifecycleAPICallV1)
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	if err != nil {
@@ -154,20 +167,65 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		}
 	}
 
+	if c.ReadAssumeRole != nil && c.ReadAssumeRole.RoleARN != "" {
+		readAwsbaseConfig := awsbaseConfig
+		readAwsbaseConfig.AssumeRole = c.ReadAssumeRole
+
+		tflog.Debug(ctx, "Configuring credentials for Read operations")
+		_, readCfg, err := awsbase.GetAwsConfig(ctx, &readAwsbaseConfig)
+		if err != nil {
+			return nil, diag.Errorf("configuring credentials for Read operations: %s", err)
+		}
+
+		readSess, err := awsbasev1.GetSession(ctx, &readCfg, &readAwsbaseConfig)
+		if err != nil {
+			return nil, diag.Errorf("creating AWS SDK v1 session for Read operations: %s", err)
+		}
+
+		readCfg.APIOptions = append(readCfg.APIOptions, recordNotFoundErrorV2, retryableErrorCodesV2)
+		readSess.Handlers.Complete.PushBack(recordNotFoundErrorV1)
+		readSess.Handlers.Retry.PushBack(retryableErrorCodesV1)
+
+		if LifecycleLoggingEnabled() {
+			readCfg.APIOptions = append(readCfg.APIOptions, recordLifecycleAPICallV2)
+			readSess.Handlers.Complete.PushBack(recordLifecycleAPICallV1)
+		}
+
+		// Reading with credentials for another account would make every resource appear to have been deleted.
+		if accountID != "" {
+			readAccountID, _, err := awsbase.GetAwsAccountIDAndPartition(ctx, readCfg, &readAwsbaseConfig)
+			if err != nil {
+				return nil, diag.Errorf("retrieving AWS account details for Read operations: %s", err)
+			}
+
+			if readAccountID != accountID {
+				return nil, diag.Errorf("AWS account ID for Read operations (%s) does not match AWS account ID (%s)", readAccountID, accountID)
+			}
+		}
+
+		client.readAWSConfig = &readCfg
+		client.readClients = make(map[string]any, 0)
+		client.readConns = make(map[string]any, 0)
+		client.readSession = readSess
+	}
+
 	DNSSuffix := "amazonaws.com"
 	if p, ok := endpoints_sdkv1.PartitionForRegion(endpoints_sdkv1.DefaultPartitions(), c.Region); ok {
 		DNSSuffix = p.DNSSuffix()
 	}
 
 	client.AccountID = accountID
+	client.CheckServiceQuotas = c.CheckServiceQuotas
 	client.DefaultTagsConfig = c.DefaultTagsConfig
 	client.DNSSuffix = DNSSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
 	client.Region = c.Region
+	client.RetryableErrorCodes = c.RetryableErrorCodes
 	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
 	client.SetHTTPClient(sess.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.Session = sess
+	client.TagPolicyConfig = c.TagPolicyConfig
 	client.TerraformVersion = c.TerraformVersion
 
 	// Used for lazy-loading AWS API clients.
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	awsmiddleware_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+)
+
+const (
+	// LifecycleLogEnvVar is the environment variable that, when set to "json",
+	// enables structured logging of resource lifecycle phases.
+	LifecycleLogEnvVar = "TF_LOG_PROVIDER_AWS"
+
+	lifecycleLogFormatJSON = "json"
+)
+
+// LifecycleLoggingEnabled returns whether structured lifecycle logging is enabled.
+func LifecycleLoggingEnabled() bool {
+	return strings.EqualFold(os.Getenv(LifecycleLogEnvVar), lifecycleLogFormatJSON)
+}
+
+// LifecycleAPICall represents a single AWS API call made during a CRUD operation.
+type LifecycleAPICall struct {
+	Service    string `json:"service"`
+	Operation  string `json:"operation"`
+	DurationMS int64  `json:"duration_ms"`
+	Retries    int    `json:"retries"`
+	Error      string `json:"error,omitempty"`
+}
+
+// LifecycleRecorder records the AWS API calls made during a single CRUD operation.
+type LifecycleRecorder struct {
+	start time.Time
+
+	mu    sync.Mutex
+	calls []LifecycleAPICall
+}
+
+type lifecycleContextKeyType int
+
+var lifecycleContextKey lifecycleContextKeyType
+
+// NewLifecycleContext returns a Context carrying a new LifecycleRecorder started at the specified time.
+func NewLifecycleContext(ctx context.Context, start time.Time) (context.Context, *LifecycleRecorder) {
+	r := &LifecycleRecorder{
+		start: start,
+	}
+
+	return context.WithValue(ctx, lifecycleContextKey, r), r
+}
+
+// LifecycleRecorderFromContext returns the LifecycleRecorder carried in Context, if any.
+func LifecycleRecorderFromContext(ctx context.Context) (*LifecycleRecorder, bool) {
+	r, ok := ctx.Value(lifecycleContextKey).(*LifecycleRecorder)
+	return r, ok
+}
+
+// Start returns the time that the CRUD operation started.
+func (r *LifecycleRecorder) Start() time.Time {
+	return r.start
+}
+
+// Calls returns the AWS API calls recorded so far.
+func (r *LifecycleRecorder) Calls() []LifecycleAPICall {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]LifecycleAPICall(nil), r.calls...)
+}
+
+// Retries returns the total number of retries across all recorded AWS API calls.
+func (r *LifecycleRecorder) Retries() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n := 0
+	for _, v := range r.calls {
+		n += v.Retries
+	}
+
+	return n
+}
+
+func (r *LifecycleRecorder) record(call LifecycleAPICall) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = append(r.calls, call)
+}
+
+// LifecycleLogEntry is a single structured lifecycle log entry.
+type LifecycleLogEntry struct {
+	Timestamp    string             `json:"@timestamp"`
+	Phase        string             `json:"phase"`
+	Operation    string             `json:"operation"`
+	ResourceType string             `json:"resource_type"`
+	ID           string             `json:"id,omitempty"`
+	DataSource   bool               `json:"data_source,omitempty"`
+	DurationMS   *int64             `json:"duration_ms,omitempty"`
+	Calls        []LifecycleAPICall `json:"aws_calls,omitempty"`
+	Retries      *int               `json:"retries,omitempty"`
+	Error        string             `json:"error,omitempty"`
+}
+
+const (
+	LifecyclePhaseStart = "start"
+	LifecyclePhaseEnd   = "end"
+)
+
+// WriteLifecycleLogEntry writes a lifecycle log entry as a single line of JSON.
+func WriteLifecycleLogEntry(w io.Writer, entry LifecycleLogEntry) error {
+	b, err := json.Marshal(entry)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(w, string(b))
+
+	return err
+}
+
+// recordLifecycleAPICallV1 is an AWS SDK for Go v1 Complete handler that records the API call in any LifecycleRecorder.
+func recordLifecycleAPICallV1(r *request_sdkv1.Request) {
+	recorder, ok := LifecycleRecorderFromContext(r.Context())
+
+	if !ok {
+		return
+	}
+
+	call := LifecycleAPICall{
+		Service:    r.ClientInfo.ServiceID,
+		Operation:  r.Operation.Name,
+		DurationMS: time.Since(r.Time).Milliseconds(),
+		Retries:    r.RetryCount,
+	}
+
+	if r.Error != nil {
+		call.Error = r.Error.Error()
+	}
+
+	recorder.record(call)
+}
+
+// recordLifecycleAPICallV2 adds AWS SDK for Go v2 middleware that records the API call in any LifecycleRecorder.
+func recordLifecycleAPICallV2(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TerraformLifecycleRecorder", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		start := time.Now()
+		out, metadata, err := next.HandleInitialize(ctx, in)
+
+		if recorder, ok := LifecycleRecorderFromContext(ctx); ok {
+			call := LifecycleAPICall{
+				Service:    awsmiddleware_sdkv2.GetServiceID(ctx),
+				Operation:  awsmiddleware_sdkv2.GetOperationName(ctx),
+				DurationMS: time.Since(start).Milliseconds(),
+			}
+
+			if v, ok := retry_sdkv2.GetAttemptResults(metadata); ok && len(v.Results) > 0 {
+				call.Retries = len(v.Results) - 1
+			}
+
+			if err != nil {
+				call.Error = err.Error()
+			}
+
+			recorder.record(call)
+		}
+
+		return out, metadata, err
+	}), middleware.After)
+}
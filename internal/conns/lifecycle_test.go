@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestRecordLifecycleAPICallV1(t *testing.T) {
+	t.Parallel()
+
+	ctx, recorder := NewLifecycleContext(context.Background(), time.Now())
+
+	newRequest := func(ctx context.Context, operation string, retries int, err error) *request_sdkv1.Request {
+		r := request_sdkv1.New(aws_sdkv1.Config{}, metadata.ClientInfo{ServiceID: "EC2"}, request_sdkv1.Handlers{}, nil, &request_sdkv1.Operation{Name: operation}, nil, nil)
+		r.SetContext(ctx)
+		r.RetryCount = retries
+		r.Error = err
+
+		return r
+	}
+
+	recordLifecycleAPICallV1(newRequest(ctx, "DescribeVpcs", 0, nil))
+	recordLifecycleAPICallV1(newRequest(ctx, "CreateVpc", 2, errors.New("throttled")))
+	// No recorder in Context.
+	recordLifecycleAPICallV1(newRequest(context.Background(), "DeleteVpc", 1, nil))
+
+	calls := recorder.Calls()
+
+	if got, want := len(calls), 2; got != want {
+		t.Fatalf("calls = %d, want %d", got, want)
+	}
+
+	if got, want := calls[1].Operation, "CreateVpc"; got != want {
+		t.Errorf("operation = %q, want %q", got, want)
+	}
+
+	if got, want := calls[1].Error, "throttled"; got != want {
+		t.Errorf("error = %q, want %q", got, want)
+	}
+
+	if got, want := recorder.Retries(), 2; got != want {
+		t.Errorf("retries = %d, want %d", got, want)
+	}
+}
+
+func TestWriteLifecycleLogEntry(t *testing.T) {
+	t.Parallel()
+
+	var sb strings.Builder
+	duration := int64(42)
+	entry := LifecycleLogEntry{
+		Timestamp:    "2023-07-01T00:00:00Z",
+		Phase:        LifecyclePhaseEnd,
+		Operation:    "create",
+		ResourceType: "aws_vpc",
+		ID:           "vpc-12345678",
+		DurationMS:   &duration,
+	}
+
+	if err := WriteLifecycleLogEntry(&sb, entry); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `{"@timestamp":"2023-07-01T00:00:00Z","phase":"end","operation":"create","resource_type":"aws_vpc","id":"vpc-12345678","duration_ms":42}` + "\n"
+
+	if got := sb.String(); got != want {
+		t.Errorf("entry = %s, want %s", got, want)
+	}
+}
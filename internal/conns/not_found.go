@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+
+	awsmiddleware_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// NotFoundRecorder records the AWS API operations that returned an error indicating that a remote object
+// does not exist during a single CRUD operation.
+type NotFoundRecorder struct {
+	mu         sync.Mutex
+	operations map[string]struct{}
+}
+
+type notFoundContextKeyType int
+
+var notFoundContextKey notFoundContextKeyType
+
+// NewNotFoundContext returns a Context carrying a new NotFoundRecorder.
+func NewNotFoundContext(ctx context.Context) (context.Context, *NotFoundRecorder) {
+	r := &NotFoundRecorder{}
+
+	return context.WithValue(ctx, notFoundContextKey, r), r
+}
+
+// NotFoundRecorderFromContext returns the NotFoundRecorder carried in Context, if any.
+func NotFoundRecorderFromContext(ctx context.Context) (*NotFoundRecorder, bool) {
+	r, ok := ctx.Value(notFoundContextKey).(*NotFoundRecorder)
+	return r, ok
+}
+
+// NotFound returns whether the specified AWS API operation, e.g. "DescribeChannel", has returned a not found error.
+func (r *NotFoundRecorder) NotFound(operation string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, ok := r.operations[operation]
+
+	return ok
+}
+
+// Record records a not found error returned by the specified AWS API operation.
+func (r *NotFoundRecorder) Record(operation string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.operations == nil {
+		r.operations = make(map[string]struct{})
+	}
+
+	r.operations[operation] = struct{}{}
+}
+
+// IsNotFoundErrorCode returns whether an AWS API error code indicates that a remote object does not exist,
+// e.g. "ResourceNotFoundException", "InvalidVpcID.NotFound" or "NoSuchEntity".
+func IsNotFoundErrorCode(code string) bool {
+	for _, suffix := range []string{"NotFound", "NotFoundException", "NotFoundFault"} {
+		if strings.HasSuffix(code, suffix) {
+			return true
+		}
+	}
+
+	return strings.HasPrefix(code, "NoSuch")
+}
+
+// recordNotFoundErrorV1 is an AWS SDK for Go v1 Complete handler that records the operation returning any not found error in any NotFoundRecorder.
+func recordNotFoundErrorV1(r *request_sdkv1.Request) {
+	recorder, ok := NotFoundRecorderFromContext(r.Context())
+
+	if !ok {
+		return
+	}
+
+	var awsErr awserr.Error
+	if r.Operation != nil && errors.As(r.Error, &awsErr) && IsNotFoundErrorCode(awsErr.Code()) {
+		recorder.Record(r.Operation.Name)
+	}
+}
+
+// recordNotFoundErrorV2 adds AWS SDK for Go v2 middleware that records the operation returning any not found error in any NotFoundRecorder.
+func recordNotFoundErrorV2(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TerraformNotFoundRecorder", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		out, metadata, err := next.HandleInitialize(ctx, in)
+
+		if recorder, ok := NotFoundRecorderFromContext(ctx); ok {
+			var apiErr smithy.APIError
+			if errors.As(err, &apiErr) && IsNotFoundErrorCode(apiErr.ErrorCode()) {
+				recorder.Record(awsmiddleware_sdkv2.GetOperationName(ctx))
+			}
+		}
+
+		return out, metadata, err
+	}), middleware.After)
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestIsNotFoundErrorCode(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"ResourceNotFoundException":   true,
+		"InvalidVpcID.NotFound":       true,
+		"NoSuchEntity":                true,
+		"NoSuchBucket":                true,
+		"CacheClusterNotFound":        true,
+		"DBInstanceNotFoundFault":     true,
+		"AccessDeniedException":       false,
+		"ThrottlingException":         false,
+		"InvalidParameterValue":       false,
+		"ResourceInUseException":      false,
+		"NotFoundButNotReallyAnError": false,
+	}
+
+	for code, want := range testCases {
+		if got := IsNotFoundErrorCode(code); got != want {
+			t.Errorf("IsNotFoundErrorCode(%q) = %t, want %t", code, got, want)
+		}
+	}
+}
+
+func TestRecordNotFoundErrorV1(t *testing.T) {
+	t.Parallel()
+
+	ctx, recorder := NewNotFoundContext(context.Background())
+
+	newRequest := func(ctx context.Context, operation string, err error) *request_sdkv1.Request {
+		r := request_sdkv1.New(aws_sdkv1.Config{}, metadata.ClientInfo{ServiceID: "SQS"}, request_sdkv1.Handlers{}, nil, &request_sdkv1.Operation{Name: operation}, nil, nil)
+		r.SetContext(ctx)
+		r.Error = err
+
+		return r
+	}
+
+	recordNotFoundErrorV1(newRequest(ctx, "GetQueueUrl", nil))
+	recordNotFoundErrorV1(newRequest(ctx, "ListQueueTags", awserr.New("AccessDenied", "denied", nil)))
+	recordNotFoundErrorV1(newRequest(ctx, "ListDeadLetterSourceQueues", errors.New("connection reset")))
+	recordNotFoundErrorV1(newRequest(ctx, "GetQueueAttributes", awserr.New("QueueDoesNotExist.NotFound", "gone", nil)))
+	// No recorder in Context.
+	recordNotFoundErrorV1(newRequest(context.Background(), "ReceiveMessage", awserr.New("ResourceNotFoundException", "gone", nil)))
+
+	testCases := map[string]bool{
+		"GetQueueAttributes":         true,
+		"GetQueueUrl":                false,
+		"ListQueueTags":              false,
+		"ListDeadLetterSourceQueues": false,
+		"ReceiveMessage":             false,
+	}
+
+	for operation, want := range testCases {
+		if got := recorder.NotFound(operation); got != want {
+			t.Errorf("NotFound(%q) = %t, want %t", operation, got, want)
+		}
+	}
+}
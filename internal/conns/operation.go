@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+)
+
+// Operation is the type of CRUD operation for which AWS API clients are used.
+type Operation int
+
+const (
+	// OperationWrite is any operation other than a Read. It is the default.
+	OperationWrite Operation = iota
+	// OperationRead is a resource or data source Read, e.g. during plan or refresh.
+	OperationRead
+)
+
+type operationContextKeyType int
+
+var operationContextKey operationContextKeyType
+
+// NewOperationContext returns a Context carrying the type of CRUD operation in progress.
+// AWS API clients obtained with the returned Context use the credentials configured for that operation type.
+func NewOperationContext(ctx context.Context, operation Operation) context.Context {
+	return context.WithValue(ctx, operationContextKey, operation)
+}
+
+// OperationFromContext returns the type of CRUD operation in progress.
+func OperationFromContext(ctx context.Context) Operation {
+	if v, ok := ctx.Value(operationContextKey).(Operation); ok {
+		return v
+	}
+
+	return OperationWrite
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"golang.org/x/exp/slices"
+)
+
+type retryableErrorCodesContextKeyType int
+
+var retryableErrorCodesContextKey retryableErrorCodesContextKeyType
+
+// NewRetryableErrorCodesContext returns a Context carrying additional AWS API error codes
+// that are retried for requests made with that Context.
+func NewRetryableErrorCodesContext(ctx context.Context, codes []string) context.Context {
+	if len(codes) == 0 {
+		return ctx
+	}
+
+	return context.WithValue(ctx, retryableErrorCodesContextKey, codes)
+}
+
+// RetryableErrorCodesFromContext returns the additional retryable AWS API error codes carried in Context, if any.
+func RetryableErrorCodesFromContext(ctx context.Context) []string {
+	v, _ := ctx.Value(retryableErrorCodesContextKey).([]string)
+	return v
+}
+
+// retryableErrorCodesV1 is an AWS SDK for Go v1 Retry handler that marks any request that failed
+// with one of the retryable error codes carried in Context as retryable.
+func retryableErrorCodesV1(r *request_sdkv1.Request) {
+	codes := RetryableErrorCodesFromContext(r.Context())
+
+	if len(codes) == 0 {
+		return
+	}
+
+	var awsErr awserr.Error
+	if errors.As(r.Error, &awsErr) && slices.Contains(codes, awsErr.Code()) {
+		r.Retryable = aws_sdkv1.Bool(true)
+	}
+}
+
+// retryableError wraps an error so that the AWS SDK for Go v2 standard retryer treats it as retryable.
+type retryableError struct {
+	error
+}
+
+func (retryableError) RetryableError() bool {
+	return true
+}
+
+func (e retryableError) Unwrap() error {
+	return e.error
+}
+
+// retryableErrorCodesV2 adds AWS SDK for Go v2 middleware that marks any request that failed
+// with one of the retryable error codes carried in Context as retryable.
+// The middleware runs after the retry middleware, i.e. within each attempt.
+func retryableErrorCodesV2(stack *middleware.Stack) error {
+	return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("TerraformRetryableErrorCodes", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		out, metadata, err := next.HandleFinalize(ctx, in)
+
+		if codes := RetryableErrorCodesFromContext(ctx); len(codes) > 0 {
+			var apiErr smithy.APIError
+			if errors.As(err, &apiErr) && slices.Contains(codes, apiErr.ErrorCode()) {
+				err = retryableError{err}
+			}
+		}
+
+		return out, metadata, err
+	}), middleware.After)
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+)
+
+func TestRetryableErrorCodesV1(t *testing.T) {
+	t.Parallel()
+
+	ctx := NewRetryableErrorCodesContext(context.Background(), []string{"ResourceConflictException"})
+
+	testCases := map[string]struct {
+		ctx  context.Context
+		err  error
+		want *bool
+	}{
+		"no error": {
+			ctx: ctx,
+		},
+		"retryable code": {
+			ctx:  ctx,
+			err:  awserr.New("ResourceConflictException", "in progress", nil),
+			want: aws_sdkv1.Bool(true),
+		},
+		"other code": {
+			ctx: ctx,
+			err: awserr.New("AccessDeniedException", "denied", nil),
+		},
+		"no codes in Context": {
+			ctx: context.Background(),
+			err: awserr.New("ResourceConflictException", "in progress", nil),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := request_sdkv1.New(aws_sdkv1.Config{}, metadata.ClientInfo{ServiceID: "Lambda"}, request_sdkv1.Handlers{}, nil, &request_sdkv1.Operation{Name: "UpdateFunctionConfiguration"}, nil, nil)
+			r.SetContext(testCase.ctx)
+			r.Error = testCase.err
+
+			retryableErrorCodesV1(r)
+
+			if got, want := aws_sdkv1.BoolValue(r.Retryable), aws_sdkv1.BoolValue(testCase.want); got != want || (r.Retryable == nil) != (testCase.want == nil) {
+				t.Errorf("Retryable = %v, want %v", r.Retryable, testCase.want)
+			}
+		})
+	}
+}
+
+func TestRetryableError(t *testing.T) {
+	t.Parallel()
+
+	apiErr := &smithy.GenericAPIError{Code: "ResourceConflictException", Message: "in progress"}
+	err := retryableError{apiErr}
+
+	if got := (retry.RetryableError{}).IsErrorRetryable(err); got.Bool() != true {
+		t.Errorf("IsErrorRetryable = %v, want true", got)
+	}
+
+	if !errors.Is(err, apiErr) {
+		t.Error("expected wrapped API error")
+	}
+}
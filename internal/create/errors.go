@@ -10,6 +10,7 @@ import (
 
 	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -67,16 +68,28 @@ func DiagError(service, action, resource, id string, gotError error) diag.Diagno
 }
 
 func newError(service, action, resource, id string, gotError error) diag.Diagnostic {
-	return diag.Diagnostic{
+	d := diag.Diagnostic{
 		Severity: diag.Error,
 		Summary:  ProblemStandardMessage(service, action, resource, id, gotError),
 	}
+
+	if gotError != nil {
+		d.Detail = errs.Guidance(gotError)
+	}
+
+	return d
 }
 
 func DiagErrorFramework(service, action, resource, id string, gotError error) fwdiag.Diagnostic {
+	detail := gotError.Error()
+
+	if guidance := errs.Guidance(gotError); guidance != "" {
+		detail = fmt.Sprintf("%s\n\n%s", detail, guidance)
+	}
+
 	return fwdiag.NewErrorDiagnostic(
 		ProblemStandardMessage(service, action, resource, id, nil),
-		gotError.Error(),
+		detail,
 	)
 }
 
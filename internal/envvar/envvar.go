@@ -66,6 +66,13 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	AccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For tests provisioning costly resources, such as NAT Gateways or Redshift Clusters
+	// Such tests are skipped unless this is set
+	AccExpensive = "TF_ACC_EXPENSIVE"
+
+	// For tests provisioning costly resources, path of a file to which per-test cost estimates are appended
+	AccCostReport = "TF_ACC_COST_REPORT"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -84,6 +91,13 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used for reporting on resource sweeper runs
+const (
+	// The CloudWatch namespace to which sweep run metrics are published
+	// Metrics are not published unless this is set
+	SweepMetricsNamespace = "TF_AWS_SWEEP_METRICS_NAMESPACE"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
+	"golang.org/x/exp/slices"
+)
+
+// Category classifies an AWS API error by its likely remedy.
+type Category int
+
+const (
+	CategoryUnknown Category = iota
+	CategoryThrottling
+	CategoryAccessDenied
+	CategoryValidation
+)
+
+func (c Category) String() string {
+	switch c {
+	case CategoryThrottling:
+		return "Throttling"
+	case CategoryAccessDenied:
+		return "Access denied"
+	case CategoryValidation:
+		return "Validation"
+	default:
+		return "Unknown"
+	}
+}
+
+var (
+	// Error codes returned when a request is throttled.
+	// Taken from the AWS SDKs' sets of throttling error codes.
+	throttlingErrorCodes = []string{
+		"BandwidthLimitExceeded",
+		"EC2ThrottledException",
+		"PriorRequestNotComplete",
+		"ProvisionedThroughputExceededException",
+		"RequestLimitExceeded",
+		"RequestThrottled",
+		"RequestThrottledException",
+		"SlowDown",
+		"ThrottledException",
+		"Throttling",
+		"ThrottlingException",
+		"TooManyRequestsException",
+		"TransactionInProgressException",
+	}
+
+	// Error codes returned when the caller is not permitted to perform an operation.
+	accessDeniedErrorCodes = []string{
+		errCodeAccessDenied,
+		"AccessDeniedException",
+		errCodeAuthorizationError,
+		"AuthorizationErrorException",
+		"Forbidden",
+		"ForbiddenException",
+		"UnauthorizedAccess",
+		"UnauthorizedException",
+		"UnauthorizedOperation",
+	}
+
+	// Error codes returned when a request's parameters are rejected.
+	validationErrorCodes = []string{
+		"InvalidInput",
+		"InvalidParameter",
+		"InvalidParameterCombination",
+		errCodeInvalidParameterException,
+		errCodeInvalidParameterValue,
+		"InvalidParameterValueException",
+		errCodeInvalidRequest,
+		"InvalidRequestException",
+		"MalformedPolicyDocument",
+		"MalformedPolicyDocumentException",
+		"MissingParameter",
+		errCodeValidationError,
+		errCodeValidationException,
+	}
+)
+
+// ErrorCode returns the AWS API error code of an AWS SDK for Go v1 or v2 error, or "" if there is none.
+func ErrorCode(err error) string {
+	if awsErr, ok := As[awserr.Error](err); ok {
+		return awsErr.Code()
+	}
+
+	if apiErr, ok := As[smithy.APIError](err); ok {
+		return apiErr.ErrorCode()
+	}
+
+	return ""
+}
+
+// Categorize returns the Category of an AWS API error.
+func Categorize(err error) Category {
+	if err == nil {
+		return CategoryUnknown
+	}
+
+	// Some services report a missing iam:PassRole permission with a validation error code.
+	if isMissingPassRole(err) {
+		return CategoryAccessDenied
+	}
+
+	code := ErrorCode(err)
+
+	switch {
+	case code == "":
+		return CategoryUnknown
+	case slices.Contains(throttlingErrorCodes, code):
+		return CategoryThrottling
+	case slices.Contains(accessDeniedErrorCodes, code):
+		return CategoryAccessDenied
+	case slices.Contains(validationErrorCodes, code):
+		return CategoryValidation
+	}
+
+	return CategoryUnknown
+}
+
+// Guidance returns an actionable description of how to remedy an AWS API error, or "" if there is none.
+func Guidance(err error) string {
+	var guidance string
+	category := Categorize(err)
+
+	switch category {
+	case CategoryThrottling:
+		guidance = "The AWS API request was throttled and retries were exhausted. " +
+			"Increase the provider's `max_retries` argument, set `retry_mode` to `adaptive`, or reduce Terraform's `-parallelism`."
+	case CategoryAccessDenied:
+		if isMissingPassRole(err) {
+			guidance = "The IAM principal used by the provider is missing the `iam:PassRole` permission for the IAM role passed to the service."
+		} else {
+			guidance = "The IAM principal used by the provider is not allowed to perform this action. " +
+				"Check its IAM policies and any permissions boundaries, service control policies and resource-based policies that apply."
+		}
+	case CategoryValidation:
+		guidance = "AWS rejected the request as invalid. Check the resource's arguments against the service's documented constraints."
+	default:
+		return ""
+	}
+
+	return category.String() + " error: " + guidance
+}
+
+func isMissingPassRole(err error) bool {
+	return strings.Contains(err.Error(), "iam:PassRole")
+}
+
+// lastError returns the last error in a list of values, if any.
+func lastError(a []any) error {
+	for i := len(a) - 1; i >= 0; i-- {
+		if err, ok := a[i].(error); ok && err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GuidanceFromArgs returns the Guidance for the last error in a list of format arguments, or "" if there is none.
+func GuidanceFromArgs(a []any) string {
+	if err := lastError(a); err != nil {
+		return Guidance(err)
+	}
+
+	return ""
+}
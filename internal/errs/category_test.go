@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func TestCategorize(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err  error
+		want errs.Category
+	}{
+		"nil": {
+			want: errs.CategoryUnknown,
+		},
+		"not an AWS error": {
+			err:  errors.New("connection reset"),
+			want: errs.CategoryUnknown,
+		},
+		"v1 throttling": {
+			err:  awserr.New("ThrottlingException", "Rate exceeded", nil),
+			want: errs.CategoryThrottling,
+		},
+		"v2 throttling": {
+			err:  &smithy.GenericAPIError{Code: "TooManyRequestsException", Message: "Rate exceeded"},
+			want: errs.CategoryThrottling,
+		},
+		"wrapped throttling": {
+			err:  fmt.Errorf("creating thing: %w", awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil)),
+			want: errs.CategoryThrottling,
+		},
+		"v1 access denied": {
+			err:  awserr.New("AccessDenied", "User is not authorized", nil),
+			want: errs.CategoryAccessDenied,
+		},
+		"v2 access denied": {
+			err:  &smithy.GenericAPIError{Code: "AccessDeniedException", Message: "User is not authorized"},
+			want: errs.CategoryAccessDenied,
+		},
+		"missing iam:PassRole with validation code": {
+			err:  awserr.New("InvalidParameterValueException", "User is not authorized to perform: iam:PassRole on resource", nil),
+			want: errs.CategoryAccessDenied,
+		},
+		"validation": {
+			err:  awserr.New("ValidationException", "1 validation error detected", nil),
+			want: errs.CategoryValidation,
+		},
+		"other code": {
+			err:  awserr.New("ResourceInUseException", "in use", nil),
+			want: errs.CategoryUnknown,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := errs.Categorize(testCase.err), testCase.want; got != want {
+				t.Errorf("Categorize = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestGuidance(t *testing.T) {
+	t.Parallel()
+
+	if got := errs.Guidance(awserr.New("ResourceInUseException", "in use", nil)); got != "" {
+		t.Errorf("unexpected guidance: %s", got)
+	}
+
+	if got := errs.Guidance(awserr.New("ThrottlingException", "Rate exceeded", nil)); !strings.Contains(got, "max_retries") {
+		t.Errorf("unexpected throttling guidance: %s", got)
+	}
+
+	if got := errs.Guidance(awserr.New("AccessDeniedException", "not authorized to perform: iam:PassRole", nil)); !strings.Contains(got, "iam:PassRole") {
+		t.Errorf("unexpected access denied guidance: %s", got)
+	}
+
+	if got := errs.GuidanceFromArgs([]any{"my-function", awserr.New("ValidationException", "bad", nil)}); !strings.HasPrefix(got, "Validation error") {
+		t.Errorf("unexpected validation guidance: %s", got)
+	}
+}
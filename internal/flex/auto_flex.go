@@ -0,0 +1,658 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ExpandTFMap and FlattenTFMap map between Terraform Plugin SDK configuration blocks (the map[string]interface{}
+// values returned by schema.ResourceData.Get for blocks) and AWS API structures. The block is described by a
+// Terraform-side model struct, declared alongside the resource, so that the mapping doesn't depend on the
+// generated AWS SDK types.
+//
+// Each exported field of the model struct corresponds to the API structure field with the same name, and to the
+// block attribute named by the field's `tfsdk` struct tag or, if the tag doesn't name one, the snake_case form of
+// the field name:
+//
+//	type metricModel struct {
+//		MetricName string   `tfsdk:"metric_name"`
+//		Namespace  string   `tfsdk:",omitempty"`          // Attribute "namespace".
+//		Dimensions []string `tfsdk:"dimension,omitempty"` // Attribute name differs from the field name.
+//		Internal   string   `tfsdk:"-"`                   // Skipped.
+//	}
+//
+// Model fields hold plain Go values: strings, bools, integers and floating point numbers, slices and maps of those,
+// nested model structs for single-element (MaxItems: 1) list blocks and slices of nested model structs for
+// list or set blocks. The corresponding API structure field may be a value or a pointer and may use a different
+// string, integer or floating point type, e.g. an enum type.
+//
+// The `omitempty` option works as follows:
+//   - On expand, a zero-valued or empty model field leaves the API structure field unset.
+//   - On flatten, a zero-valued or empty model field is not added to the block.
+//
+// Without `omitempty`, zero values are expanded as-is and nil API structure fields flatten to zero values.
+
+const (
+	autoFlexTagKey    = "tfsdk"
+	autoFlexOmitEmpty = "omitempty"
+)
+
+// ExpandTFMap expands tfMap, a configuration block described by the model struct M, into apiObject,
+// which must be a pointer to an API structure.
+func ExpandTFMap[M any](tfMap map[string]interface{}, apiObject any) error {
+	var model M
+	valModel := reflect.ValueOf(&model).Elem()
+
+	if valModel.Kind() != reflect.Struct {
+		return fmt.Errorf("ExpandTFMap[%T]: model must be a struct", model)
+	}
+
+	valTo := reflect.ValueOf(apiObject)
+
+	if valTo.Kind() != reflect.Ptr || valTo.IsNil() || valTo.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("ExpandTFMap[%T]: target (%T) must be a non-nil pointer to a struct", model, apiObject)
+	}
+
+	if err := decodeModel(tfMap, valModel); err != nil {
+		return fmt.Errorf("ExpandTFMap[%T]: %w", model, err)
+	}
+
+	if err := expandModel(valModel, valTo.Elem()); err != nil {
+		return fmt.Errorf("ExpandTFMap[%T]: %w", model, err)
+	}
+
+	return nil
+}
+
+// FlattenTFMap flattens apiObject, an API structure or pointer to an API structure, into a configuration block
+// described by the model struct M. nil is returned for a nil pointer.
+func FlattenTFMap[M any](apiObject any) (map[string]interface{}, error) {
+	var model M
+	valModel := reflect.ValueOf(&model).Elem()
+
+	if valModel.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("FlattenTFMap[%T]: model must be a struct", model)
+	}
+
+	valFrom := reflect.ValueOf(apiObject)
+
+	if valFrom.Kind() == reflect.Ptr {
+		if valFrom.IsNil() {
+			return nil, nil
+		}
+
+		valFrom = valFrom.Elem()
+	}
+
+	if valFrom.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("FlattenTFMap[%T]: source (%T) must be a struct", model, apiObject)
+	}
+
+	if err := flattenModel(valFrom, valModel); err != nil {
+		return nil, fmt.Errorf("FlattenTFMap[%T]: %w", model, err)
+	}
+
+	return encodeModel(valModel), nil
+}
+
+type modelField struct {
+	name          string // Also the API structure field name.
+	attributeName string
+	omitEmpty     bool
+	index         int
+}
+
+// modelFields returns the exported fields of the specified model struct type.
+func modelFields(typ reflect.Type) []modelField {
+	var fields []modelField
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		if !field.IsExported() {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(field.Tag.Get(autoFlexTagKey), ",")
+
+		if name == "-" {
+			continue
+		}
+
+		if name == "" {
+			name = snakeCase(field.Name)
+		}
+
+		fields = append(fields, modelField{
+			name:          field.Name,
+			attributeName: name,
+			omitEmpty:     opts == autoFlexOmitEmpty,
+			index:         i,
+		})
+	}
+
+	return fields
+}
+
+// decodeModel sets the fields of a model struct from the values in a configuration block.
+func decodeModel(tfMap map[string]interface{}, valModel reflect.Value) error {
+	for _, field := range modelFields(valModel.Type()) {
+		v, ok := tfMap[field.attributeName]
+
+		if !ok || v == nil {
+			continue
+		}
+
+		if err := decodeValue(v, valModel.Field(field.index)); err != nil {
+			return fmt.Errorf("%s: %w", field.attributeName, err)
+		}
+	}
+
+	return nil
+}
+
+func decodeValue(v interface{}, val reflect.Value) error {
+	typ := val.Type()
+
+	switch typ.Kind() {
+	case reflect.Struct:
+		tfList, err := listValue(v)
+
+		if err != nil {
+			return err
+		}
+
+		if len(tfList) == 0 || tfList[0] == nil {
+			return nil
+		}
+
+		tfMap, ok := tfList[0].(map[string]interface{})
+
+		if !ok {
+			return fmt.Errorf("unexpected type %T for %s, want map", tfList[0], typ)
+		}
+
+		return decodeModel(tfMap, val)
+
+	case reflect.Slice:
+		tfList, err := listValue(v)
+
+		if err != nil {
+			return err
+		}
+
+		slice := reflect.MakeSlice(typ, 0, len(tfList))
+
+		for _, tfElem := range tfList {
+			if tfElem == nil {
+				continue
+			}
+
+			elem := reflect.New(typ.Elem()).Elem()
+
+			// Elements of slices of structs are decoded from maps, not from single-element lists.
+			if elem.Kind() == reflect.Struct {
+				tfMap, ok := tfElem.(map[string]interface{})
+
+				if !ok {
+					return fmt.Errorf("unexpected type %T for %s, want map", tfElem, typ.Elem())
+				}
+
+				if err := decodeModel(tfMap, elem); err != nil {
+					return err
+				}
+			} else if err := decodeScalar(tfElem, elem); err != nil {
+				return err
+			}
+
+			slice = reflect.Append(slice, elem)
+		}
+
+		val.Set(slice)
+
+		return nil
+
+	case reflect.Map:
+		if typ.Key().Kind() != reflect.String {
+			return fmt.Errorf("unsupported map key type %s", typ.Key())
+		}
+
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok {
+			return fmt.Errorf("unexpected type %T for %s, want map", v, typ)
+		}
+
+		m := reflect.MakeMapWithSize(typ, len(tfMap))
+
+		for key, tfElem := range tfMap {
+			elem := reflect.New(typ.Elem()).Elem()
+
+			if err := decodeScalar(tfElem, elem); err != nil {
+				return err
+			}
+
+			m.SetMapIndex(reflect.ValueOf(key).Convert(typ.Key()), elem)
+		}
+
+		val.Set(m)
+
+		return nil
+	}
+
+	return decodeScalar(v, val)
+}
+
+func decodeScalar(v interface{}, val reflect.Value) error {
+	typ := val.Type()
+
+	switch typ.Kind() {
+	case reflect.String:
+		if v, ok := v.(string); ok {
+			val.SetString(v)
+			return nil
+		}
+
+	case reflect.Bool:
+		if v, ok := v.(bool); ok {
+			val.SetBool(v)
+			return nil
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+
+		switch v := v.(type) {
+		case int:
+			i = int64(v)
+		case int32:
+			i = int64(v)
+		case int64:
+			i = v
+		default:
+			return fmt.Errorf("unexpected type %T for %s", v, typ)
+		}
+
+		if val.OverflowInt(i) {
+			return fmt.Errorf("value %d overflows %s", i, typ)
+		}
+
+		val.SetInt(i)
+
+		return nil
+
+	case reflect.Float32, reflect.Float64:
+		var f float64
+
+		switch v := v.(type) {
+		case float32:
+			f = float64(v)
+		case float64:
+			f = v
+		case int:
+			f = float64(v)
+		default:
+			return fmt.Errorf("unexpected type %T for %s", v, typ)
+		}
+
+		val.SetFloat(f)
+
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported model type %s", typ)
+	}
+
+	return fmt.Errorf("unexpected type %T for %s", v, typ)
+}
+
+// listValue returns the elements of a list or set attribute value.
+func listValue(v interface{}) ([]interface{}, error) {
+	switch v := v.(type) {
+	case []interface{}:
+		return v, nil
+	case *schema.Set:
+		return v.List(), nil
+	}
+
+	return nil, fmt.Errorf("unexpected type %T, want list or set", v)
+}
+
+// expandModel sets the fields of an API structure from the fields of the same name in a model struct.
+func expandModel(valModel, valTo reflect.Value) error {
+	for _, field := range modelFields(valModel.Type()) {
+		fieldTo := valTo.FieldByName(field.name)
+
+		if !fieldTo.IsValid() {
+			return fmt.Errorf("%s: no field %s in %s", field.attributeName, field.name, valTo.Type())
+		}
+
+		fieldFrom := valModel.Field(field.index)
+
+		if field.omitEmpty && isEmpty(fieldFrom) {
+			continue
+		}
+
+		if err := expandValue(fieldFrom, fieldTo); err != nil {
+			return fmt.Errorf("%s: %w", field.attributeName, err)
+		}
+	}
+
+	return nil
+}
+
+func expandValue(valFrom, valTo reflect.Value) error {
+	typTo := valTo.Type()
+
+	switch typTo.Kind() {
+	case reflect.Ptr:
+		elem := reflect.New(typTo.Elem())
+
+		if err := expandValue(valFrom, elem.Elem()); err != nil {
+			return err
+		}
+
+		valTo.Set(elem)
+
+		return nil
+
+	case reflect.Struct:
+		if valFrom.Kind() != reflect.Struct {
+			return fmt.Errorf("incompatible types %s and %s", valFrom.Type(), typTo)
+		}
+
+		return expandModel(valFrom, valTo)
+
+	case reflect.Slice:
+		if valFrom.Kind() != reflect.Slice {
+			return fmt.Errorf("incompatible types %s and %s", valFrom.Type(), typTo)
+		}
+
+		if valFrom.IsNil() {
+			return nil
+		}
+
+		slice := reflect.MakeSlice(typTo, valFrom.Len(), valFrom.Len())
+
+		for i := 0; i < valFrom.Len(); i++ {
+			if err := expandValue(valFrom.Index(i), slice.Index(i)); err != nil {
+				return err
+			}
+		}
+
+		valTo.Set(slice)
+
+		return nil
+
+	case reflect.Map:
+		if valFrom.Kind() != reflect.Map || typTo.Key().Kind() != reflect.String {
+			return fmt.Errorf("incompatible types %s and %s", valFrom.Type(), typTo)
+		}
+
+		if valFrom.IsNil() {
+			return nil
+		}
+
+		m := reflect.MakeMapWithSize(typTo, valFrom.Len())
+		iter := valFrom.MapRange()
+
+		for iter.Next() {
+			elem := reflect.New(typTo.Elem()).Elem()
+
+			if err := expandValue(iter.Value(), elem); err != nil {
+				return err
+			}
+
+			m.SetMapIndex(iter.Key().Convert(typTo.Key()), elem)
+		}
+
+		valTo.Set(m)
+
+		return nil
+	}
+
+	return convertScalar(valFrom, valTo)
+}
+
+// flattenModel sets the fields of a model struct from the fields of the same name in an API structure.
+func flattenModel(valFrom, valModel reflect.Value) error {
+	for _, field := range modelFields(valModel.Type()) {
+		fieldFrom := valFrom.FieldByName(field.name)
+
+		if !fieldFrom.IsValid() {
+			return fmt.Errorf("%s: no field %s in %s", field.attributeName, field.name, valFrom.Type())
+		}
+
+		if err := flattenValue(fieldFrom, valModel.Field(field.index)); err != nil {
+			return fmt.Errorf("%s: %w", field.attributeName, err)
+		}
+	}
+
+	return nil
+}
+
+func flattenValue(valFrom, valTo reflect.Value) error {
+	if kind := valFrom.Kind(); kind == reflect.Ptr || kind == reflect.Interface {
+		// nil API values leave the zero value in the model.
+		if valFrom.IsNil() {
+			return nil
+		}
+
+		return flattenValue(valFrom.Elem(), valTo)
+	}
+
+	typTo := valTo.Type()
+
+	switch typTo.Kind() {
+	case reflect.Struct:
+		if valFrom.Kind() != reflect.Struct {
+			return fmt.Errorf("incompatible types %s and %s", valFrom.Type(), typTo)
+		}
+
+		return flattenModel(valFrom, valTo)
+
+	case reflect.Slice:
+		if valFrom.Kind() != reflect.Slice {
+			return fmt.Errorf("incompatible types %s and %s", valFrom.Type(), typTo)
+		}
+
+		if valFrom.IsNil() {
+			return nil
+		}
+
+		slice := reflect.MakeSlice(typTo, 0, valFrom.Len())
+
+		for i := 0; i < valFrom.Len(); i++ {
+			elemFrom := valFrom.Index(i)
+
+			if kind := elemFrom.Kind(); (kind == reflect.Ptr || kind == reflect.Interface) && elemFrom.IsNil() {
+				continue
+			}
+
+			elem := reflect.New(typTo.Elem()).Elem()
+
+			if err := flattenValue(elemFrom, elem); err != nil {
+				return err
+			}
+
+			slice = reflect.Append(slice, elem)
+		}
+
+		valTo.Set(slice)
+
+		return nil
+
+	case reflect.Map:
+		if valFrom.Kind() != reflect.Map || valFrom.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf("incompatible types %s and %s", valFrom.Type(), typTo)
+		}
+
+		if valFrom.IsNil() {
+			return nil
+		}
+
+		m := reflect.MakeMapWithSize(typTo, valFrom.Len())
+		iter := valFrom.MapRange()
+
+		for iter.Next() {
+			if kind := iter.Value().Kind(); (kind == reflect.Ptr || kind == reflect.Interface) && iter.Value().IsNil() {
+				continue
+			}
+
+			elem := reflect.New(typTo.Elem()).Elem()
+
+			if err := flattenValue(iter.Value(), elem); err != nil {
+				return err
+			}
+
+			m.SetMapIndex(iter.Key().Convert(typTo.Key()), elem)
+		}
+
+		valTo.Set(m)
+
+		return nil
+	}
+
+	return convertScalar(valFrom, valTo)
+}
+
+// convertScalar sets valTo to valFrom converted to valTo's type.
+// Only conversions between types of the same kind family (strings, bools, integers or floating point numbers) are allowed.
+func convertScalar(valFrom, valTo reflect.Value) error {
+	if family(valFrom.Kind()) == "" || family(valFrom.Kind()) != family(valTo.Kind()) {
+		return fmt.Errorf("incompatible types %s and %s", valFrom.Type(), valTo.Type())
+	}
+
+	switch valTo.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if valTo.OverflowInt(valFrom.Int()) {
+			return fmt.Errorf("value %d overflows %s", valFrom.Int(), valTo.Type())
+		}
+	}
+
+	valTo.Set(valFrom.Convert(valTo.Type()))
+
+	return nil
+}
+
+func family(kind reflect.Kind) string {
+	switch kind {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "bool"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "int"
+	case reflect.Float32, reflect.Float64:
+		return "float"
+	}
+
+	return ""
+}
+
+// encodeModel returns the fields of a model struct as a configuration block.
+func encodeModel(valModel reflect.Value) map[string]interface{} {
+	tfMap := make(map[string]interface{})
+
+	for _, field := range modelFields(valModel.Type()) {
+		val := valModel.Field(field.index)
+
+		if field.omitEmpty && isEmpty(val) {
+			continue
+		}
+
+		tfMap[field.attributeName] = encodeValue(val)
+	}
+
+	return tfMap
+}
+
+func encodeValue(val reflect.Value) interface{} {
+	switch val.Kind() {
+	case reflect.Struct:
+		return []interface{}{encodeModel(val)}
+
+	case reflect.Slice:
+		tfList := make([]interface{}, val.Len())
+
+		for i := 0; i < val.Len(); i++ {
+			// Elements of slices of structs are encoded as maps, not as single-element lists.
+			if elem := val.Index(i); elem.Kind() == reflect.Struct {
+				tfList[i] = encodeModel(elem)
+			} else {
+				tfList[i] = encodeValue(elem)
+			}
+		}
+
+		return tfList
+
+	case reflect.Map:
+		tfMap := make(map[string]interface{}, val.Len())
+		iter := val.MapRange()
+
+		for iter.Next() {
+			tfMap[iter.Key().String()] = encodeValue(iter.Value())
+		}
+
+		return tfMap
+
+	case reflect.String:
+		return val.String()
+
+	case reflect.Bool:
+		return val.Bool()
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(val.Int())
+
+	case reflect.Float32, reflect.Float64:
+		return val.Float()
+	}
+
+	return nil
+}
+
+// isEmpty returns whether the specified model value is a zero value or an empty slice or map.
+func isEmpty(val reflect.Value) bool {
+	switch val.Kind() {
+	case reflect.Slice, reflect.Map:
+		return val.Len() == 0
+	}
+
+	return val.IsZero()
+}
+
+// snakeCase converts a Go exported identifier to the snake_case attribute name convention,
+// treating runs of upper case letters as a single word, e.g. `VpcId` -> `vpc_id` and `DNSName` -> `dns_name`.
+func snakeCase(s string) string {
+	runes := []rune(s)
+	var sb strings.Builder
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				prev := runes[i-1]
+				nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+
+				if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+					sb.WriteRune('_')
+				}
+			}
+
+			sb.WriteRune(unicode.ToLower(r))
+
+			continue
+		}
+
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
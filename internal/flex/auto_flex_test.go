@@ -0,0 +1,368 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"math"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// AWS API structures, as generated by the AWS SDK for Go.
+
+type testAutoFlexEnum string
+
+type testAutoFlexAPINested struct {
+	_ struct{} `type:"structure"`
+
+	Name  *string `type:"string"`
+	Value *int64  `type:"integer"`
+}
+
+type testAutoFlexAPIObject struct {
+	_ struct{} `type:"structure"`
+
+	Config      *testAutoFlexAPINested   `type:"structure"`
+	Count       *int64                   `type:"integer"`
+	Description *string                  `type:"string"`
+	DisplayName *string                  `type:"string"`
+	Enabled     *bool                    `type:"boolean"`
+	KmsKeyId    *string                  `type:"string"`
+	Mode        testAutoFlexEnum         `type:"string" enum:"Mode"`
+	Port        int32                    `type:"integer"`
+	Ratio       *float64                 `type:"double"`
+	Retries     *int64                   `type:"integer"`
+	Rules       []*testAutoFlexAPINested `type:"list"`
+	SubnetIds   []*string                `type:"list"`
+	Tags        map[string]*string       `type:"map"`
+	Unmapped    *string                  `type:"string"`
+}
+
+// Terraform-side models.
+
+type testAutoFlexNestedModel struct {
+	Name  string
+	Value int
+}
+
+type testAutoFlexModel struct {
+	Config      testAutoFlexNestedModel   `tfsdk:"config,omitempty"`
+	Count       int                       `tfsdk:"count"`
+	Description string                    `tfsdk:",omitempty"`
+	DisplayName string                    `tfsdk:"label"`
+	Enabled     bool                      `tfsdk:"enabled"`
+	KmsKeyId    string                    `tfsdk:"kms_key_id,omitempty"`
+	Mode        string                    `tfsdk:"mode,omitempty"`
+	Port        int                       `tfsdk:"port,omitempty"`
+	Ratio       float64                   `tfsdk:"ratio,omitempty"`
+	Retries     int                       `tfsdk:"retries,omitempty"`
+	Rules       []testAutoFlexNestedModel `tfsdk:"rule,omitempty"`
+	SubnetIds   []string                  `tfsdk:"subnet_ids,omitempty"`
+	Tags        map[string]string         `tfsdk:"tags,omitempty"`
+	Internal    string                    `tfsdk:"-"`
+}
+
+type testAutoFlexMismatchedModel struct {
+	Missing string `tfsdk:"missing"`
+}
+
+type testAutoFlexIncompatibleModel struct {
+	Count string `tfsdk:"count"`
+}
+
+func TestSnakeCase(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"Name":            "name",
+		"KmsKeyId":        "kms_key_id",
+		"DNSName":         "dns_name",
+		"VpcIds":          "vpc_ids",
+		"Ipv6CidrBlock":   "ipv6_cidr_block",
+		"HTTPEndpoint":    "http_endpoint",
+		"S3BucketArn":     "s3_bucket_arn",
+		"MaxItemsPer1000": "max_items_per1000",
+	}
+
+	for input, want := range testCases {
+		input, want := input, want
+
+		t.Run(input, func(t *testing.T) {
+			t.Parallel()
+
+			if got := snakeCase(input); got != want {
+				t.Errorf("snakeCase(%q) = %q, want %q", input, got, want)
+			}
+		})
+	}
+}
+
+func TestExpandTFMap(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tfMap   map[string]interface{}
+		want    *testAutoFlexAPIObject
+		wantErr bool
+	}{
+		"empty": {
+			tfMap: map[string]interface{}{},
+			want: &testAutoFlexAPIObject{
+				Count:       aws.Int64(0),
+				DisplayName: aws.String(""),
+				Enabled:     aws.Bool(false),
+			},
+		},
+		"all attributes": {
+			tfMap: map[string]interface{}{
+				"config": []interface{}{
+					map[string]interface{}{"name": "c1", "value": 1},
+				},
+				"count":       3,
+				"description": "test",
+				"enabled":     true,
+				"internal":    "ignored",
+				"kms_key_id":  "key",
+				"label":       "display",
+				"mode":        "FAST",
+				"port":        443,
+				"ratio":       0.5,
+				"retries":     2,
+				"rule": schema.NewSet(func(v interface{}) int { return v.(map[string]interface{})["value"].(int) }, []interface{}{
+					map[string]interface{}{"name": "r1", "value": 10},
+				}),
+				"subnet_ids": []interface{}{"subnet-1", "subnet-2"},
+				"tags":       map[string]interface{}{"k": "v"},
+			},
+			want: &testAutoFlexAPIObject{
+				Config:      &testAutoFlexAPINested{Name: aws.String("c1"), Value: aws.Int64(1)},
+				Count:       aws.Int64(3),
+				Description: aws.String("test"),
+				DisplayName: aws.String("display"),
+				Enabled:     aws.Bool(true),
+				KmsKeyId:    aws.String("key"),
+				Mode:        testAutoFlexEnum("FAST"),
+				Port:        443,
+				Ratio:       aws.Float64(0.5),
+				Retries:     aws.Int64(2),
+				Rules:       []*testAutoFlexAPINested{{Name: aws.String("r1"), Value: aws.Int64(10)}},
+				SubnetIds:   aws.StringSlice([]string{"subnet-1", "subnet-2"}),
+				Tags:        aws.StringMap(map[string]string{"k": "v"}),
+			},
+		},
+		"omitempty zero values": {
+			tfMap: map[string]interface{}{
+				"config":      []interface{}{},
+				"count":       0,
+				"description": "",
+				"enabled":     false,
+				"label":       "",
+				"retries":     0,
+				"subnet_ids":  []interface{}{},
+			},
+			want: &testAutoFlexAPIObject{
+				Count:       aws.Int64(0),
+				DisplayName: aws.String(""),
+				Enabled:     aws.Bool(false),
+			},
+		},
+		"unexpected attribute type": {
+			tfMap:   map[string]interface{}{"count": "three"},
+			wantErr: true,
+		},
+		"unexpected block type": {
+			tfMap:   map[string]interface{}{"config": map[string]interface{}{"name": "c1"}},
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := &testAutoFlexAPIObject{}
+			err := ExpandTFMap[testAutoFlexModel](testCase.tfMap, got)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("error = %v, want error %t", err, want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.want, cmp.AllowUnexported(testAutoFlexAPIObject{}, testAutoFlexAPINested{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandTFMapErrors(t *testing.T) {
+	t.Parallel()
+
+	if err := ExpandTFMap[testAutoFlexModel](map[string]interface{}{}, testAutoFlexAPIObject{}); err == nil {
+		t.Error("expected error for non-pointer target")
+	}
+
+	if err := ExpandTFMap[string](map[string]interface{}{}, &testAutoFlexAPIObject{}); err == nil {
+		t.Error("expected error for non-struct model")
+	}
+
+	if err := ExpandTFMap[testAutoFlexMismatchedModel](map[string]interface{}{"missing": "x"}, &testAutoFlexAPIObject{}); err == nil {
+		t.Error("expected error for model field without API structure field")
+	}
+
+	if err := ExpandTFMap[testAutoFlexIncompatibleModel](map[string]interface{}{"count": "x"}, &testAutoFlexAPIObject{}); err == nil {
+		t.Error("expected error for incompatible field types")
+	}
+
+	type overflowModel struct {
+		Port int
+	}
+
+	if err := ExpandTFMap[overflowModel](map[string]interface{}{"port": math.MaxInt64}, &testAutoFlexAPIObject{}); err == nil {
+		t.Error("expected error for integer overflow")
+	}
+}
+
+func TestFlattenTFMap(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		apiObject any
+		want      map[string]interface{}
+		wantErr   bool
+	}{
+		"nil": {
+			apiObject: (*testAutoFlexAPIObject)(nil),
+		},
+		"empty": {
+			apiObject: &testAutoFlexAPIObject{},
+			want: map[string]interface{}{
+				"count":   0,
+				"enabled": false,
+				"label":   "",
+			},
+		},
+		"all fields": {
+			apiObject: &testAutoFlexAPIObject{
+				Config:      &testAutoFlexAPINested{Name: aws.String("c1"), Value: aws.Int64(1)},
+				Count:       aws.Int64(3),
+				Description: aws.String("test"),
+				DisplayName: aws.String("display"),
+				Enabled:     aws.Bool(true),
+				KmsKeyId:    aws.String("key"),
+				Mode:        testAutoFlexEnum("FAST"),
+				Port:        443,
+				Ratio:       aws.Float64(0.5),
+				Retries:     aws.Int64(2),
+				Rules:       []*testAutoFlexAPINested{{Name: aws.String("r1"), Value: aws.Int64(10)}, nil},
+				SubnetIds:   aws.StringSlice([]string{"subnet-1", "subnet-2"}),
+				Tags:        aws.StringMap(map[string]string{"k": "v"}),
+				Unmapped:    aws.String("ignored"),
+			},
+			want: map[string]interface{}{
+				"config": []interface{}{
+					map[string]interface{}{"name": "c1", "value": 1},
+				},
+				"count":       3,
+				"description": "test",
+				"enabled":     true,
+				"kms_key_id":  "key",
+				"label":       "display",
+				"mode":        "FAST",
+				"port":        443,
+				"ratio":       0.5,
+				"retries":     2,
+				"rule": []interface{}{
+					map[string]interface{}{"name": "r1", "value": 10},
+				},
+				"subnet_ids": []interface{}{"subnet-1", "subnet-2"},
+				"tags":       map[string]interface{}{"k": "v"},
+			},
+		},
+		"value struct": {
+			apiObject: testAutoFlexAPIObject{Count: aws.Int64(1)},
+			want: map[string]interface{}{
+				"count":   1,
+				"enabled": false,
+				"label":   "",
+			},
+		},
+		"not a struct": {
+			apiObject: aws.String("x"),
+			wantErr:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := FlattenTFMap[testAutoFlexModel](testCase.apiObject)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("error = %v, want error %t", err, want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func FuzzExpandFlattenTFMap(f *testing.F) {
+	f.Add("display", 3, true, "FAST", 0.5, "subnet-1")
+	f.Add("", 0, false, "", 0.0, "")
+
+	f.Fuzz(func(t *testing.T, label string, count int, enabled bool, mode string, ratio float64, subnetID string) {
+		if math.IsNaN(ratio) {
+			t.Skip()
+		}
+
+		tfMap := map[string]interface{}{
+			"count":   count,
+			"enabled": enabled,
+			"label":   label,
+		}
+		if mode != "" {
+			tfMap["mode"] = mode
+		}
+		if ratio != 0 {
+			tfMap["ratio"] = ratio
+		}
+		if subnetID != "" {
+			tfMap["subnet_ids"] = []interface{}{subnetID}
+		}
+
+		apiObject := &testAutoFlexAPIObject{}
+
+		if err := ExpandTFMap[testAutoFlexModel](tfMap, apiObject); err != nil {
+			t.Fatalf("expanding: %s", err)
+		}
+
+		got, err := FlattenTFMap[testAutoFlexModel](apiObject)
+
+		if err != nil {
+			t.Fatalf("flattening: %s", err)
+		}
+
+		if diff := cmp.Diff(got, tfMap); diff != "" {
+			t.Errorf("round trip: unexpected diff (+wanted, -got): %s", diff)
+		}
+	})
+}
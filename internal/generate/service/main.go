@@ -0,0 +1,436 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/tools/go/packages"
+)
+
+var (
+	sdkPackage = flag.String("SDKPackage", "", "AWS SDK for Go v2 service package name, e.g. oam")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go -SDKPackage=<aws-sdk-go-v2-service-package>\n\n")
+	fmt.Fprintf(os.Stderr, "Run from the root of the repository.\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	const (
+		namesDataFile = `names/names_data.csv`
+		sdkImportPath = `github.com/aws/aws-sdk-go-v2/service/`
+	)
+	g := common.NewGenerator()
+
+	flag.Usage = usage
+	flag.Parse()
+
+	if *sdkPackage == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	data, err := common.ReadAllCSVData(namesDataFile)
+
+	if err != nil {
+		g.Fatalf("error reading %s: %s", namesDataFile, err)
+	}
+
+	var s *ServiceDatum
+
+	for i, l := range data {
+		if i < 1 { // no header
+			continue
+		}
+
+		if l[names.ColGoV2Package] != *sdkPackage || l[names.ColExclude] != "" {
+			continue
+		}
+
+		if l[names.ColClientSDKV2] == "" {
+			g.Fatalf("service (%s) does not use AWS SDK for Go v2: set ClientSDKV2 in %s", *sdkPackage, namesDataFile)
+		}
+
+		// See internal/generate/namesconsts/main.go.
+		p := l[names.ColProviderPackageCorrect]
+
+		if l[names.ColProviderPackageActual] != "" {
+			p = l[names.ColProviderPackageActual]
+		}
+
+		s = &ServiceDatum{
+			GoV2Package:       l[names.ColGoV2Package],
+			HumanFriendly:     l[names.ColHumanFriendly],
+			ProviderNameUpper: l[names.ColProviderNameUpper],
+			ProviderPackage:   p,
+		}
+
+		break
+	}
+
+	if s == nil {
+		g.Fatalf("service (%s) not found in %s: add it first, see docs/add-a-new-service.md", *sdkPackage, namesDataFile)
+	}
+
+	g.Infof("Loading AWS API model from %s%s", sdkImportPath, s.GoV2Package)
+
+	if err := s.loadModel(sdkImportPath + s.GoV2Package); err != nil {
+		g.Fatalf("error loading AWS API model (%s): %s", s.GoV2Package, err)
+	}
+
+	dir := filepath.Join("internal", "service", s.ProviderPackage)
+
+	if err := os.MkdirAll(dir, 0755); err != nil { //nolint:gomnd
+		g.Fatalf("error creating directory (%s): %s", dir, err)
+	}
+
+	files := []struct {
+		filename string
+		tmpl     string
+		goSource bool
+		skip     bool
+	}{
+		{filename: "README.md", tmpl: readmeTmpl},
+		{filename: "generate.go", tmpl: generateTmpl, goSource: true},
+		{filename: "list_pages.go", tmpl: listPagesTmpl, goSource: true, skip: !s.NeedsListPages()},
+		{filename: "sweep.go", tmpl: sweepTmpl, goSource: true},
+		{filename: s.ProviderPackage + "_test.go", tmpl: testTmpl, goSource: true, skip: s.PreCheckOperation == ""},
+	}
+
+	for _, f := range files {
+		if f.skip {
+			continue
+		}
+
+		filename := filepath.Join(dir, f.filename)
+
+		if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) {
+			g.Warnf("Skipping existing %s", filename)
+			continue
+		}
+
+		g.Infof("Generating %s", filename)
+
+		var d common.Destination
+		if f.goSource {
+			d = g.NewGoFileDestination(filename)
+		} else {
+			d = g.NewUnformattedFileDestination(filename)
+		}
+
+		if err := d.WriteTemplate(f.filename, f.tmpl, s); err != nil {
+			g.Fatalf("error generating %s: %s", filename, err)
+		}
+
+		if err := d.Write(); err != nil {
+			g.Fatalf("generating file (%s): %s", filename, err)
+		}
+	}
+
+	if s.Tagging == nil {
+		g.Warnf("No standard tagging operations found: add tags generation to %s by hand if the service supports tagging", filepath.Join(dir, "generate.go"))
+	}
+
+	g.Infof("Run `make gen` to generate the service client and register the service package")
+}
+
+type ServiceDatum struct {
+	GoV2Package       string // AWS SDK for Go v2 package name
+	HumanFriendly     string
+	ProviderNameUpper string
+	ProviderPackage   string
+
+	ListOperations    []ListOperation
+	PreCheckOperation string // List operation with no required input, called from acceptance test PreCheck
+	Tagging           *TaggingDatum
+}
+
+// NeedsListPages returns whether any List operation is paginated but has no AWS SDK for Go v2 paginator.
+func (s *ServiceDatum) NeedsListPages() bool {
+	for _, v := range s.ListOperations {
+		if v.NextToken && !v.Paginated {
+			return true
+		}
+	}
+
+	return false
+}
+
+type ListOperation struct {
+	Name          string
+	FuncName      string
+	NextToken     bool // Input and output have a NextToken field
+	Paginated     bool // The AWS SDK for Go v2 defines a paginator
+	RequiredInput bool // Input has required fields
+}
+
+type TaggingDatum struct {
+	Flags string // Flags for internal/generate/tags/main.go
+}
+
+//go:embed generate.tmpl
+var generateTmpl string
+
+//go:embed listpages.tmpl
+var listPagesTmpl string
+
+//go:embed readme.tmpl
+var readmeTmpl string
+
+//go:embed sweep.tmpl
+var sweepTmpl string
+
+//go:embed test.tmpl
+var testTmpl string
+
+// loadModel inspects the AWS SDK for Go v2 service package's source for List and tagging operations.
+func (s *ServiceDatum) loadModel(importPath string) error {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles,
+	}
+	pkgs, err := packages.Load(cfg, importPath)
+
+	if err != nil {
+		return err
+	}
+
+	if packages.PrintErrors(pkgs) > 0 || len(pkgs) != 1 || len(pkgs[0].GoFiles) == 0 {
+		return fmt.Errorf("loading package (%s): is it a dependency in go.mod?", importPath)
+	}
+
+	m := newModel()
+	fileSet := token.NewFileSet()
+
+	for _, filename := range pkgs[0].GoFiles {
+		file, err := parser.ParseFile(fileSet, filename, nil, parser.ParseComments)
+
+		if err != nil {
+			return fmt.Errorf("parsing (%s): %w", filename, err)
+		}
+
+		m.processFile(file)
+	}
+
+	for name := range m.operations {
+		if !strings.HasPrefix(name, "List") {
+			continue
+		}
+
+		input, output := m.structs[name+"Input"], m.structs[name+"Output"]
+
+		if input == nil || output == nil {
+			continue
+		}
+
+		s.ListOperations = append(s.ListOperations, ListOperation{
+			Name:          name,
+			FuncName:      strings.ToLower(name[:1]) + name[1:],
+			NextToken:     input.hasField("NextToken") && output.hasField("NextToken"),
+			Paginated:     m.funcs["New"+name+"Paginator"],
+			RequiredInput: input.required,
+		})
+	}
+
+	sort.Slice(s.ListOperations, func(i, j int) bool {
+		return s.ListOperations[i].Name < s.ListOperations[j].Name
+	})
+
+	for _, v := range s.ListOperations {
+		if !v.RequiredInput && v.Name != "ListTagsForResource" {
+			s.PreCheckOperation = v.Name
+			break
+		}
+	}
+
+	if m.operations["TagResource"] && m.operations["UntagResource"] && m.operations["ListTagsForResource"] {
+		s.Tagging = m.tagging()
+	}
+
+	return nil
+}
+
+// model is the subset of an AWS SDK for Go v2 service package's declarations used for scaffolding.
+type model struct {
+	funcs      map[string]bool
+	operations map[string]bool
+	structs    map[string]*structDatum
+}
+
+type structDatum struct {
+	fields   []fieldDatum
+	required bool // Whether any field is documented as required
+}
+
+type fieldDatum struct {
+	name string
+	expr ast.Expr
+}
+
+func newModel() *model {
+	return &model{
+		funcs:      make(map[string]bool),
+		operations: make(map[string]bool),
+		structs:    make(map[string]*structDatum),
+	}
+}
+
+// processFile records a single Go source file's functions, Client methods and struct types.
+func (m *model) processFile(file *ast.File) {
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if !decl.Name.IsExported() {
+				continue
+			}
+
+			if decl.Recv == nil {
+				m.funcs[decl.Name.Name] = true
+				continue
+			}
+
+			if star, ok := decl.Recv.List[0].Type.(*ast.StarExpr); ok {
+				if ident, ok := star.X.(*ast.Ident); ok && ident.Name == "Client" {
+					m.operations[decl.Name.Name] = true
+				}
+			}
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
+
+				if !ok {
+					continue
+				}
+
+				structType, ok := typeSpec.Type.(*ast.StructType)
+
+				if !ok {
+					continue
+				}
+
+				v := &structDatum{}
+
+				for _, field := range structType.Fields.List {
+					if field.Doc != nil && strings.Contains(field.Doc.Text(), "This member is required.") {
+						v.required = true
+					}
+
+					for _, name := range field.Names {
+						v.fields = append(v.fields, fieldDatum{name: name.Name, expr: field.Type})
+					}
+				}
+
+				m.structs[typeSpec.Name.Name] = v
+			}
+		}
+	}
+}
+
+// tagging returns the tags generator configuration for the standard TagResource, UntagResource and ListTagsForResource operations.
+// nil is returned if the operations' shapes are not supported by the tags generator.
+func (m *model) tagging() *TaggingDatum {
+	tagInput := m.structs["TagResourceInput"]
+	untagInput := m.structs["UntagResourceInput"]
+	listTagsInput := m.structs["ListTagsForResourceInput"]
+	listTagsOutput := m.structs["ListTagsForResourceOutput"]
+
+	if tagInput == nil || untagInput == nil || listTagsInput == nil || listTagsOutput == nil {
+		return nil
+	}
+
+	tagInIDElem, listTagsInIDElem := tagInput.stringField(), listTagsInput.stringField()
+
+	if tagInIDElem == "" || listTagsInIDElem == "" || !listTagsOutput.hasField("Tags") {
+		return nil
+	}
+
+	var flags []string
+
+	switch expr := tagInput.field("Tags").(type) {
+	case *ast.MapType:
+		flags = append(flags, "-ServiceTagsMap", "-KVTValues", "-SkipTypesImp")
+	case *ast.ArrayType:
+		flags = append(flags, "-ServiceTagsSlice")
+
+		if sel, ok := expr.Elt.(*ast.SelectorExpr); ok && sel.Sel.Name != "Tag" {
+			flags = append(flags, "-TagType="+sel.Sel.Name)
+		}
+	default:
+		return nil
+	}
+
+	flags = append(flags, "-ListTags", "-UpdateTags")
+
+	if tagInIDElem != "ResourceArn" {
+		flags = append(flags, "-TagInIDElem="+tagInIDElem)
+	}
+
+	if listTagsInIDElem != "ResourceArn" {
+		flags = append(flags, "-ListTagsInIDElem="+listTagsInIDElem)
+	}
+
+	if !untagInput.hasField("TagKeys") {
+		for _, v := range untagInput.fields {
+			if strings.HasPrefix(v.name, "Tag") {
+				flags = append(flags, "-UntagInTagsElem="+v.name)
+				break
+			}
+		}
+	}
+
+	return &TaggingDatum{
+		Flags: strings.Join(flags, " "),
+	}
+}
+
+func (v *structDatum) field(name string) ast.Expr {
+	for _, field := range v.fields {
+		if field.name == name {
+			return field.expr
+		}
+	}
+
+	return nil
+}
+
+func (v *structDatum) hasField(name string) bool {
+	return v.field(name) != nil
+}
+
+// stringField returns the name of the first exported *string field.
+func (v *structDatum) stringField() string {
+	for _, field := range v.fields {
+		if !ast.IsExported(field.name) {
+			continue
+		}
+
+		if star, ok := field.expr.(*ast.StarExpr); ok {
+			if ident, ok := star.X.(*ast.Ident); ok && ident.Name == "string" {
+				return field.name
+			}
+		}
+	}
+
+	return ""
+}
@@ -128,6 +128,10 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	NotFoundOperation       string   // AWS API operation whose NotFound error removes the resource from state when Read fails
+	EndpointDriftAttribute  string   // Attribute holding the resource's own ARN, checked for endpoint drift when Read removes the resource from state
+	Partitions              []string // AWS partitions in which the resource or data source is available
+	ARNAttributes           []string // Top-level attributes whose ARN values must be in the configured AWS partition
 }
 
 type ServiceDatum struct {
@@ -228,6 +232,46 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				d.TagsResourceType = attr
 			}
 		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "NotFound" {
+			args := common.ParseArgs(m[3])
+
+			if attr, ok := args.Keyword["operation"]; ok {
+				d.NotFoundOperation = attr
+			} else {
+				v.err = multierror.Append(v.err, fmt.Errorf("no NotFound operation: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "EndpointDrift" {
+			args := common.ParseArgs(m[3])
+
+			if attr, ok := args.Keyword["arnAttribute"]; ok {
+				d.EndpointDriftAttribute = attr
+			} else {
+				v.err = multierror.Append(v.err, fmt.Errorf("no EndpointDrift arnAttribute: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Partitions" {
+			args := common.ParseArgs(m[3])
+
+			if len(args.Positional) == 0 {
+				v.err = multierror.Append(v.err, fmt.Errorf("no partitions: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			d.Partitions = append(d.Partitions, args.Positional...)
+		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "ARNAttributes" {
+			args := common.ParseArgs(m[3])
+
+			if len(args.Positional) == 0 {
+				v.err = multierror.Append(v.err, fmt.Errorf("no ARN attributes: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			d.ARNAttributes = append(d.ARNAttributes, args.Positional...)
+		}
 	}
 
 	for _, line := range funcDecl.Doc.List {
@@ -281,7 +325,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "ARNAttributes", "EndpointDrift", "NotFound", "Partitions", "Tags":
 				// Handled above.
 			default:
 				v.g.Warnf("unknown annotation: %s", annotationName)
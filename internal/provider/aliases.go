@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"golang.org/x/exp/slices"
+)
+
+// typeNameAlias records the former name of a renamed resource or data source type.
+// The former name is served by the current type's implementation, with an identical schema,
+// so existing configurations and state continue to work unchanged and state can be moved
+// to the current type name with `terraform state mv` without any hand-editing.
+type typeNameAlias struct {
+	former  string
+	current string
+	// Whether use of the former name produces a deprecation warning.
+	deprecated bool
+}
+
+// deprecationMessage returns the message shown when a deprecated former type name is used.
+func (a typeNameAlias) deprecationMessage() string {
+	return fmt.Sprintf("%[1]s has been renamed to %[2]s. Use %[2]s instead; existing state can be moved with `terraform state mv`.", a.former, a.current)
+}
+
+// resourceTypeAliases is the central registry of renamed Plugin SDK resource types.
+// Register a rename here rather than adding a second @SDKResource annotation.
+var resourceTypeAliases = []typeNameAlias{
+	{former: "aws_alb", current: "aws_lb"},
+	{former: "aws_alb_listener", current: "aws_lb_listener"},
+	{former: "aws_alb_listener_certificate", current: "aws_lb_listener_certificate"},
+	{former: "aws_alb_listener_rule", current: "aws_lb_listener_rule"},
+	{former: "aws_alb_target_group", current: "aws_lb_target_group"},
+	{former: "aws_alb_target_group_attachment", current: "aws_lb_target_group_attachment"},
+}
+
+// dataSourceTypeAliases is the central registry of renamed Plugin SDK data source types.
+// Register a rename here rather than adding a second @SDKDataSource annotation.
+var dataSourceTypeAliases = []typeNameAlias{
+	{former: "aws_alb", current: "aws_lb"},
+	{former: "aws_alb_listener", current: "aws_lb_listener"},
+	{former: "aws_alb_target_group", current: "aws_lb_target_group"},
+}
+
+// findTypeNameAlias returns the registered alias with the specified former type name.
+func findTypeNameAlias(aliases []typeNameAlias, former string) (typeNameAlias, bool) {
+	for _, v := range aliases {
+		if v.former == former {
+			return v, true
+		}
+	}
+
+	return typeNameAlias{}, false
+}
+
+// withSDKDataSourceAliases returns the specified data sources followed by a copy of each
+// registered under its former type names.
+func withSDKDataSourceAliases(dataSources []*types.ServicePackageSDKDataSource) []*types.ServicePackageSDKDataSource {
+	output := slices.Clone(dataSources)
+
+	for _, v := range dataSources {
+		for _, alias := range dataSourceTypeAliases {
+			if alias.current == v.TypeName {
+				v := *v
+				v.TypeName = alias.former
+				output = append(output, &v)
+			}
+		}
+	}
+
+	return output
+}
+
+// withSDKResourceAliases returns the specified resources followed by a copy of each
+// registered under its former type names.
+func withSDKResourceAliases(resources []*types.ServicePackageSDKResource) []*types.ServicePackageSDKResource {
+	output := slices.Clone(resources)
+
+	for _, v := range resources {
+		for _, alias := range resourceTypeAliases {
+			if alias.current == v.TypeName {
+				v := *v
+				v.TypeName = alias.former
+				output = append(output, &v)
+			}
+		}
+	}
+
+	return output
+}
+
+// validateTypeNameAliases returns an error for each registered alias whose current type name
+// isn't implemented.
+func validateTypeNameAliases(aliases []typeNameAlias, implemented func(string) bool, kind string) []error {
+	var errs []error
+
+	for _, v := range aliases {
+		if !implemented(v.current) {
+			errs = append(errs, fmt.Errorf("%s alias %s: unknown %s: %s", kind, v.former, kind, v.current))
+		}
+	}
+
+	return errs
+}
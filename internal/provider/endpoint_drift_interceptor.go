@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+type endpointDriftContextKeyType int
+
+var endpointDriftContextKey endpointDriftContextKeyType
+
+// endpointDriftInterceptor prevents a resource from being removed from state during Read when the
+// resource's own ARN shows that it was created in a different AWS partition or account than the one the
+// provider is now configured for, e.g. because the provider's endpoints have been switched between
+// LocalStack and AWS. Removing such a resource from state would cause Terraform to orphan it.
+// Only resources whose ARN is always in the provider's own account should use this interceptor.
+type endpointDriftInterceptor struct {
+	typeName     string
+	arnAttribute string // "id" for the resource ID
+}
+
+func (r endpointDriftInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if why != Read {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		ctx = context.WithValue(ctx, endpointDriftContextKey, d.Id())
+	case Finally:
+		id, _ := ctx.Value(endpointDriftContextKey).(string)
+
+		// Only check resources that have just been removed from state.
+		if id == "" || d.Id() != "" {
+			return ctx, diags
+		}
+
+		c, ok := meta.(*conns.AWSClient)
+
+		if !ok {
+			return ctx, diags
+		}
+
+		v, ok := r.resourceARN(d, id)
+
+		if !ok {
+			return ctx, diags
+		}
+
+		var drifted bool
+		if v.Partition != c.Partition {
+			drifted = true
+		}
+		// The account ID is unknown if the provider is configured with skip_requesting_account_id.
+		if v.AccountID != "" && c.AccountID != "" && v.AccountID != c.AccountID {
+			drifted = true
+		}
+
+		if !drifted {
+			return ctx, diags
+		}
+
+		d.SetId(id)
+
+		return ctx, sdkdiag.AppendErrorf(diags, "%s (%s) not found, but its ARN (%s) is in AWS partition %q and account %q whereas the provider is configured for AWS partition %q and account %q. "+
+			"If the provider's endpoints or credentials have changed (e.g. from LocalStack to AWS) restore the original configuration, otherwise remove the resource from state with `terraform state rm`",
+			r.typeName, id, v, v.Partition, v.AccountID, c.Partition, c.AccountID)
+	}
+
+	return ctx, diags
+}
+
+// resourceARN returns the resource's own ARN.
+func (r endpointDriftInterceptor) resourceARN(d schemaResourceData, id string) (arn.ARN, bool) {
+	v := id
+	if r.arnAttribute != "id" {
+		v, _ = d.Get(r.arnAttribute).(string)
+	}
+
+	if v, err := arn.Parse(v); err == nil {
+		return v, true
+	}
+
+	return arn.ARN{}, false
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+type endpointDriftResourceData struct {
+	notFoundResourceData
+
+	arn string
+}
+
+func (d *endpointDriftResourceData) Get(key string) any {
+	if key == "arn" {
+		return d.arn
+	}
+
+	return nil
+}
+
+func TestEndpointDriftInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const id = "q1"
+
+	testCases := map[string]struct {
+		arn           string
+		arnAttribute  string
+		id            string
+		accountID     string
+		removed       bool
+		expectRemoved bool
+		expectErrors  int
+	}{
+		"not removed": {
+			arn:       "arn:aws:sqs:us-west-2:000000000000:q1", //lintignore:AWSAT003,AWSAT005
+			accountID: "123456789012",
+		},
+		"removed same account": {
+			arn:           "arn:aws:sqs:us-west-2:123456789012:q1", //lintignore:AWSAT003,AWSAT005
+			accountID:     "123456789012",
+			removed:       true,
+			expectRemoved: true,
+		},
+		"removed different account": {
+			arn:          "arn:aws:sqs:us-west-2:000000000000:q1", //lintignore:AWSAT003,AWSAT005
+			accountID:    "123456789012",
+			removed:      true,
+			expectErrors: 1,
+		},
+		"removed different partition": {
+			arn:          "arn:aws-cn:sqs:cn-north-1:123456789012:q1", //lintignore:AWSAT003,AWSAT005
+			accountID:    "123456789012",
+			removed:      true,
+			expectErrors: 1,
+		},
+		"removed ARN ID different account": {
+			arnAttribute: "id",
+			id:           "arn:aws:sqs:us-west-2:000000000000:q1", //lintignore:AWSAT003,AWSAT005
+			accountID:    "123456789012",
+			removed:      true,
+			expectErrors: 1,
+		},
+		"removed ARN ID not the ARN attribute": {
+			id:            "arn:aws:sqs:us-west-2:000000000000:q1", //lintignore:AWSAT003,AWSAT005
+			accountID:     "123456789012",
+			removed:       true,
+			expectRemoved: true,
+		},
+		"removed no ARN": {
+			accountID:     "123456789012",
+			removed:       true,
+			expectRemoved: true,
+		},
+		"removed account ID not requested": {
+			arn:           "arn:aws:sqs:us-west-2:000000000000:q1", //lintignore:AWSAT003,AWSAT005
+			removed:       true,
+			expectRemoved: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			meta := &conns.AWSClient{AccountID: testCase.accountID, Partition: "aws"}
+			arnAttribute := "arn"
+			if testCase.arnAttribute != "" {
+				arnAttribute = testCase.arnAttribute
+			}
+			interceptor := endpointDriftInterceptor{typeName: "aws_sqs_queue", arnAttribute: arnAttribute}
+			d := &endpointDriftResourceData{arn: testCase.arn}
+			d.id = id
+			if testCase.id != "" {
+				d.id = testCase.id
+			}
+
+			var diags diag.Diagnostics
+			ctx, diags = interceptor.run(ctx, d, meta, Before, Read, diags)
+
+			if testCase.removed {
+				d.SetId("")
+			}
+
+			_, diags = interceptor.run(ctx, d, meta, Finally, Read, diags)
+
+			if got, want := d.Id() == "", testCase.expectRemoved; got != want {
+				t.Errorf("removed = %t, want %t", got, want)
+			}
+
+			if got, want := len(diags), testCase.expectErrors; got != want {
+				t.Errorf("length of diags = %d, want %d", got, want)
+			}
+		})
+	}
+}
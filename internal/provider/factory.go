@@ -34,5 +34,7 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 		return nil, nil, err
 	}
 
-	return muxServer.ProviderServer, primary, nil
+	quotaCheckServer := newQuotaCheckProviderServer(muxServer.ProviderServer(), primary.Meta)
+
+	return func() tfprotov5.ProviderServer { return quotaCheckServer }, primary, nil
 }
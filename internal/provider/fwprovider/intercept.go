@@ -6,12 +6,17 @@ package fwprovider
 import (
 	"context"
 	"fmt"
+	"log"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -155,6 +160,7 @@ func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.Schem
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = conns.NewOperationContext(ctx, conns.OperationRead)
 	w.inner.Read(ctx, request, response)
 }
 
@@ -206,6 +212,7 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = conns.NewOperationContext(ctx, conns.OperationRead)
 	diags := interceptedHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
@@ -253,11 +260,21 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
 		v.ModifyPlan(ctx, request, response)
 
-		return
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Validate planned tags against any provider configured tag policy.
+	for _, v := range w.interceptors {
+		if v, ok := v.(tagsInterceptor); ok {
+			response.Diagnostics.Append(v.modifyPlan(ctx, request, response, w.meta)...)
+		}
 	}
 }
 
@@ -550,3 +567,130 @@ func (r tagsInterceptor) update(ctx context.Context, request resource.UpdateRequ
 func (r tagsInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
+
+// modifyPlan validates a resource's planned tags, including any provider configured default_tags,
+// against any provider configured tag_policy.
+func (r tagsInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if r.tags == nil || meta == nil || meta.TagPolicyConfig == nil {
+		return diags
+	}
+
+	// Nothing to validate on resource destroy.
+	if request.Plan.Raw.IsNull() {
+		return diags
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return diags
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return diags
+	}
+
+	var planTags fwtypes.Map
+	diags.Append(response.Plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)...)
+	if diags.HasError() || planTags.IsUnknown() {
+		return diags
+	}
+
+	// Only validate tags on resources being created or whose tags are changing.
+	if !request.State.Raw.IsNull() {
+		var planTagsAll, stateTagsAll fwtypes.Map
+		diags.Append(response.Plan.GetAttribute(ctx, path.Root(names.AttrTagsAll), &planTagsAll)...)
+		diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTagsAll), &stateTagsAll)...)
+		if diags.HasError() || planTagsAll.Equal(stateTagsAll) {
+			return diags
+		}
+	}
+
+	tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, planTags))
+	tags = tags.IgnoreSystem(inContext.ServicePackageName)
+
+	if err := meta.TagPolicyConfig.Validate(tags); err != nil {
+		diags.AddAttributeError(path.Root(names.AttrTags), "Tag policy violation", err.Error())
+	}
+
+	return diags
+}
+
+// lifecycleLogInterceptor emits structured (JSON) log entries at the start and end of each CRUD operation.
+type lifecycleLogInterceptor struct {
+	typeName string
+}
+
+func (r lifecycleLogInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, "create", response.State, when, diags), diags
+}
+
+func (r lifecycleLogInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, "read", response.State, when, diags), diags
+}
+
+func (r lifecycleLogInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, "update", response.State, when, diags), diags
+}
+
+func (r lifecycleLogInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, "delete", request.State, when, diags), diags
+}
+
+func (r lifecycleLogInterceptor) run(ctx context.Context, operation string, state tfsdk.State, when when, diags diag.Diagnostics) context.Context {
+	entry := conns.LifecycleLogEntry{
+		Operation:    operation,
+		ResourceType: r.typeName,
+	}
+
+	if !state.Raw.IsNull() {
+		var id fwtypes.String
+		// Not all resources have an "id" attribute.
+		if !state.GetAttribute(ctx, path.Root(names.AttrID), &id).HasError() {
+			entry.ID = id.ValueString()
+		}
+	}
+
+	switch when {
+	case Before:
+		now := time.Now()
+		ctx, _ = conns.NewLifecycleContext(ctx, now)
+
+		entry.Timestamp = now.UTC().Format(time.RFC3339Nano)
+		entry.Phase = conns.LifecyclePhaseStart
+	case Finally:
+		recorder, ok := conns.LifecycleRecorderFromContext(ctx)
+
+		if !ok {
+			return ctx
+		}
+
+		now := time.Now()
+		duration := now.Sub(recorder.Start()).Milliseconds()
+		retries := recorder.Retries()
+
+		entry.Timestamp = now.UTC().Format(time.RFC3339Nano)
+		entry.Phase = conns.LifecyclePhaseEnd
+		entry.DurationMS = &duration
+		entry.Calls = recorder.Calls()
+		entry.Retries = &retries
+
+		var errs []string
+		for _, v := range diags.Errors() {
+			errs = append(errs, v.Summary())
+		}
+		entry.Error = strings.Join(errs, "; ")
+	default:
+		return ctx
+	}
+
+	if err := conns.WriteLifecycleLogEntry(log.Writer(), entry); err != nil {
+		tflog.Warn(ctx, "writing lifecycle log entry", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx
+}
@@ -51,6 +51,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"check_service_quotas": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Warn at plan time when creating resources would exceed an applied Service Quotas limit, e.g. VPCs per Region. Requires permission to call the Service Quotas and EC2 Describe APIs.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -143,55 +147,7 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 			},
 		},
 		Blocks: map[string]schema.Block{
-			"assume_role": schema.ListNestedBlock{
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
-				NestedObject: schema.NestedBlockObject{
-					Attributes: map[string]schema.Attribute{
-						"duration": schema.StringAttribute{
-							CustomType:  fwtypes.DurationType,
-							Optional:    true,
-							Description: "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
-						},
-						"external_id": schema.StringAttribute{
-							Optional:    true,
-							Description: "A unique identifier that might be required when you assume a role in another account.",
-						},
-						"policy": schema.StringAttribute{
-							Optional:    true,
-							Description: "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
-						},
-						"policy_arns": schema.SetAttribute{
-							ElementType: types.StringType,
-							Optional:    true,
-							Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
-						},
-						"role_arn": schema.StringAttribute{
-							Optional:    true,
-							Description: "Amazon Resource Name (ARN) of an IAM Role to assume prior to making API calls.",
-						},
-						"session_name": schema.StringAttribute{
-							Optional:    true,
-							Description: "An identifier for the assumed role session.",
-						},
-						"source_identity": schema.StringAttribute{
-							Optional:    true,
-							Description: "Source identity specified by the principal assuming the role.",
-						},
-						"tags": schema.MapAttribute{
-							ElementType: types.StringType,
-							Optional:    true,
-							Description: "Assume role session tags.",
-						},
-						"transitive_tag_keys": schema.SetAttribute{
-							ElementType: types.StringType,
-							Optional:    true,
-							Description: "Assume role session tag keys to pass to any subsequent sessions.",
-						},
-					},
-				},
-			},
+			"assume_role": assumeRoleBlock(),
 			"assume_role_with_web_identity": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -236,6 +192,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"normalize_key_case": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Treat tag keys that differ only in case as the same key when merging default and resource tags",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -265,6 +225,43 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"read_assume_role": assumeRoleBlock(),
+			"retryable_error_codes": schema.ListNestedBlock{
+				Description: "Configuration block with additional AWS API error codes that are retried for a resource type.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"error_codes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							Description: "AWS API error codes to retry, e.g. `ResourceConflictException`.",
+						},
+						"resource_type": schema.StringAttribute{
+							Required:    true,
+							Description: "Resource type name, e.g. `aws_lambda_function`.",
+						},
+					},
+				},
+			},
+			"tag_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with tag requirements validated at plan time for all resources that support tags.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"required_keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tag keys that must be present on all resources.",
+						},
+						"value_patterns": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Map of resource tag keys to regular expressions that the tag's value must match.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -351,13 +348,19 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig.ForResource(servicePackageName, v.Name), meta.IgnoreTagsConfig)
+					ctx = conns.NewRetryableErrorCodesContext(ctx, meta.RetryableErrorCodes[typeName])
 				}
 
 				return ctx
 			}
 			interceptors := resourceInterceptors{}
 
+			// Lifecycle logging is first so that it wraps all other interceptors.
+			if conns.LifecycleLoggingEnabled() {
+				interceptors = append(interceptors, lifecycleLogInterceptor{typeName: typeName})
+			}
+
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
@@ -401,6 +404,59 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	return resources
 }
 
+// assumeRoleBlock returns the schema of the assume_role and read_assume_role blocks.
+func assumeRoleBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"duration": schema.StringAttribute{
+					CustomType:  fwtypes.DurationType,
+					Optional:    true,
+					Description: "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+				},
+				"external_id": schema.StringAttribute{
+					Optional:    true,
+					Description: "A unique identifier that might be required when you assume a role in another account.",
+				},
+				"policy": schema.StringAttribute{
+					Optional:    true,
+					Description: "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
+				},
+				"policy_arns": schema.SetAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+				},
+				"role_arn": schema.StringAttribute{
+					Optional:    true,
+					Description: "Amazon Resource Name (ARN) of an IAM Role to assume prior to making API calls.",
+				},
+				"session_name": schema.StringAttribute{
+					Optional:    true,
+					Description: "An identifier for the assumed role session.",
+				},
+				"source_identity": schema.StringAttribute{
+					Optional:    true,
+					Description: "Source identity specified by the principal assuming the role.",
+				},
+				"tags": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Description: "Assume role session tags.",
+				},
+				"transitive_tag_keys": schema.SetAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Description: "Assume role session tag keys to pass to any subsequent sessions.",
+				},
+			},
+		},
+	}
+}
+
 func endpointsBlock() schema.SetNestedBlock {
 	endpointsAttributes := make(map[string]schema.Attribute)
 
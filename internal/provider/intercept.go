@@ -29,7 +29,9 @@ type schemaResourceData interface {
 	GetRawState() cty.Value
 	HasChange(key string) bool
 	Id() string
+	IsNewResource() bool
 	Set(string, any) error
+	SetId(string)
 }
 
 // An interceptor is functionality invoked during the CRUD request lifecycle.
@@ -91,6 +93,9 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		var diags diag.Diagnostics
 		ctx = bootstrapContext(ctx, meta)
+		if why == Read {
+			ctx = conns.NewOperationContext(ctx, conns.OperationRead)
+		}
 		// Before interceptors are run first to last.
 		forward := interceptors.why(why)
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
@@ -93,3 +94,35 @@ func TestInterceptedHandler(t *testing.T) {
 		t.Errorf("length of diags = %v, want %v", got, want)
 	}
 }
+
+func TestInterceptedHandlerOperation(t *testing.T) {
+	t.Parallel()
+
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		return ctx
+	}
+
+	testCases := []struct {
+		why      why
+		expected conns.Operation
+	}{
+		{why: Create, expected: conns.OperationWrite},
+		{why: Read, expected: conns.OperationRead},
+		{why: Update, expected: conns.OperationWrite},
+		{why: Delete, expected: conns.OperationWrite},
+	}
+
+	for _, testCase := range testCases {
+		var got conns.Operation
+		var f schema.ReadContextFunc = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			got = conns.OperationFromContext(ctx)
+			return nil
+		}
+
+		interceptedHandler(bootstrapContext, nil, f, testCase.why)(context.Background(), nil, 42)
+
+		if got != testCase.expected {
+			t.Errorf("operation for %v = %v, want %v", testCase.why, got, testCase.expected)
+		}
+	}
+}
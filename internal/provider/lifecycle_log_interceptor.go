@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"io"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// lifecycleLogInterceptor emits structured (JSON) log entries at the start and end of each CRUD operation.
+type lifecycleLogInterceptor struct {
+	typeName   string
+	dataSource bool
+	writer     func() io.Writer
+	now        func() time.Time
+}
+
+func newLifecycleLogInterceptor(typeName string, dataSource bool) lifecycleLogInterceptor {
+	return lifecycleLogInterceptor{
+		typeName:   typeName,
+		dataSource: dataSource,
+		writer:     log.Writer,
+		now:        time.Now,
+	}
+}
+
+func (r lifecycleLogInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	entry := conns.LifecycleLogEntry{
+		Operation:    lifecycleOperation(why),
+		ResourceType: r.typeName,
+		ID:           d.Id(),
+		DataSource:   r.dataSource,
+	}
+
+	switch when {
+	case Before:
+		now := r.now()
+		ctx, _ = conns.NewLifecycleContext(ctx, now)
+
+		entry.Timestamp = now.UTC().Format(time.RFC3339Nano)
+		entry.Phase = conns.LifecyclePhaseStart
+	case Finally:
+		recorder, ok := conns.LifecycleRecorderFromContext(ctx)
+
+		if !ok {
+			return ctx, diags
+		}
+
+		now := r.now()
+		duration := now.Sub(recorder.Start()).Milliseconds()
+		retries := recorder.Retries()
+
+		entry.Timestamp = now.UTC().Format(time.RFC3339Nano)
+		entry.Phase = conns.LifecyclePhaseEnd
+		entry.DurationMS = &duration
+		entry.Calls = recorder.Calls()
+		entry.Retries = &retries
+
+		var errs []string
+		for _, v := range diags {
+			if v.Severity == diag.Error {
+				errs = append(errs, v.Summary)
+			}
+		}
+		entry.Error = strings.Join(errs, "; ")
+	default:
+		return ctx, diags
+	}
+
+	if err := conns.WriteLifecycleLogEntry(r.writer(), entry); err != nil {
+		log.Printf("[WARN] writing lifecycle log entry: %s", err)
+	}
+
+	return ctx, diags
+}
+
+func lifecycleOperation(why why) string {
+	switch why {
+	case Create:
+		return "create"
+	case Read:
+		return "read"
+	case Update:
+		return "update"
+	case Delete:
+		return "delete"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestLifecycleLogInterceptor(t *testing.T) {
+	t.Parallel()
+
+	var sb strings.Builder
+	start := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	interceptor := lifecycleLogInterceptor{
+		typeName: "aws_vpc",
+		writer:   func() io.Writer { return &sb },
+		now:      func() time.Time { return now },
+	}
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+	d.SetId("vpc-12345678")
+
+	ctx, diags := interceptor.run(context.Background(), d, nil, Before, Update, nil)
+
+	if _, ok := conns.LifecycleRecorderFromContext(ctx); !ok {
+		t.Fatal("expected lifecycle recorder in Context")
+	}
+
+	now = start.Add(1500 * time.Millisecond)
+	diags = append(diags, diag.Diagnostic{Severity: diag.Error, Summary: "updating VPC"})
+	_, _ = interceptor.run(ctx, d, nil, Finally, Update, diags)
+
+	lines := strings.Split(strings.TrimSpace(sb.String()), "\n")
+
+	if got, want := len(lines), 2; got != want {
+		t.Fatalf("log entries = %d, want %d", got, want)
+	}
+
+	var entries [2]conns.LifecycleLogEntry
+	for i, line := range lines {
+		if err := json.Unmarshal([]byte(line), &entries[i]); err != nil {
+			t.Fatalf("unmarshaling log entry %d: %s", i, err)
+		}
+	}
+
+	if got, want := entries[0].Phase, conns.LifecyclePhaseStart; got != want {
+		t.Errorf("start phase = %q, want %q", got, want)
+	}
+
+	if got, want := entries[0].Operation, "update"; got != want {
+		t.Errorf("operation = %q, want %q", got, want)
+	}
+
+	if got, want := entries[1].Phase, conns.LifecyclePhaseEnd; got != want {
+		t.Errorf("end phase = %q, want %q", got, want)
+	}
+
+	if entries[1].DurationMS == nil || *entries[1].DurationMS != 1500 {
+		t.Errorf("duration_ms = %v, want 1500", entries[1].DurationMS)
+	}
+
+	if got, want := entries[1].ID, "vpc-12345678"; got != want {
+		t.Errorf("id = %q, want %q", got, want)
+	}
+
+	if got, want := entries[1].Error, "updating VPC"; got != want {
+		t.Errorf("error = %q, want %q", got, want)
+	}
+}
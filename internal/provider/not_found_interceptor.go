@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// notFoundInterceptor removes a resource from state, with a warning, when its Read handler fails
+// after the AWS API operation that reads the remote object returned an error indicating that the object
+// no longer exists. Not found errors from any other operation called by the Read handler are ignored.
+type notFoundInterceptor struct {
+	typeName  string
+	operation string
+}
+
+func (r notFoundInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if why != Read {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		ctx, _ = conns.NewNotFoundContext(ctx)
+	case OnError:
+		// A resource that has just been created must exist.
+		if d.IsNewResource() {
+			return ctx, diags
+		}
+
+		recorder, ok := conns.NotFoundRecorderFromContext(ctx)
+
+		if !ok {
+			return ctx, diags
+		}
+
+		if !recorder.NotFound(r.operation) {
+			return ctx, diags
+		}
+
+		var warnings diag.Diagnostics
+		for _, v := range diags {
+			if v.Severity != diag.Error {
+				warnings = append(warnings, v)
+			}
+		}
+
+		log.Printf("[WARN] %s (%s) not found, removing from state", r.typeName, d.Id())
+		d.SetId("")
+
+		return ctx, warnings
+	}
+
+	return ctx, diags
+}
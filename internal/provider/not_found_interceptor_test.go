@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+type notFoundResourceData struct {
+	resourceData
+
+	id          string
+	newResource bool
+}
+
+func (d *notFoundResourceData) Id() string {
+	return d.id
+}
+
+func (d *notFoundResourceData) IsNewResource() bool {
+	return d.newResource
+}
+
+func (d *notFoundResourceData) SetId(id string) {
+	d.id = id
+}
+
+func TestNotFoundInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const (
+		operation   = "GetQueueAttributes"
+		notFoundErr = "QueueDoesNotExist.NotFound: Queue does not exist"
+	)
+
+	testCases := map[string]struct {
+		newResource   bool
+		recorded      []string
+		diags         diag.Diagnostics
+		expectRemoved bool
+		expectErrors  int
+	}{
+		"not found": {
+			recorded:      []string{operation},
+			diags:         diag.Errorf("reading Queue (q1): %s", notFoundErr),
+			expectRemoved: true,
+		},
+		"not found with warning": {
+			recorded: []string{operation},
+			diags: append(diag.Diagnostics{{Severity: diag.Warning, Summary: "deprecated"}},
+				diag.Errorf("reading Queue (q1): %s", notFoundErr)...),
+			expectRemoved: true,
+		},
+		"new resource": {
+			newResource:  true,
+			recorded:     []string{operation},
+			diags:        diag.Errorf("reading Queue (q1): %s", notFoundErr),
+			expectErrors: 1,
+		},
+		"nothing recorded": {
+			diags:        diag.Errorf("reading Queue (q1): %s", notFoundErr),
+			expectErrors: 1,
+		},
+		"other operation not found": {
+			recorded:     []string{"ListDeadLetterSourceQueues"},
+			diags:        diag.Errorf("reading Queue (q1) dead-letter sources: %s", notFoundErr),
+			expectErrors: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			interceptor := notFoundInterceptor{typeName: "aws_sqs_queue", operation: operation}
+			d := &notFoundResourceData{id: "q1", newResource: testCase.newResource}
+
+			ctx, _ := interceptor.run(context.Background(), d, nil, Before, Read, nil)
+
+			recorder, ok := conns.NotFoundRecorderFromContext(ctx)
+
+			if !ok {
+				t.Fatal("no NotFoundRecorder in Context")
+			}
+
+			for _, v := range testCase.recorded {
+				recorder.Record(v)
+			}
+
+			_, diags := interceptor.run(ctx, d, nil, OnError, Read, testCase.diags)
+
+			if got, want := d.id == "", testCase.expectRemoved; got != want {
+				t.Errorf("removed = %t, want %t", got, want)
+			}
+
+			n := 0
+			for _, v := range diags {
+				if v.Severity == diag.Error {
+					n++
+				}
+			}
+
+			if got, want := n, testCase.expectErrors; got != want {
+				t.Errorf("errors = %d, want %d", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"golang.org/x/exp/slices"
+)
+
+// partitionInterceptor fails a data source's Read with an actionable error before any AWS API call is made
+// if the data source is not available in the AWS partition the provider is configured for.
+type partitionInterceptor struct {
+	typeName   string
+	partitions []string
+}
+
+func (r partitionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when != Before {
+		return ctx, diags
+	}
+
+	if err := checkPartition(meta, r.typeName, r.partitions); err != nil {
+		return ctx, sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return ctx, diags
+}
+
+// partitionCustomizeDiff returns a CustomizeDiff function that fails the plan of a new resource
+// if the resource is not available in the AWS partition the provider is configured for.
+func partitionCustomizeDiff(typeName string, partitions []string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		// Existing resources must have been created in a supported partition.
+		if d.Id() != "" {
+			return nil
+		}
+
+		return checkPartition(meta, typeName, partitions)
+	}
+}
+
+// checkPartition returns an error if the AWS partition the provider is configured for is not one of the specified partitions.
+func checkPartition(meta any, typeName string, partitions []string) error {
+	c, ok := meta.(*conns.AWSClient)
+
+	if !ok || c.Partition == "" {
+		return nil
+	}
+
+	if slices.Contains(partitions, c.Partition) {
+		return nil
+	}
+
+	return fmt.Errorf("%s is not available in AWS partition %q (Region %q); it is only available in AWS partition(s) %s. "+
+		"Use a provider configured for a supported Region, or check the AWS Regional Services List (https://aws.amazon.com/about-aws/global-infrastructure/regional-product-services/) for availability",
+		typeName, c.Partition, c.Region, strings.Join(partitions, ", "))
+}
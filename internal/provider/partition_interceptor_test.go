@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestPartitionInterceptor(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		partition    string
+		partitions   []string
+		expectErrors int
+	}{
+		"supported partition": {
+			partition:  "aws",
+			partitions: []string{"aws", "aws-cn"},
+		},
+		"unsupported partition": {
+			partition:    "aws-us-gov",
+			partitions:   []string{"aws", "aws-cn"},
+			expectErrors: 1,
+		},
+		"unknown partition": {
+			partitions: []string{"aws"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			meta := &conns.AWSClient{Partition: testCase.partition, Region: "us-gov-west-1"} //lintignore:AWSAT003
+			interceptor := partitionInterceptor{typeName: "aws_test_thing", partitions: testCase.partitions}
+
+			var diags diag.Diagnostics
+			_, diags = interceptor.run(ctx, &notFoundResourceData{}, meta, Before, Read, diags)
+
+			if got, want := len(diags), testCase.expectErrors; got != want {
+				t.Errorf("length of diags = %d, want %d", got, want)
+			}
+
+			_, diags = interceptor.run(ctx, &notFoundResourceData{}, meta, After, Read, nil)
+
+			if got, want := len(diags), 0; got != want {
+				t.Errorf("After: length of diags = %d, want %d", got, want)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -48,6 +49,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"check_service_quotas": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Warn at plan time when creating resources would exceed an applied Service Quotas limit, " +
+					"e.g. VPCs per Region. Requires permission to call the Service Quotas and EC2 Describe APIs.",
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -62,6 +69,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"normalize_key_case": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Treat tag keys that differ only in case as the same key when merging default and resource tags",
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -137,6 +149,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"read_assume_role": assumeRoleSchema(),
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -149,6 +162,26 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. " +
 					"Can also be configured using the `AWS_RETRY_MODE` environment variable.",
 			},
+			"retryable_error_codes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration block with additional AWS API error codes that are retried for a resource type.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"error_codes": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "AWS API error codes to retry, e.g. `ResourceConflictException`.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Resource type name, e.g. `aws_lambda_function`.",
+						},
+					},
+				},
+			},
 			"s3_use_path_style": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -206,6 +239,28 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with tag requirements validated at plan time for all resources that support tags.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"required_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tag keys that must be present on all resources.",
+						},
+						"value_patterns": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Map of resource tag keys to regular expressions that the tag's value must match.",
+						},
+					},
+				},
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -242,7 +297,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 		servicePackageName := sp.ServicePackageName()
 		servicePackageMap[servicePackageName] = sp
 
-		for _, v := range sp.SDKDataSources(ctx) {
+		for _, v := range withSDKDataSourceAliases(sp.SDKDataSources(ctx)) {
 			v := v
 			typeName := v.TypeName
 
@@ -259,6 +314,10 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				continue
 			}
 
+			if v, ok := findTypeNameAlias(dataSourceTypeAliases, typeName); ok && v.deprecated {
+				r.DeprecationMessage = v.deprecationMessage()
+			}
+
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
@@ -269,6 +328,23 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				return ctx
 			}
 			interceptors := interceptorItems{}
+
+			if conns.LifecycleLoggingEnabled() {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | Finally,
+					why:         Read,
+					interceptor: newLifecycleLogInterceptor(typeName, true),
+				})
+			}
+
+			if len(v.Partitions) > 0 {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before,
+					why:         Read,
+					interceptor: partitionInterceptor{typeName: typeName, partitions: v.Partitions},
+				})
+			}
+
 			ds := &wrappedDataSource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -281,7 +357,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			provider.DataSourcesMap[typeName] = r
 		}
 
-		for _, v := range sp.SDKResources(ctx) {
+		for _, v := range withSDKResourceAliases(sp.SDKResources(ctx)) {
 			v := v
 			typeName := v.TypeName
 
@@ -310,17 +386,67 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				continue
 			}
 
+			if v, ok := findTypeNameAlias(resourceTypeAliases, typeName); ok && v.deprecated {
+				r.DeprecationMessage = v.deprecationMessage()
+			}
+
+			resourceName := v.Name
+
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, resourceName)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig.ForResource(servicePackageName, resourceName), v.IgnoreTagsConfig)
+					ctx = conns.NewRetryableErrorCodesContext(ctx, v.RetryableErrorCodes[typeName])
 				}
 
 				return ctx
 			}
 			interceptors := interceptorItems{}
 
+			// Lifecycle logging is first so that it wraps all other interceptors.
+			if conns.LifecycleLoggingEnabled() {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | Finally,
+					why:         AllOps,
+					interceptor: newLifecycleLogInterceptor(typeName, false),
+				})
+			}
+
+			if attribute := v.EndpointDriftAttribute; attribute != "" {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | Finally,
+					why:         Read,
+					interceptor: endpointDriftInterceptor{typeName: typeName, arnAttribute: attribute},
+				})
+			}
+
+			if operation := v.NotFoundOperation; operation != "" {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | OnError,
+					why:         Read,
+					interceptor: notFoundInterceptor{typeName: typeName, operation: operation},
+				})
+			}
+
+			if partitions := v.Partitions; len(partitions) > 0 {
+				// Fail at plan time if the resource is not available in the configured partition.
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(partitionCustomizeDiff(typeName, partitions), v)
+				} else {
+					r.CustomizeDiff = partitionCustomizeDiff(typeName, partitions)
+				}
+			}
+
+			if attributes := v.ARNAttributes; len(attributes) > 0 {
+				// Fail at plan time if a referenced ARN is in a different partition from the configured one.
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(verify.ARNPartitionDiff(attributes...), v)
+				} else {
+					r.CustomizeDiff = verify.ARNPartitionDiff(attributes...)
+				}
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -354,6 +480,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 						readFunc:   tagsReadFunc,
 					},
 				})
+
+				// Validate planned tags against any provider configured tag policy.
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(v, tagsPolicyCustomizeDiff)
+				} else {
+					r.CustomizeDiff = tagsPolicyCustomizeDiff
+				}
 			}
 
 			rs := &wrappedResource{
@@ -391,6 +524,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 		}
 	}
 
+	errs = multierror.Append(errs, validateTypeNameAliases(dataSourceTypeAliases, func(typeName string) bool {
+		_, ok := provider.DataSourcesMap[typeName]
+		return ok
+	}, "data source")...)
+	errs = multierror.Append(errs, validateTypeNameAliases(resourceTypeAliases, func(typeName string) bool {
+		_, ok := provider.ResourcesMap[typeName]
+		return ok
+	}, "resource")...)
+
 	if err := errs.ErrorOrNil(); err != nil {
 		return nil, err
 	}
@@ -421,6 +563,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		CheckServiceQuotas:             d.Get("check_service_quotas").(bool),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -464,6 +607,16 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		})
 	}
 
+	if v, ok := d.GetOk("read_assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.ReadAssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
+		tflog.Info(ctx, "read_assume_role configuration set", map[string]any{
+			"tf_aws.read_assume_role.role_arn":        config.ReadAssumeRole.RoleARN,
+			"tf_aws.read_assume_role.session_name":    config.ReadAssumeRole.SessionName,
+			"tf_aws.read_assume_role.external_id":     config.ReadAssumeRole.ExternalID,
+			"tf_aws.read_assume_role.source_identity": config.ReadAssumeRole.SourceIdentity,
+		})
+	}
+
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.AssumeRoleWithWebIdentity = expandAssumeRoleWithWebIdentity(ctx, v.([]interface{})[0].(map[string]interface{}))
 		tflog.Info(ctx, "assume_role_with_web_identity configuration set", map[string]any{
@@ -498,6 +651,20 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("retryable_error_codes"); ok && len(v.([]interface{})) > 0 {
+		config.RetryableErrorCodes = expandRetryableErrorCodes(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		policyConfig, err := expandTagPolicy(v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.TagPolicyConfig = policyConfig
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -771,6 +938,10 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 
 	defaultConfig := &tftags.DefaultConfig{}
 
+	if v, ok := tfMap["normalize_key_case"].(bool); ok {
+		defaultConfig.NormalizeKeyCase = v
+	}
+
 	if v, ok := tfMap["tags"].(map[string]interface{}); ok {
 		defaultConfig.Tags = tftags.New(ctx, v)
 	}
@@ -796,6 +967,58 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandTagPolicy(tfMap map[string]interface{}) (*tftags.PolicyConfig, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	policyConfig := &tftags.PolicyConfig{}
+
+	if v, ok := tfMap["required_keys"].(*schema.Set); ok {
+		policyConfig.RequiredKeys = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["value_patterns"].(map[string]interface{}); ok && len(v) > 0 {
+		policyConfig.ValuePatterns = make(map[string]*regexp.Regexp, len(v))
+
+		for k, v := range v {
+			re, err := regexp.Compile(v.(string))
+
+			if err != nil {
+				return nil, fmt.Errorf("tag_policy value_patterns (%s): %w", k, err)
+			}
+
+			policyConfig.ValuePatterns[k] = re
+		}
+	}
+
+	return policyConfig, nil
+}
+
+func expandRetryableErrorCodes(tfList []interface{}) map[string][]string {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	codes := make(map[string][]string)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		typeName := tfMap["resource_type"].(string)
+
+		if v, ok := tfMap["error_codes"].(*schema.Set); ok {
+			codes[typeName] = append(codes[typeName], flex.ExpandStringValueSet(v)...)
+		}
+	}
+
+	return codes
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, error) {
 	if len(tfList) == 0 {
 		return nil, nil
@@ -9,6 +9,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -53,6 +56,66 @@ func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandTagPolicy(t *testing.T) {
+	t.Parallel()
+
+	policyConfig, err := expandTagPolicy(map[string]interface{}{
+		"required_keys": schema.NewSet(schema.HashString, []interface{}{"Owner"}),
+		"value_patterns": map[string]interface{}{
+			"CostCenter": "^[0-9]{4}$",
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if got, want := policyConfig.RequiredKeys, []string{"Owner"}; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("Expected required keys %v, got %v", want, got)
+	}
+
+	if re, ok := policyConfig.ValuePatterns["CostCenter"]; !ok || !re.MatchString("1234") || re.MatchString("abc") {
+		t.Errorf("Unexpected value patterns: %v", policyConfig.ValuePatterns)
+	}
+
+	_, err = expandTagPolicy(map[string]interface{}{
+		"value_patterns": map[string]interface{}{
+			"CostCenter": "[",
+		},
+	})
+
+	if err == nil {
+		t.Error("Expected error for invalid pattern")
+	}
+}
+
+func TestExpandRetryableErrorCodes(t *testing.T) {
+	t.Parallel()
+
+	codes := expandRetryableErrorCodes([]interface{}{
+		map[string]interface{}{
+			"error_codes":   schema.NewSet(schema.HashString, []interface{}{"ResourceConflictException"}),
+			"resource_type": "aws_lambda_function",
+		},
+		map[string]interface{}{
+			"error_codes":   schema.NewSet(schema.HashString, []interface{}{"DependencyViolation"}),
+			"resource_type": "aws_security_group",
+		},
+	})
+
+	if got, want := len(codes), 2; got != want {
+		t.Fatalf("Expected %d resource types, got %d", want, got)
+	}
+
+	if got, want := codes["aws_lambda_function"], []string{"ResourceConflictException"}; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("Expected error codes %v, got %v", want, got)
+	}
+
+	if got, want := codes["aws_security_group"], []string{"DependencyViolation"}; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("Expected error codes %v, got %v", want, got)
+	}
+}
+
 func TestEndpointMultipleKeys(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := []struct {
@@ -205,3 +268,99 @@ func popEnv(env []string) {
 		os.Setenv(k, v)
 	}
 }
+
+func TestProviderTypeNameAliases(t *testing.T) {
+	t.Parallel()
+
+	p, err := New(context.Background())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range resourceTypeAliases {
+		former, ok := p.ResourcesMap[v.former]
+		if !ok {
+			t.Errorf("resource alias %s is not registered", v.former)
+			continue
+		}
+
+		if got, want := former.CoreConfigSchema().ImpliedType(), p.ResourcesMap[v.current].CoreConfigSchema().ImpliedType(); !got.Equals(want) {
+			t.Errorf("resource alias %s schema differs from %s", v.former, v.current)
+		}
+
+		if got, want := former.DeprecationMessage != "", v.deprecated; got != want {
+			t.Errorf("resource alias %s deprecated = %t, want %t", v.former, got, want)
+		}
+	}
+
+	for _, v := range dataSourceTypeAliases {
+		former, ok := p.DataSourcesMap[v.former]
+		if !ok {
+			t.Errorf("data source alias %s is not registered", v.former)
+			continue
+		}
+
+		if got, want := former.CoreConfigSchema().ImpliedType(), p.DataSourcesMap[v.current].CoreConfigSchema().ImpliedType(); !got.Equals(want) {
+			t.Errorf("data source alias %s schema differs from %s", v.former, v.current)
+		}
+	}
+}
+
+func TestProviderResourcePartitions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	p, err := New(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const typeName = "aws_chime_voice_connector_logging"
+	r, ok := p.ResourcesMap[typeName]
+
+	if !ok {
+		t.Fatalf("resource %s is not registered", typeName)
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]any{
+		"voice_connector_id": "abcdef1ghij2klmno3pqr4",
+	})
+
+	testCases := map[string]struct {
+		partition   string
+		region      string
+		expectError bool
+	}{
+		"supported partition": {
+			partition: "aws",
+			region:    "us-east-1", //lintignore:AWSAT003
+		},
+		"unsupported partition": {
+			partition:   "aws-us-gov",
+			region:      "us-gov-west-1", //lintignore:AWSAT003
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			meta := &conns.AWSClient{Partition: testCase.partition, Region: testCase.region}
+
+			_, err := r.Diff(ctx, nil, config, meta)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, want error %t", err, want)
+			}
+
+			if err != nil && !strings.Contains(err.Error(), "is not available in AWS partition") {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,515 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// quotaCheck describes a Service Quotas limit that is checked at plan time
+// against the number of resources of a given type that the plan creates.
+type quotaCheck struct {
+	// description is the human-friendly name of the limited resource, e.g. "VPCs".
+	description string
+	serviceCode string
+	quotaCode   string
+	// scope returns the key that the quota applies to (e.g. a security group ID and direction)
+	// from the planned resource's attributes. An empty key means the quota applies to the whole Region.
+	// false is returned if the scope cannot be determined, e.g. because a value is not yet known.
+	scope func(attrs map[string]tftypes.Value) (string, bool)
+	// count returns the number of quota units used by the planned resource.
+	count func(attrs map[string]tftypes.Value) int
+	// usage returns the number of quota units currently in use for the specified scope.
+	usage func(ctx context.Context, meta *conns.AWSClient, scope string) (int, error)
+}
+
+var (
+	quotaCheckVPCsPerRegion = quotaCheck{
+		description: "VPCs",
+		serviceCode: "vpc",
+		quotaCode:   "L-F678F1CE",
+		scope:       quotaScopeRegion,
+		count:       quotaCountOne,
+		usage:       vpcsInUse,
+	}
+	quotaCheckElasticIPs = quotaCheck{
+		description: "Elastic IP addresses",
+		serviceCode: "ec2",
+		quotaCode:   "L-0263D0A3",
+		scope:       quotaScopeRegion,
+		count:       quotaCountOne,
+		usage:       elasticIPsInUse,
+	}
+	quotaCheckSecurityGroupRules = quotaCheck{
+		description: "security group rules",
+		serviceCode: "vpc",
+		quotaCode:   "L-0EA8095F",
+		scope:       quotaScopeSecurityGroupRule(""),
+		count:       quotaCountSecurityGroupRule,
+		usage:       securityGroupRulesInUse,
+	}
+	quotaCheckSecurityGroupEgressRules = quotaCheck{
+		description: "security group rules",
+		serviceCode: "vpc",
+		quotaCode:   "L-0EA8095F",
+		scope:       quotaScopeSecurityGroupRule(securityGroupRuleTypeEgress),
+		count:       quotaCountOne,
+		usage:       securityGroupRulesInUse,
+	}
+	quotaCheckSecurityGroupIngressRules = quotaCheck{
+		description: "security group rules",
+		serviceCode: "vpc",
+		quotaCode:   "L-0EA8095F",
+		scope:       quotaScopeSecurityGroupRule(securityGroupRuleTypeIngress),
+		count:       quotaCountOne,
+		usage:       securityGroupRulesInUse,
+	}
+)
+
+const (
+	securityGroupRuleTypeEgress  = "egress"
+	securityGroupRuleTypeIngress = "ingress"
+
+	securityGroupRuleScopeSeparator = "/"
+)
+
+// quotaChecks maps resource type names to the Service Quotas limits checked when planning their creation.
+var quotaChecks = map[string]quotaCheck{
+	"aws_eip":                             quotaCheckElasticIPs,
+	"aws_security_group_rule":             quotaCheckSecurityGroupRules,
+	"aws_vpc":                             quotaCheckVPCsPerRegion,
+	"aws_vpc_security_group_egress_rule":  quotaCheckSecurityGroupEgressRules,
+	"aws_vpc_security_group_ingress_rule": quotaCheckSecurityGroupIngressRules,
+}
+
+// quotaCheckProviderServer wraps a protocol v5 provider server.
+// When enabled via the provider's `check_service_quotas` argument, PlanResourceChange
+// returns a warning if the planned creations would exceed an applied Service Quotas limit.
+type quotaCheckProviderServer struct {
+	tfprotov5.ProviderServer
+
+	checker *quotaChecker
+	meta    func() any
+}
+
+func newQuotaCheckProviderServer(server tfprotov5.ProviderServer, meta func() any) tfprotov5.ProviderServer {
+	return &quotaCheckProviderServer{
+		ProviderServer: server,
+		checker:        newQuotaChecker(findAppliedQuotaValue),
+		meta:           meta,
+	}
+}
+
+// ConfigureProvider is called at the start of each Terraform operation, so it resets any counts from a previous plan.
+func (s *quotaCheckProviderServer) ConfigureProvider(ctx context.Context, req *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
+	s.checker.reset()
+
+	return s.ProviderServer.ConfigureProvider(ctx, req)
+}
+
+func (s *quotaCheckProviderServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	check, ok := quotaChecks[req.TypeName]
+	if !ok {
+		return resp, nil
+	}
+
+	meta, ok := s.meta().(*conns.AWSClient)
+	if !ok || !meta.CheckServiceQuotas {
+		return resp, nil
+	}
+
+	for _, v := range resp.Diagnostics {
+		if v.Severity == tfprotov5.DiagnosticSeverityError {
+			return resp, nil
+		}
+	}
+
+	attrs, ok := s.plannedCreate(ctx, req, resp)
+	if !ok {
+		return resp, nil
+	}
+
+	if diag := s.checker.check(ctx, meta, check, attrs); diag != nil {
+		resp.Diagnostics = append(resp.Diagnostics, diag)
+	}
+
+	return resp, nil
+}
+
+// plannedCreate returns the planned attribute values if the plan creates a new resource.
+func (s *quotaCheckProviderServer) plannedCreate(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest, resp *tfprotov5.PlanResourceChangeResponse) (map[string]tftypes.Value, bool) {
+	typ, err := s.checker.resourceType(ctx, s.ProviderServer, req.TypeName)
+	if err != nil {
+		log.Printf("[WARN] Service Quotas check (%s): %s", req.TypeName, err)
+		return nil, false
+	}
+
+	if req.PriorState != nil {
+		prior, err := req.PriorState.Unmarshal(typ)
+		if err != nil || !prior.IsNull() {
+			return nil, false
+		}
+	}
+
+	if resp.PlannedState == nil {
+		return nil, false
+	}
+
+	planned, err := resp.PlannedState.Unmarshal(typ)
+	if err != nil || planned.IsNull() || !planned.IsKnown() {
+		return nil, false
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := planned.As(&attrs); err != nil {
+		return nil, false
+	}
+
+	return attrs, true
+}
+
+type quotaValueFunc func(ctx context.Context, meta *conns.AWSClient, serviceCode, quotaCode string) (float64, error)
+
+// quotaLookup memoizes the result of a single lookup.
+// Concurrent callers wait for the first call to complete instead of repeating it.
+type quotaLookup[T any] struct {
+	once  sync.Once
+	value T
+	err   error
+}
+
+func (l *quotaLookup[T]) get(f func() (T, error)) (T, error) {
+	l.once.Do(func() {
+		l.value, l.err = f()
+	})
+
+	return l.value, l.err
+}
+
+// quotaChecker accumulates the resources created by a single plan.
+// Terraform plans resources concurrently so all state is protected by a mutex.
+// Lookups are made without holding the mutex so that they don't serialize unrelated plans.
+type quotaChecker struct {
+	quotaValue quotaValueFunc
+	types      quotaLookup[map[string]tftypes.Type]
+
+	mu      sync.Mutex
+	quotas  map[string]*quotaLookup[float64]
+	usage   map[string]*quotaLookup[int]
+	pending map[string]int
+	warned  map[string]bool
+}
+
+func newQuotaChecker(quotaValue quotaValueFunc) *quotaChecker {
+	return &quotaChecker{
+		quotaValue: quotaValue,
+		quotas:     make(map[string]*quotaLookup[float64]),
+		usage:      make(map[string]*quotaLookup[int]),
+		pending:    make(map[string]int),
+		warned:     make(map[string]bool),
+	}
+}
+
+func (c *quotaChecker) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.quotas = make(map[string]*quotaLookup[float64])
+	c.usage = make(map[string]*quotaLookup[int])
+	c.pending = make(map[string]int)
+	c.warned = make(map[string]bool)
+}
+
+func (c *quotaChecker) resourceType(ctx context.Context, server tfprotov5.ProviderServer, typeName string) (tftypes.Type, error) {
+	types, err := c.types.get(func() (map[string]tftypes.Type, error) {
+		resp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+		if err != nil {
+			return nil, err
+		}
+
+		types := make(map[string]tftypes.Type, len(resp.ResourceSchemas))
+		for name, schema := range resp.ResourceSchemas {
+			types[name] = schema.ValueType()
+		}
+
+		return types, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	v, ok := types[typeName]
+	if !ok {
+		return nil, fmt.Errorf("no schema found for resource type %s", typeName)
+	}
+
+	return v, nil
+}
+
+// lookups returns the quota and usage lookups for the specified keys, creating them if necessary.
+func (c *quotaChecker) lookups(quotaKey, usageKey string) (*quotaLookup[float64], *quotaLookup[int]) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	quota, ok := c.quotas[quotaKey]
+	if !ok {
+		quota = &quotaLookup[float64]{}
+		c.quotas[quotaKey] = quota
+	}
+
+	usage, ok := c.usage[usageKey]
+	if !ok {
+		usage = &quotaLookup[int]{}
+		c.usage[usageKey] = usage
+	}
+
+	return quota, usage
+}
+
+// check records the planned creation and returns a warning the first time the
+// number of planned resources plus those already in use exceeds the quota.
+func (c *quotaChecker) check(ctx context.Context, meta *conns.AWSClient, check quotaCheck, attrs map[string]tftypes.Value) *tfprotov5.Diagnostic {
+	scope, ok := check.scope(attrs)
+	if !ok {
+		return nil
+	}
+
+	quotaKey := check.serviceCode + "/" + check.quotaCode
+	usageKey := quotaKey + "/" + scope
+	quotaEntry, usageEntry := c.lookups(quotaKey, usageKey)
+
+	quota, err := quotaEntry.get(func() (float64, error) {
+		return c.quotaValue(ctx, meta, check.serviceCode, check.quotaCode)
+	})
+	if err != nil {
+		log.Printf("[WARN] Service Quotas check (%s): reading quota: %s", quotaKey, err)
+		return nil
+	}
+
+	usage, err := usageEntry.get(func() (int, error) {
+		return check.usage(ctx, meta, scope)
+	})
+	if err != nil {
+		log.Printf("[WARN] Service Quotas check (%s): reading usage: %s", usageKey, err)
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.pending[usageKey] += check.count(attrs)
+	pending := c.pending[usageKey]
+
+	if float64(usage+pending) <= quota || c.warned[usageKey] {
+		return nil
+	}
+
+	c.warned[usageKey] = true
+
+	detail := fmt.Sprintf("This plan creates at least %d %s", pending, check.description)
+	if scope != "" {
+		detail += fmt.Sprintf(" for %s", scope)
+	}
+	detail += fmt.Sprintf(". Together with the %d already in use, this exceeds the applied Service Quotas limit of %.0f (service code %q, quota code %q) and the apply is likely to fail. Request a quota increase or reduce the number of resources.", usage, quota, check.serviceCode, check.quotaCode)
+
+	return &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityWarning,
+		Summary:  fmt.Sprintf("Service Quotas limit for %s may be exceeded", check.description),
+		Detail:   detail,
+	}
+}
+
+func quotaScopeRegion(map[string]tftypes.Value) (string, bool) {
+	return "", true
+}
+
+func quotaCountOne(map[string]tftypes.Value) int {
+	return 1
+}
+
+// quotaScopeSecurityGroupRule returns a scope function that returns the security group ID and rule type,
+// as the rules per security group quota applies separately to inbound and outbound rules.
+// If ruleType is empty the rule type is read from the `type` attribute.
+func quotaScopeSecurityGroupRule(ruleType string) func(map[string]tftypes.Value) (string, bool) {
+	return func(attrs map[string]tftypes.Value) (string, bool) {
+		groupID, ok := quotaStringValue(attrs, "security_group_id")
+		if !ok || groupID == "" {
+			return "", false
+		}
+
+		ruleType := ruleType
+		if ruleType == "" {
+			if ruleType, ok = quotaStringValue(attrs, "type"); !ok {
+				return "", false
+			}
+		}
+
+		return groupID + securityGroupRuleScopeSeparator + ruleType, true
+	}
+}
+
+// quotaCountSecurityGroupRule returns the number of security group rules that the resource creates.
+// aws_security_group_rule creates one rule per CIDR block or prefix list.
+func quotaCountSecurityGroupRule(attrs map[string]tftypes.Value) int {
+	n := 0
+
+	for _, k := range []string{"cidr_blocks", "ipv6_cidr_blocks", "prefix_list_ids"} {
+		v, ok := attrs[k]
+		if !ok || v.IsNull() || !v.IsKnown() {
+			continue
+		}
+
+		var elems []tftypes.Value
+		if err := v.As(&elems); err == nil {
+			n += len(elems)
+		}
+	}
+
+	if n == 0 {
+		n = 1
+	}
+
+	return n
+}
+
+func quotaStringValue(attrs map[string]tftypes.Value, k string) (string, bool) {
+	v, ok := attrs[k]
+	if !ok || v.IsNull() || !v.IsKnown() {
+		return "", false
+	}
+
+	var s string
+	if err := v.As(&s); err != nil {
+		return "", false
+	}
+
+	return s, true
+}
+
+// findAppliedQuotaValue returns the value of the quota applied to the account,
+// falling back to the AWS default value if no quota has been applied.
+func findAppliedQuotaValue(ctx context.Context, meta *conns.AWSClient, serviceCode, quotaCode string) (float64, error) {
+	conn := meta.ServiceQuotasConn(ctx)
+
+	output, err := conn.GetServiceQuotaWithContext(ctx, &servicequotas.GetServiceQuotaInput{
+		QuotaCode:   aws.String(quotaCode),
+		ServiceCode: aws.String(serviceCode),
+	})
+
+	if tfawserr.ErrCodeEquals(err, servicequotas.ErrCodeNoSuchResourceException) {
+		output, err := conn.GetAWSDefaultServiceQuotaWithContext(ctx, &servicequotas.GetAWSDefaultServiceQuotaInput{
+			QuotaCode:   aws.String(quotaCode),
+			ServiceCode: aws.String(serviceCode),
+		})
+
+		if err != nil {
+			return 0, err
+		}
+
+		if output == nil || output.Quota == nil || output.Quota.Value == nil {
+			return 0, fmt.Errorf("empty result")
+		}
+
+		return aws.Float64Value(output.Quota.Value), nil
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	if output == nil || output.Quota == nil || output.Quota.Value == nil {
+		return 0, fmt.Errorf("empty result")
+	}
+
+	return aws.Float64Value(output.Quota.Value), nil
+}
+
+func vpcsInUse(ctx context.Context, meta *conns.AWSClient, _ string) (int, error) {
+	n := 0
+
+	err := meta.EC2Conn(ctx).DescribeVpcsPagesWithContext(ctx, &ec2.DescribeVpcsInput{}, func(page *ec2.DescribeVpcsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		n += len(page.Vpcs)
+
+		return !lastPage
+	})
+
+	return n, err
+}
+
+func elasticIPsInUse(ctx context.Context, meta *conns.AWSClient, _ string) (int, error) {
+	output, err := meta.EC2Conn(ctx).DescribeAddressesWithContext(ctx, &ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("domain"),
+				Values: aws.StringSlice([]string{ec2.DomainTypeVpc}),
+			},
+		},
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return len(output.Addresses), nil
+}
+
+func securityGroupRulesInUse(ctx context.Context, meta *conns.AWSClient, scope string) (int, error) {
+	groupID, ruleType, ok := strings.Cut(scope, securityGroupRuleScopeSeparator)
+	if !ok {
+		return 0, fmt.Errorf("unexpected security group rule scope: %s", scope)
+	}
+	isEgress := ruleType == securityGroupRuleTypeEgress
+
+	n := 0
+
+	err := meta.EC2Conn(ctx).DescribeSecurityGroupRulesPagesWithContext(ctx, &ec2.DescribeSecurityGroupRulesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("group-id"),
+				Values: aws.StringSlice([]string{groupID}),
+			},
+		},
+	}, func(page *ec2.DescribeSecurityGroupRulesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SecurityGroupRules {
+			if aws.BoolValue(v.IsEgress) == isEgress {
+				n++
+			}
+		}
+
+		return !lastPage
+	})
+
+	// A security group created by the same plan doesn't exist yet.
+	if tfawserr.ErrCodeEquals(err, "InvalidGroup.NotFound") {
+		return 0, nil
+	}
+
+	return n, err
+}
@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestQuotaCheckerCheck(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	checker := newQuotaChecker(func(context.Context, *conns.AWSClient, string, string) (float64, error) {
+		return 5, nil
+	})
+	usageCalls := 0
+	check := quotaCheck{
+		description: "widgets",
+		serviceCode: "svc",
+		quotaCode:   "L-12345678",
+		scope:       quotaScopeRegion,
+		count:       quotaCountOne,
+		usage: func(context.Context, *conns.AWSClient, string) (int, error) {
+			usageCalls++
+			return 3, nil
+		},
+	}
+
+	// 3 in use + 2 planned == quota.
+	for i := 0; i < 2; i++ {
+		if diag := checker.check(ctx, nil, check, nil); diag != nil {
+			t.Fatalf("unexpected warning for planned resource %d: %s", i+1, diag.Detail)
+		}
+	}
+
+	if diag := checker.check(ctx, nil, check, nil); diag == nil {
+		t.Fatal("expected warning when quota exceeded")
+	}
+
+	// Only warn once per quota.
+	if diag := checker.check(ctx, nil, check, nil); diag != nil {
+		t.Errorf("unexpected second warning: %s", diag.Detail)
+	}
+
+	if usageCalls != 1 {
+		t.Errorf("usage called %d times, want 1", usageCalls)
+	}
+
+	checker.reset()
+
+	if diag := checker.check(ctx, nil, check, nil); diag != nil {
+		t.Errorf("unexpected warning after reset: %s", diag.Detail)
+	}
+}
+
+func TestQuotaCheckerCheckConcurrent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var quotaCalls, usageCalls int32
+	checker := newQuotaChecker(func(context.Context, *conns.AWSClient, string, string) (float64, error) {
+		atomic.AddInt32(&quotaCalls, 1)
+		return 100, nil
+	})
+	check := quotaCheck{
+		description: "widgets",
+		serviceCode: "svc",
+		quotaCode:   "L-12345678",
+		scope:       quotaScopeRegion,
+		count:       quotaCountOne,
+		usage: func(context.Context, *conns.AWSClient, string) (int, error) {
+			atomic.AddInt32(&usageCalls, 1)
+			return 95, nil
+		},
+	}
+
+	var wg sync.WaitGroup
+	var warnings int32
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if diag := checker.check(ctx, nil, check, nil); diag != nil {
+				atomic.AddInt32(&warnings, 1)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if quotaCalls != 1 {
+		t.Errorf("quota value read %d times, want 1", quotaCalls)
+	}
+
+	if usageCalls != 1 {
+		t.Errorf("usage read %d times, want 1", usageCalls)
+	}
+
+	if warnings != 1 {
+		t.Errorf("got %d warnings, want 1", warnings)
+	}
+}
+
+func TestQuotaScopeSecurityGroupRule(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ruleType  string
+		attrs     map[string]tftypes.Value
+		wantScope string
+		wantOK    bool
+	}{
+		"type attribute": {
+			attrs: map[string]tftypes.Value{
+				"security_group_id": tftypes.NewValue(tftypes.String, "sg-12345678"),
+				"type":              tftypes.NewValue(tftypes.String, "egress"),
+			},
+			wantScope: "sg-12345678/egress",
+			wantOK:    true,
+		},
+		"fixed type": {
+			ruleType: securityGroupRuleTypeIngress,
+			attrs: map[string]tftypes.Value{
+				"security_group_id": tftypes.NewValue(tftypes.String, "sg-12345678"),
+			},
+			wantScope: "sg-12345678/ingress",
+			wantOK:    true,
+		},
+		"unknown security group": {
+			ruleType: securityGroupRuleTypeIngress,
+			attrs: map[string]tftypes.Value{
+				"security_group_id": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+		},
+		"missing type": {
+			attrs: map[string]tftypes.Value{
+				"security_group_id": tftypes.NewValue(tftypes.String, "sg-12345678"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			scope, ok := quotaScopeSecurityGroupRule(testCase.ruleType)(testCase.attrs)
+
+			if ok != testCase.wantOK {
+				t.Fatalf("ok = %t, want %t", ok, testCase.wantOK)
+			}
+
+			if scope != testCase.wantScope {
+				t.Errorf("scope = %q, want %q", scope, testCase.wantScope)
+			}
+		})
+	}
+}
+
+func TestQuotaCountSecurityGroupRule(t *testing.T) {
+	t.Parallel()
+
+	listType := tftypes.List{ElementType: tftypes.String}
+	attrs := map[string]tftypes.Value{
+		"cidr_blocks": tftypes.NewValue(listType, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "10.0.0.0/16"),
+			tftypes.NewValue(tftypes.String, "10.1.0.0/16"),
+		}),
+		"ipv6_cidr_blocks": tftypes.NewValue(listType, nil),
+		"prefix_list_ids": tftypes.NewValue(listType, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "pl-12345678"),
+		}),
+	}
+
+	if got, want := quotaCountSecurityGroupRule(attrs), 3; got != want {
+		t.Errorf("count = %d, want %d", got, want)
+	}
+
+	if got, want := quotaCountSecurityGroupRule(map[string]tftypes.Value{}), 1; got != want {
+		t.Errorf("count = %d, want %d", got, want)
+	}
+}
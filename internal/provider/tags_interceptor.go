@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -160,3 +161,36 @@ func tagsReadFunc(ctx context.Context, d schemaResourceData, sp conns.ServicePac
 
 	return ctx, diags
 }
+
+// tagsPolicyCustomizeDiff validates a resource's planned tags, including any provider configured default_tags,
+// against any provider configured tag_policy.
+func tagsPolicyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	policyConfig := meta.(*conns.AWSClient).TagPolicyConfig
+	if policyConfig == nil {
+		return nil
+	}
+
+	// Only validate tags on resources being created or whose tags are changing.
+	if d.Id() != "" && !d.HasChanges(names.AttrTags, names.AttrTagsAll) {
+		return nil
+	}
+
+	if !d.GetRawPlan().GetAttr(names.AttrTags).IsWhollyKnown() {
+		return nil
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})))
+	tags = tags.IgnoreSystem(inContext.ServicePackageName)
+
+	return policyConfig.Validate(tags)
+}
@@ -136,10 +136,16 @@ func (d *resourceData) Id() string {
 	return "id"
 }
 
+func (d *resourceData) IsNewResource() bool {
+	return false
+}
+
 func (d *resourceData) Set(string, any) error {
 	return nil
 }
 
+func (d *resourceData) SetId(string) {}
+
 func (d *resourceData) GetChange(key string) (interface{}, interface{}) {
 	return nil, nil
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -18,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -362,6 +364,11 @@ func resourceAPIDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 		return diags
 	}
 
+	// Deletion is rejected with a conflict while the API is still referenced.
+	if tfawserr.ErrCodeEquals(err, apigatewayv2.ErrCodeConflictException) {
+		return append(diags, apiDeleteBlockedDiagnostic(ctx, conn, d.Id(), err))
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting API Gateway v2 API (%s): %s", d.Id(), err)
 	}
@@ -369,6 +376,163 @@ func resourceAPIDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 	return diags
 }
 
+// apiDeleteBlockedDiagnostic explains why the specified API could not be deleted.
+// API Gateway removes an API's stages, routes, integrations and authorizers along with the API itself,
+// so deletion is only blocked by references from outside the API, such as custom domain name API mappings.
+// Those references are managed by other resources and are not removed here.
+// Errors listing them are logged and otherwise ignored so that the deletion error is always reported.
+func apiDeleteBlockedDiagnostic(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, apiID string, err error) diag.Diagnostic {
+	summary := fmt.Sprintf("deleting API Gateway v2 API (%s): %s", apiID, err)
+
+	mappings, listErr := findAPIMappingsByAPIID(ctx, conn, apiID)
+
+	if listErr != nil {
+		log.Printf("[WARN] Listing API Gateway v2 API (%s) API mappings: %s", apiID, listErr)
+	}
+
+	if len(mappings) > 0 {
+		var details []string
+		seen := make(map[string]bool)
+		for _, v := range mappings {
+			id := aws.StringValue(v.mapping.ApiMappingId)
+
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+
+			details = append(details, fmt.Sprintf("Stage %q is mapped to custom domain name %q by API mapping %q (key %q).",
+				aws.StringValue(v.mapping.Stage), v.domainName, id, aws.StringValue(v.mapping.ApiMappingKey)))
+		}
+
+		return errs.NewErrorDiagnostic(summary, strings.Join(details, "\n")+"\n\nRemove the API mappings before deleting the API.")
+	}
+
+	stages, authorizers, listErr := findAPIStagesAndAuthorizers(ctx, conn, apiID)
+
+	if listErr != nil {
+		log.Printf("[WARN] Listing API Gateway v2 API (%s) stages and authorizers: %s", apiID, listErr)
+	}
+
+	if len(stages) == 0 && len(authorizers) == 0 {
+		return errs.NewErrorDiagnostic(summary, "")
+	}
+
+	return errs.NewErrorDiagnostic(summary, fmt.Sprintf("Stages: %s\nAuthorizers: %s", strings.Join(stages, ", "), strings.Join(authorizers, ", ")))
+}
+
+type domainNameAPIMapping struct {
+	domainName string
+	mapping    *apigatewayv2.ApiMapping
+}
+
+// findAPIMappingsByAPIID returns all custom domain name API mappings that reference the specified API.
+func findAPIMappingsByAPIID(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, apiID string) ([]domainNameAPIMapping, error) {
+	var output []domainNameAPIMapping
+	var mappingsErr error
+
+	input := &apigatewayv2.GetDomainNamesInput{}
+	err := getDomainNamesPages(ctx, conn, input, func(page *apigatewayv2.GetDomainNamesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			domainName := aws.StringValue(v.DomainName)
+			input := &apigatewayv2.GetApiMappingsInput{
+				DomainName: aws.String(domainName),
+			}
+
+			mappingsErr = getAPIMappingsPages(ctx, conn, input, func(page *apigatewayv2.GetApiMappingsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.Items {
+					if aws.StringValue(v.ApiId) == apiID {
+						output = append(output, domainNameAPIMapping{
+							domainName: domainName,
+							mapping:    v,
+						})
+					}
+				}
+
+				return !lastPage
+			})
+
+			if tfawserr.ErrCodeEquals(mappingsErr, apigatewayv2.ErrCodeNotFoundException) {
+				mappingsErr = nil
+			}
+
+			if mappingsErr != nil {
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if mappingsErr != nil {
+		return nil, mappingsErr
+	}
+
+	return output, nil
+}
+
+// findAPIStagesAndAuthorizers returns the names of the specified API's stages and authorizers.
+// It is used to give context when the API cannot be deleted.
+func findAPIStagesAndAuthorizers(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, apiID string) ([]string, []string, error) {
+	var stages, authorizers []string
+
+	stagesInput := &apigatewayv2.GetStagesInput{
+		ApiId: aws.String(apiID),
+	}
+	for {
+		output, err := conn.GetStagesWithContext(ctx, stagesInput)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, v := range output.Items {
+			stages = append(stages, aws.StringValue(v.StageName))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		stagesInput.NextToken = output.NextToken
+	}
+
+	authorizersInput := &apigatewayv2.GetAuthorizersInput{
+		ApiId: aws.String(apiID),
+	}
+	for {
+		output, err := conn.GetAuthorizersWithContext(ctx, authorizersInput)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, v := range output.Items {
+			authorizers = append(authorizers, fmt.Sprintf("%s (%s)", aws.StringValue(v.Name), aws.StringValue(v.AuthorizerId)))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		authorizersInput.NextToken = output.NextToken
+	}
+
+	return stages, authorizers, nil
+}
+
 func reimportOpenAPIDefinition(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).APIGatewayV2Conn(ctx)
 
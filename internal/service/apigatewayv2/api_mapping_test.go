@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/service/acm"
@@ -39,9 +40,10 @@ func TestAccAPIGatewayV2APIMapping_serial(t *testing.T) {
 	})
 
 	testCases := map[string]func(t *testing.T, rName string, certificateArn *string){
-		"basic":         testAccAPIMapping_basic,
-		"disappears":    testAccAPIMapping_disappears,
-		"ApiMappingKey": testAccAPIMapping_key,
+		"basic":            testAccAPIMapping_basic,
+		"disappears":       testAccAPIMapping_disappears,
+		"ApiMappingKey":    testAccAPIMapping_key,
+		"apiDeleteBlocked": testAccAPIMapping_apiDeleteBlocked,
 	}
 	for name, tc := range testCases { //nolint:paralleltest
 		tc := tc
@@ -166,6 +168,62 @@ func testAccAPIMapping_key(t *testing.T, rName string, certificateARN *string) {
 	})
 }
 
+func testAccAPIMapping_apiDeleteBlocked(t *testing.T, rName string, certificateARN *string) {
+	ctx := acctest.Context(t)
+	var api apigatewayv2.GetApiOutput
+	var apiMappingID string
+	domainName := fmt.Sprintf("%s.example.com", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAPIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIMappingConfig_quickCreateAPI(rName, *certificateARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAPIExists(ctx, "aws_apigatewayv2_api.test", &api),
+				),
+			},
+			{
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Conn(ctx)
+
+					output, err := conn.CreateApiMappingWithContext(ctx, &apigatewayv2.CreateApiMappingInput{
+						ApiId:      api.ApiId,
+						DomainName: aws.String(domainName),
+						Stage:      aws.String("$default"),
+					})
+
+					if err != nil {
+						t.Fatalf("creating API Gateway v2 API Mapping: %s", err)
+					}
+
+					apiMappingID = aws.StringValue(output.ApiMappingId)
+				},
+				Config:      testAccAPIMappingConfig_base(rName, *certificateARN),
+				ExpectError: regexp.MustCompile(`is mapped to custom domain name`),
+			},
+			{
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Conn(ctx)
+
+					_, err := conn.DeleteApiMappingWithContext(ctx, &apigatewayv2.DeleteApiMappingInput{
+						ApiMappingId: aws.String(apiMappingID),
+						DomainName:   aws.String(domainName),
+					})
+
+					if err != nil {
+						t.Fatalf("deleting API Gateway v2 API Mapping (%s): %s", apiMappingID, err)
+					}
+				},
+				Config: testAccAPIMappingConfig_base(rName, *certificateARN),
+			},
+		},
+	})
+}
+
 func testAccCheckAPIMappingCreateCertificate(ctx context.Context, t *testing.T, rName string, certificateARN *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		privateKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
@@ -290,3 +348,13 @@ resource "aws_apigatewayv2_api_mapping" "test" {
 }
 `, apiMappingKey))
 }
+
+func testAccAPIMappingConfig_quickCreateAPI(rName, certificateARN string) string {
+	return acctest.ConfigCompose(testAccAPIMappingConfig_base(rName, certificateARN), fmt.Sprintf(`
+resource "aws_apigatewayv2_api" "test" {
+  name          = %[1]q
+  protocol_type = "HTTP"
+  target        = "http://www.example.com"
+}
+`, rName))
+}
@@ -14,3 +14,21 @@ func ConfigurationProfileType_Values() []string {
 		configurationProfileTypeFreeform,
 	}
 }
+
+const (
+	featureFlagAttributeTypeBoolean     = "boolean"
+	featureFlagAttributeTypeNumber      = "number"
+	featureFlagAttributeTypeNumberArray = "number[]"
+	featureFlagAttributeTypeString      = "string"
+	featureFlagAttributeTypeStringArray = "string[]"
+)
+
+func featureFlagAttributeType_Values() []string {
+	return []string{
+		featureFlagAttributeTypeBoolean,
+		featureFlagAttributeTypeNumber,
+		featureFlagAttributeTypeNumberArray,
+		featureFlagAttributeTypeString,
+		featureFlagAttributeTypeStringArray,
+	}
+}
@@ -56,7 +56,9 @@ func ResourceExtensionAssociation() *schema.Resource {
 			},
 			"extension_version": {
 				Type:     schema.TypeInt,
+				Optional: true,
 				Computed: true,
+				ForceNew: true,
 			},
 		},
 	}
@@ -70,6 +72,10 @@ func resourceExtensionAssociationCreate(ctx context.Context, d *schema.ResourceD
 		ResourceIdentifier:  aws.String(d.Get("resource_arn").(string)),
 	}
 
+	if v, ok := d.GetOk("extension_version"); ok {
+		in.ExtensionVersionNumber = aws.Int64(int64(v.(int)))
+	}
+
 	if v, ok := d.GetOk("parameters"); ok {
 		in.Parameters = flex.ExpandStringMap(v.(map[string]interface{}))
 	}
@@ -48,6 +48,33 @@ func TestAccAppConfigExtensionAssociation_basic(t *testing.T) {
 	})
 }
 
+func TestAccAppConfigExtensionAssociation_extensionVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appconfig_extension_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appconfig.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckExtensionAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExtensionAssociationConfig_extensionVersion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExtensionAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "extension_version", "aws_appconfig_extension.test", "version"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAppConfigExtensionAssociation_Parameters(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -227,6 +254,30 @@ resource "aws_appconfig_extension_association" "test" {
 `, rName))
 }
 
+func testAccExtensionAssociationConfig_extensionVersion(rName string) string {
+	return acctest.ConfigCompose(
+		testAccExtensionAssociationConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_extension" "test" {
+  name        = %[1]q
+  description = "test description"
+  action_point {
+    point = "ON_DEPLOYMENT_COMPLETE"
+    action {
+      name     = "test"
+      role_arn = aws_iam_role.test.arn
+      uri      = aws_sns_topic.test.arn
+    }
+  }
+}
+resource "aws_appconfig_extension_association" "test" {
+  extension_arn     = aws_appconfig_extension.test.arn
+  extension_version = aws_appconfig_extension.test.version
+  resource_arn      = aws_appconfig_application.test.arn
+}
+`, rName))
+}
+
 func testAccExtensionAssociationConfig_parameters1(rName string, pName string, pDescription string, pRequired string, pValue string) string {
 	return acctest.ConfigCompose(
 		testAccExtensionAssociationConfigBase(rName),
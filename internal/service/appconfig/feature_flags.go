@@ -0,0 +1,317 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfig
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+const (
+	featureFlagsContentType = "application/json"
+	featureFlagsVersion     = "1"
+
+	featureFlagValueEnabled  = "enabled"
+	featureFlagValueVariants = "_variants"
+)
+
+var featureFlagKeyRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_\-]{0,63}$`)
+
+// featureFlagsContent is the JSON representation of the content of an AWS.AppConfig.FeatureFlags configuration profile.
+// See https://docs.aws.amazon.com/appconfig/latest/userguide/appconfig-type-reference-feature-flags.html.
+type featureFlagsContent struct {
+	Flags   map[string]featureFlagDefinition  `json:"flags"`
+	Values  map[string]map[string]interface{} `json:"values"`
+	Version string                            `json:"version"`
+}
+
+type featureFlagDefinition struct {
+	Attributes  map[string]featureFlagAttributeDefinition `json:"attributes,omitempty"`
+	Description string                                    `json:"description,omitempty"`
+	Name        string                                    `json:"name"`
+}
+
+type featureFlagAttributeDefinition struct {
+	Constraints featureFlagAttributeConstraints `json:"constraints"`
+}
+
+type featureFlagAttributeConstraints struct {
+	Required bool   `json:"required,omitempty"`
+	Type     string `json:"type"`
+}
+
+type featureFlagVariant struct {
+	AttributeValues map[string]interface{} `json:"attributeValues,omitempty"`
+	Enabled         bool                   `json:"enabled"`
+	Name            string                 `json:"name"`
+	Rule            string                 `json:"rule,omitempty"`
+}
+
+// expandFeatureFlags validates a feature_flags configuration block and returns the equivalent hosted configuration content.
+func expandFeatureFlags(tfMap map[string]interface{}) ([]byte, error) {
+	content := featureFlagsContent{
+		Flags:   make(map[string]featureFlagDefinition),
+		Values:  make(map[string]map[string]interface{}),
+		Version: featureFlagsVersion,
+	}
+
+	for _, tfMapRaw := range tfMap["flag"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		key := tfMap["key"].(string)
+
+		if _, ok := content.Flags[key]; ok {
+			return nil, fmt.Errorf("duplicate feature flag key (%s)", key)
+		}
+
+		flag := featureFlagDefinition{
+			Attributes:  make(map[string]featureFlagAttributeDefinition),
+			Description: tfMap["description"].(string),
+			Name:        tfMap["name"].(string),
+		}
+		value := map[string]interface{}{
+			featureFlagValueEnabled: tfMap["enabled"].(bool),
+		}
+		variants := tfMap["variant"].([]interface{})
+
+		for _, tfMapRaw := range tfMap["attribute"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			name := tfMap["name"].(string)
+
+			if name == featureFlagValueEnabled {
+				return nil, fmt.Errorf("feature flag (%s) attribute name %q is reserved", key, name)
+			}
+
+			if _, ok := flag.Attributes[name]; ok {
+				return nil, fmt.Errorf("duplicate feature flag (%s) attribute (%s)", key, name)
+			}
+
+			attributeType := tfMap["type"].(string)
+			required := tfMap["required"].(bool)
+			flag.Attributes[name] = featureFlagAttributeDefinition{
+				Constraints: featureFlagAttributeConstraints{
+					Required: required,
+					Type:     attributeType,
+				},
+			}
+
+			if v := tfMap["value"].(string); v != "" {
+				v, err := expandFeatureFlagAttributeValue(attributeType, v)
+
+				if err != nil {
+					return nil, fmt.Errorf("feature flag (%s) attribute (%s): %w", key, name, err)
+				}
+
+				value[name] = v
+			} else if required && len(variants) == 0 {
+				return nil, fmt.Errorf("feature flag (%s) attribute (%s) is required but has no value", key, name)
+			}
+		}
+
+		if len(variants) > 0 {
+			apiObjects := make([]featureFlagVariant, 0, len(variants))
+			names := make(map[string]struct{})
+
+			for i, tfMapRaw := range variants {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				apiObject := featureFlagVariant{
+					Enabled: tfMap["enabled"].(bool),
+					Name:    tfMap["name"].(string),
+					Rule:    tfMap["rule"].(string),
+				}
+
+				if _, ok := names[apiObject.Name]; ok {
+					return nil, fmt.Errorf("duplicate feature flag (%s) variant (%s)", key, apiObject.Name)
+				}
+
+				names[apiObject.Name] = struct{}{}
+
+				if apiObject.Rule == "" && i != len(variants)-1 {
+					return nil, fmt.Errorf("feature flag (%s) variant (%s) has no rule; only the last (default) variant may omit its rule", key, apiObject.Name)
+				}
+
+				for name, v := range tfMap["attribute_values"].(map[string]interface{}) {
+					attribute, ok := flag.Attributes[name]
+
+					if !ok {
+						return nil, fmt.Errorf("feature flag (%s) variant (%s) sets undeclared attribute (%s)", key, apiObject.Name, name)
+					}
+
+					v, err := expandFeatureFlagAttributeValue(attribute.Constraints.Type, v.(string))
+
+					if err != nil {
+						return nil, fmt.Errorf("feature flag (%s) variant (%s) attribute (%s): %w", key, apiObject.Name, name, err)
+					}
+
+					if apiObject.AttributeValues == nil {
+						apiObject.AttributeValues = make(map[string]interface{})
+					}
+
+					apiObject.AttributeValues[name] = v
+				}
+
+				apiObjects = append(apiObjects, apiObject)
+			}
+
+			value[featureFlagValueVariants] = apiObjects
+		}
+
+		content.Flags[key] = flag
+		content.Values[key] = value
+	}
+
+	return json.Marshal(content)
+}
+
+func expandFeatureFlagAttributeValue(attributeType, v string) (interface{}, error) {
+	switch attributeType {
+	case featureFlagAttributeTypeBoolean:
+		return strconv.ParseBool(v)
+	case featureFlagAttributeTypeNumber:
+		return strconv.ParseFloat(v, 64)
+	case featureFlagAttributeTypeNumberArray:
+		var values []float64
+		if err := json.Unmarshal([]byte(v), &values); err != nil {
+			return nil, fmt.Errorf("value must be a JSON array of numbers: %w", err)
+		}
+		return values, nil
+	case featureFlagAttributeTypeString:
+		return v, nil
+	case featureFlagAttributeTypeStringArray:
+		var values []string
+		if err := json.Unmarshal([]byte(v), &values); err != nil {
+			return nil, fmt.Errorf("value must be a JSON array of strings: %w", err)
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("unsupported attribute type (%s)", attributeType)
+	}
+}
+
+// flattenFeatureFlags returns the feature_flags configuration block equivalent to hosted configuration content.
+// Flags, attributes and attribute values are ordered by name.
+func flattenFeatureFlags(b []byte) (map[string]interface{}, error) {
+	var content featureFlagsContent
+
+	if err := json.Unmarshal(b, &content); err != nil {
+		return nil, err
+	}
+
+	if len(content.Flags) == 0 {
+		return nil, errors.New("no feature flags defined")
+	}
+
+	keys := make([]string, 0, len(content.Flags))
+	for key := range content.Flags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	tfList := make([]interface{}, 0, len(keys))
+
+	for _, key := range keys {
+		flag := content.Flags[key]
+		value := content.Values[key]
+
+		tfMap := map[string]interface{}{
+			"description": flag.Description,
+			"key":         key,
+			"name":        flag.Name,
+		}
+
+		if v, ok := value[featureFlagValueEnabled].(bool); ok {
+			tfMap["enabled"] = v
+		}
+
+		names := make([]string, 0, len(flag.Attributes))
+		for name := range flag.Attributes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		attributes := make([]interface{}, 0, len(names))
+		for _, name := range names {
+			attribute := map[string]interface{}{
+				"name":     name,
+				"required": flag.Attributes[name].Constraints.Required,
+				"type":     flag.Attributes[name].Constraints.Type,
+			}
+
+			if v, ok := value[name]; ok {
+				attribute["value"] = flattenFeatureFlagAttributeValue(v)
+			}
+
+			attributes = append(attributes, attribute)
+		}
+		tfMap["attribute"] = attributes
+
+		if v, ok := value[featureFlagValueVariants]; ok {
+			b, err := json.Marshal(v)
+
+			if err != nil {
+				return nil, err
+			}
+
+			var apiObjects []featureFlagVariant
+
+			if err := json.Unmarshal(b, &apiObjects); err != nil {
+				return nil, fmt.Errorf("reading feature flag (%s) variants: %w", key, err)
+			}
+
+			variants := make([]interface{}, 0, len(apiObjects))
+			for _, apiObject := range apiObjects {
+				attributeValues := make(map[string]interface{}, len(apiObject.AttributeValues))
+				for name, v := range apiObject.AttributeValues {
+					attributeValues[name] = flattenFeatureFlagAttributeValue(v)
+				}
+
+				variants = append(variants, map[string]interface{}{
+					"attribute_values": attributeValues,
+					"enabled":          apiObject.Enabled,
+					"name":             apiObject.Name,
+					"rule":             apiObject.Rule,
+				})
+			}
+			tfMap["variant"] = variants
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return map[string]interface{}{
+		"flag": tfList,
+	}, nil
+}
+
+func flattenFeatureFlagAttributeValue(v interface{}) string {
+	switch v := v.(type) {
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		return v
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
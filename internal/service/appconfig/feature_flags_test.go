@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfig
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExpandFeatureFlags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		Input         map[string]interface{}
+		ExpectedJSON  string
+		ExpectedError string
+	}{
+		"basic": {
+			Input: featureFlagsTestConfig(map[string]interface{}{
+				"attribute": []interface{}{
+					map[string]interface{}{"name": "color", "required": true, "type": "string", "value": "red"},
+					map[string]interface{}{"name": "limit", "required": false, "type": "number", "value": "1.5"},
+					map[string]interface{}{"name": "regions", "required": false, "type": "string[]", "value": `["us-east-1"]`},
+				},
+			}),
+			ExpectedJSON: `{
+  "flags": {"test": {"attributes": {"color": {"constraints": {"required": true, "type": "string"}}, "limit": {"constraints": {"type": "number"}}, "regions": {"constraints": {"type": "string[]"}}}, "name": "Test"}},
+  "values": {"test": {"color": "red", "enabled": true, "limit": 1.5, "regions": ["us-east-1"]}},
+  "version": "1"
+}`,
+		},
+		"variants": {
+			Input: featureFlagsTestConfig(map[string]interface{}{
+				"attribute": []interface{}{
+					map[string]interface{}{"name": "color", "required": true, "type": "string", "value": ""},
+				},
+				"variant": []interface{}{
+					map[string]interface{}{"name": "gold", "enabled": true, "rule": `(eq $tier "gold")`, "attribute_values": map[string]interface{}{"color": "yellow"}},
+					map[string]interface{}{"name": "default", "enabled": false, "rule": "", "attribute_values": map[string]interface{}{"color": "grey"}},
+				},
+			}),
+			ExpectedJSON: `{
+  "flags": {"test": {"attributes": {"color": {"constraints": {"required": true, "type": "string"}}}, "name": "Test"}},
+  "values": {"test": {"enabled": true, "_variants": [
+    {"attributeValues": {"color": "yellow"}, "enabled": true, "name": "gold", "rule": "(eq $tier \"gold\")"},
+    {"attributeValues": {"color": "grey"}, "enabled": false, "name": "default"}
+  ]}},
+  "version": "1"
+}`,
+		},
+		"required attribute without value": {
+			Input: featureFlagsTestConfig(map[string]interface{}{
+				"attribute": []interface{}{
+					map[string]interface{}{"name": "color", "required": true, "type": "string", "value": ""},
+				},
+			}),
+			ExpectedError: "is required but has no value",
+		},
+		"invalid number": {
+			Input: featureFlagsTestConfig(map[string]interface{}{
+				"attribute": []interface{}{
+					map[string]interface{}{"name": "limit", "required": false, "type": "number", "value": "many"},
+				},
+			}),
+			ExpectedError: "attribute (limit)",
+		},
+		"undeclared variant attribute": {
+			Input: featureFlagsTestConfig(map[string]interface{}{
+				"variant": []interface{}{
+					map[string]interface{}{"name": "default", "enabled": true, "rule": "", "attribute_values": map[string]interface{}{"color": "grey"}},
+				},
+			}),
+			ExpectedError: "sets undeclared attribute (color)",
+		},
+		"default variant not last": {
+			Input: featureFlagsTestConfig(map[string]interface{}{
+				"variant": []interface{}{
+					map[string]interface{}{"name": "default", "enabled": true, "rule": "", "attribute_values": map[string]interface{}{}},
+					map[string]interface{}{"name": "gold", "enabled": true, "rule": `(eq $tier "gold")`, "attribute_values": map[string]interface{}{}},
+				},
+			}),
+			ExpectedError: "only the last (default) variant may omit its rule",
+		},
+		"duplicate key": {
+			Input: map[string]interface{}{
+				"flag": []interface{}{
+					featureFlagsTestFlag(nil),
+					featureFlagsTestFlag(nil),
+				},
+			},
+			ExpectedError: "duplicate feature flag key (test)",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := expandFeatureFlags(testCase.Input)
+
+			if testCase.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error containing %q, got: %v", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var gotValue, expectedValue interface{}
+
+			if err := json.Unmarshal(got, &gotValue); err != nil {
+				t.Fatalf("unmarshaling result: %s", err)
+			}
+
+			if err := json.Unmarshal([]byte(testCase.ExpectedJSON), &expectedValue); err != nil {
+				t.Fatalf("unmarshaling expected: %s", err)
+			}
+
+			if !reflect.DeepEqual(gotValue, expectedValue) {
+				t.Errorf("got %s, expected %s", got, testCase.ExpectedJSON)
+			}
+		})
+	}
+}
+
+func TestFlattenFeatureFlags(t *testing.T) {
+	t.Parallel()
+
+	input := featureFlagsTestConfig(map[string]interface{}{
+		"attribute": []interface{}{
+			map[string]interface{}{"name": "color", "required": true, "type": "string", "value": ""},
+			map[string]interface{}{"name": "limit", "required": false, "type": "number", "value": "10"},
+		},
+		"variant": []interface{}{
+			map[string]interface{}{"name": "gold", "enabled": true, "rule": `(eq $tier "gold")`, "attribute_values": map[string]interface{}{"color": "yellow"}},
+			map[string]interface{}{"name": "default", "enabled": false, "rule": "", "attribute_values": map[string]interface{}{"color": "grey"}},
+		},
+	})
+
+	b, err := expandFeatureFlags(input)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := flattenFeatureFlags(b)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := featureFlagsTestConfig(map[string]interface{}{
+		"attribute": []interface{}{
+			map[string]interface{}{"name": "color", "required": true, "type": "string"},
+			map[string]interface{}{"name": "limit", "required": false, "type": "number", "value": "10"},
+		},
+		"variant": []interface{}{
+			map[string]interface{}{"name": "gold", "enabled": true, "rule": `(eq $tier "gold")`, "attribute_values": map[string]interface{}{"color": "yellow"}},
+			map[string]interface{}{"name": "default", "enabled": false, "rule": "", "attribute_values": map[string]interface{}{"color": "grey"}},
+		},
+	})
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %#v, expected %#v", got, expected)
+	}
+
+	if _, err := flattenFeatureFlags([]byte(`{"foo":"bar"}`)); err == nil {
+		t.Error("expected error flattening freeform content")
+	}
+}
+
+func featureFlagsTestFlag(overrides map[string]interface{}) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"attribute":   []interface{}{},
+		"description": "",
+		"enabled":     true,
+		"key":         "test",
+		"name":        "Test",
+		"variant":     []interface{}{},
+	}
+
+	for k, v := range overrides {
+		tfMap[k] = v
+	}
+
+	return tfMap
+}
+
+func featureFlagsTestConfig(overrides map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"flag": []interface{}{featureFlagsTestFlag(overrides)},
+	}
+}
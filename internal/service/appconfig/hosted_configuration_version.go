@@ -50,10 +50,12 @@ func ResourceHostedConfigurationVersion() *schema.Resource {
 				ValidateFunc: validation.StringMatch(regexp.MustCompile(`[a-z0-9]{4,7}`), ""),
 			},
 			"content": {
-				Type:      schema.TypeString,
-				Required:  true,
-				ForceNew:  true,
-				Sensitive: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"content", "feature_flags"},
 			},
 			"content_type": {
 				Type:         schema.TypeString,
@@ -67,11 +69,121 @@ func ResourceHostedConfigurationVersion() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(0, 1024),
 			},
+			"feature_flags": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"content", "feature_flags"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"flag": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringMatch(featureFlagKeyRegexp, "must start with a letter and contain only alphanumeric characters, hyphens and underscores"),
+												},
+												"required": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													ForceNew: true,
+												},
+												"type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(featureFlagAttributeType_Values(), false),
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"description": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(0, 1024),
+									},
+									"enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringMatch(featureFlagKeyRegexp, "must start with a letter and contain only alphanumeric characters, hyphens and underscores"),
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+									"variant": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"attribute_values": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													ForceNew: true,
+												},
+												"name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 64),
+												},
+												"rule": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1024),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"version_number": {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: customizeDiffHostedConfigurationVersionFeatureFlags,
 	}
 }
 
@@ -89,6 +201,16 @@ func resourceHostedConfigurationVersionCreate(ctx context.Context, d *schema.Res
 		ContentType:            aws.String(d.Get("content_type").(string)),
 	}
 
+	if v, ok := d.GetOk("feature_flags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		content, err := expandFeatureFlags(v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating AppConfig HostedConfigurationVersion for Application (%s): %s", appID, err)
+		}
+
+		input.Content = content
+	}
+
 	if v, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(v.(string))
 	}
@@ -141,6 +263,16 @@ func resourceHostedConfigurationVersionRead(ctx context.Context, d *schema.Resou
 	d.Set("content", string(output.Content))
 	d.Set("content_type", output.ContentType)
 	d.Set("description", output.Description)
+	// Hosted configuration versions are immutable, so feature flags only need to be read on import.
+	if _, ok := d.GetOk("feature_flags"); !ok && aws.StringValue(output.ContentType) == featureFlagsContentType {
+		if tfMap, err := flattenFeatureFlags(output.Content); err == nil {
+			if err := d.Set("feature_flags", []interface{}{tfMap}); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting feature_flags: %s", err)
+			}
+		} else {
+			log.Printf("[DEBUG] AppConfig Hosted Configuration Version (%s) content is not feature flags: %s", d.Id(), err)
+		}
+	}
 	d.Set("version_number", output.VersionNumber)
 
 	arn := arn.ARN{
@@ -185,6 +317,26 @@ func resourceHostedConfigurationVersionDelete(ctx context.Context, d *schema.Res
 	return diags
 }
 
+func customizeDiffHostedConfigurationVersionFeatureFlags(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if v := diff.GetRawConfig().GetAttr("feature_flags"); !v.IsWhollyKnown() || v.IsNull() || v.LengthInt() == 0 {
+		return nil
+	}
+
+	if diff.NewValueKnown("content_type") {
+		if v := diff.Get("content_type").(string); v != featureFlagsContentType {
+			return fmt.Errorf("content_type must be %q when feature_flags is configured, got: %q", featureFlagsContentType, v)
+		}
+	}
+
+	if v, ok := diff.Get("feature_flags").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if _, err := expandFeatureFlags(v[0].(map[string]interface{})); err != nil {
+			return fmt.Errorf("invalid feature_flags: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func HostedConfigurationVersionParseID(id string) (string, string, int, error) {
 	parts := strings.Split(id, "/")
 
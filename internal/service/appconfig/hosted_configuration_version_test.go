@@ -53,6 +53,61 @@ func TestAccAppConfigHostedConfigurationVersion_basic(t *testing.T) {
 	})
 }
 
+func TestAccAppConfigHostedConfigurationVersion_featureFlags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appconfig_hosted_configuration_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appconfig.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHostedConfigurationVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostedConfigurationVersionConfig_featureFlags(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostedConfigurationVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "content"),
+					resource.TestCheckResourceAttr(resourceName, "feature_flags.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "feature_flags.0.flag.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "feature_flags.0.flag.0.key", "checkout"),
+					resource.TestCheckResourceAttr(resourceName, "feature_flags.0.flag.0.attribute.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "feature_flags.0.flag.0.variant.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "feature_flags.0.flag.0.variant.0.attribute_values.theme", "dark"),
+					resource.TestCheckResourceAttr(resourceName, "feature_flags.0.flag.1.key", "search"),
+					resource.TestCheckResourceAttr(resourceName, "feature_flags.0.flag.1.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"feature_flags"},
+			},
+		},
+	})
+}
+
+func TestAccAppConfigHostedConfigurationVersion_featureFlagsInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appconfig.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHostedConfigurationVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccHostedConfigurationVersionConfig_featureFlagsInvalid(rName),
+				ExpectError: regexp.MustCompile(`feature flag \(checkout\) attribute \(limit\)`),
+			},
+		},
+	})
+}
+
 func TestAccAppConfigHostedConfigurationVersion_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -170,3 +225,97 @@ resource "aws_appconfig_hosted_configuration_version" "test" {
 }
 `, rName))
 }
+
+func testAccHostedConfigurationVersionConfig_featureFlagsBase(rName string) string {
+	return acctest.ConfigCompose(
+		testAccApplicationConfig_name(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_configuration_profile" "test" {
+  application_id = aws_appconfig_application.test.id
+  name           = %[1]q
+  location_uri   = "hosted"
+  type           = "AWS.AppConfig.FeatureFlags"
+}
+`, rName))
+}
+
+func testAccHostedConfigurationVersionConfig_featureFlags(rName string) string {
+	return acctest.ConfigCompose(
+		testAccHostedConfigurationVersionConfig_featureFlagsBase(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_hosted_configuration_version" "test" {
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id = aws_appconfig_configuration_profile.test.configuration_profile_id
+  content_type             = "application/json"
+  description              = %[1]q
+
+  feature_flags {
+    flag {
+      key     = "checkout"
+      name    = "Checkout"
+      enabled = true
+
+      attribute {
+        name     = "theme"
+        type     = "string"
+        required = true
+      }
+
+      attribute {
+        name  = "regions"
+        type  = "string[]"
+        value = jsonencode(["us-east-1", "eu-west-1"])
+      }
+
+      variant {
+        name    = "gold"
+        enabled = true
+        rule    = "(eq $tier \"gold\")"
+
+        attribute_values = {
+          theme = "dark"
+        }
+      }
+
+      variant {
+        name = "default"
+
+        attribute_values = {
+          theme = "light"
+        }
+      }
+    }
+
+    flag {
+      key  = "search"
+      name = "Search"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccHostedConfigurationVersionConfig_featureFlagsInvalid(rName string) string {
+	return acctest.ConfigCompose(
+		testAccHostedConfigurationVersionConfig_featureFlagsBase(rName),
+		`
+resource "aws_appconfig_hosted_configuration_version" "test" {
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id = aws_appconfig_configuration_profile.test.configuration_profile_id
+  content_type             = "application/json"
+
+  feature_flags {
+    flag {
+      key  = "checkout"
+      name = "Checkout"
+
+      attribute {
+        name  = "limit"
+        type  = "number"
+        value = "unlimited"
+      }
+    }
+  }
+}
+`)
+}
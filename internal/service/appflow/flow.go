@@ -5,14 +5,18 @@ package appflow
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"regexp"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appflow"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -693,6 +697,40 @@ func ResourceFlow() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringMatch(regexp.MustCompile(`arn:.*:kms:.*:[0-9]+:.*`), "must be a valid ARN of a Key Management Services (KMS) key"),
 			},
+			"metadata_catalog_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"glue_data_catalog": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"database_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"table_prefix": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"source_flow_config": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -1214,7 +1252,10 @@ func ResourceFlow() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceFlowCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -1238,6 +1279,10 @@ func resourceFlowCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		in.KmsArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("metadata_catalog_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.MetadataCatalogConfig = expandMetadataCatalogConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	out, err := conn.CreateFlowWithContext(ctx, in)
 
 	if err != nil {
@@ -1288,6 +1333,14 @@ func resourceFlowRead(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	d.Set("kms_arn", out2.KmsArn)
 
+	if out2.MetadataCatalogConfig != nil {
+		if err := d.Set("metadata_catalog_config", []interface{}{flattenMetadataCatalogConfig(out2.MetadataCatalogConfig)}); err != nil {
+			return diag.Errorf("setting metadata_catalog_config: %s", err)
+		}
+	} else {
+		d.Set("metadata_catalog_config", nil)
+	}
+
 	if out2.SourceFlowConfig != nil {
 		if err := d.Set("source_flow_config", []interface{}{flattenSourceFlowConfig(out2.SourceFlowConfig)}); err != nil {
 			return diag.Errorf("setting source_flow_config: %s", err)
@@ -1327,6 +1380,10 @@ func resourceFlowUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 			in.Description = aws.String(d.Get(names.AttrDescription).(string))
 		}
 
+		if v, ok := d.GetOk("metadata_catalog_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			in.MetadataCatalogConfig = expandMetadataCatalogConfig(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		log.Printf("[DEBUG] Updating AppFlow Flow (%s): %#v", d.Id(), in)
 		_, err := conn.UpdateFlowWithContext(ctx, in)
 
@@ -1364,6 +1421,92 @@ func resourceFlowDelete(ctx context.Context, d *schema.ResourceData, meta interf
 	return nil
 }
 
+// connectorOperatorAttributes maps source connector types to the connector_operator attribute valid for their tasks.
+var connectorOperatorAttributes = map[string]string{
+	appflow.ConnectorTypeAmplitude:       "amplitude",
+	appflow.ConnectorTypeCustomConnector: "custom_connector",
+	appflow.ConnectorTypeDatadog:         "datadog",
+	appflow.ConnectorTypeDynatrace:       "dynatrace",
+	appflow.ConnectorTypeGoogleanalytics: "google_analytics",
+	appflow.ConnectorTypeInfornexus:      "infor_nexus",
+	appflow.ConnectorTypeMarketo:         "marketo",
+	appflow.ConnectorTypeS3:              "s3",
+	appflow.ConnectorTypeSalesforce:      "salesforce",
+	appflow.ConnectorTypeSapodata:        "sapo_data",
+	appflow.ConnectorTypeServicenow:      "service_now",
+	appflow.ConnectorTypeSingular:        "singular",
+	appflow.ConnectorTypeSlack:           "slack",
+	appflow.ConnectorTypeTrendmicro:      "trendmicro",
+	appflow.ConnectorTypeVeeva:           "veeva",
+	appflow.ConnectorTypeZendesk:         "zendesk",
+}
+
+func resourceFlowCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	v, ok := diff.Get("source_flow_config").([]interface{})
+
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	connectorType, _ := v[0].(map[string]interface{})["connector_type"].(string)
+
+	// The source connector type may not be known until apply.
+	if connectorType == "" {
+		return nil
+	}
+
+	return validateTaskConnectorOperators(connectorType, diff.Get("task").(*schema.Set).List())
+}
+
+// validateTaskConnectorOperators returns an error if any task's connector_operator is not valid for the source connector type.
+func validateTaskConnectorOperators(connectorType string, tasks []interface{}) error {
+	expected, supported := connectorOperatorAttributes[connectorType]
+
+	for _, tfMapRaw := range tasks {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		taskType, _ := tfMap["task_type"].(string)
+
+		for _, tfOperatorRaw := range tfMap["connector_operator"].([]interface{}) {
+			tfOperator, ok := tfOperatorRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			var attrs []string
+
+			for k, v := range tfOperator {
+				if v, ok := v.(string); ok && v != "" {
+					attrs = append(attrs, k)
+				}
+			}
+
+			sort.Strings(attrs)
+
+			if len(attrs) > 1 {
+				return fmt.Errorf("task (%s): connector_operator must set exactly one operator, got %s", taskType, strings.Join(attrs, ", "))
+			}
+
+			for _, attr := range attrs {
+				if !supported {
+					return fmt.Errorf("task (%s): connector_operator is not supported for source connector type %q", taskType, connectorType)
+				}
+
+				if attr != expected {
+					return fmt.Errorf("task (%s): connector_operator %q is not valid for source connector type %q, use %q", taskType, attr, connectorType, expected)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func expandErrorHandlingConfig(tfMap map[string]interface{}) *appflow.ErrorHandlingConfig {
 	if tfMap == nil {
 		return nil
@@ -1924,6 +2067,42 @@ func expandIncrementalPullConfig(tfMap map[string]interface{}) *appflow.Incremen
 	return a
 }
 
+func expandMetadataCatalogConfig(tfMap map[string]interface{}) *appflow.MetadataCatalogConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	a := &appflow.MetadataCatalogConfig{}
+
+	if v, ok := tfMap["glue_data_catalog"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		a.GlueDataCatalog = expandGlueDataCatalogConfig(v[0].(map[string]interface{}))
+	}
+
+	return a
+}
+
+func expandGlueDataCatalogConfig(tfMap map[string]interface{}) *appflow.GlueDataCatalogConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	a := &appflow.GlueDataCatalogConfig{}
+
+	if v, ok := tfMap["database_name"].(string); ok && v != "" {
+		a.DatabaseName = aws.String(v)
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		a.RoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["table_prefix"].(string); ok && v != "" {
+		a.TablePrefix = aws.String(v)
+	}
+
+	return a
+}
+
 func expandSourceConnectorProperties(tfMap map[string]interface{}) *appflow.SourceConnectorProperties {
 	if tfMap == nil {
 		return nil
@@ -3032,6 +3211,42 @@ func flattenIncrementalPullConfig(incrementalPullConfig *appflow.IncrementalPull
 	return m
 }
 
+func flattenMetadataCatalogConfig(metadataCatalogConfig *appflow.MetadataCatalogConfig) map[string]interface{} {
+	if metadataCatalogConfig == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	if v := metadataCatalogConfig.GlueDataCatalog; v != nil {
+		m["glue_data_catalog"] = []interface{}{flattenGlueDataCatalogConfig(v)}
+	}
+
+	return m
+}
+
+func flattenGlueDataCatalogConfig(glueDataCatalogConfig *appflow.GlueDataCatalogConfig) map[string]interface{} {
+	if glueDataCatalogConfig == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	if v := glueDataCatalogConfig.DatabaseName; v != nil {
+		m["database_name"] = aws.StringValue(v)
+	}
+
+	if v := glueDataCatalogConfig.RoleArn; v != nil {
+		m["role_arn"] = aws.StringValue(v)
+	}
+
+	if v := glueDataCatalogConfig.TablePrefix; v != nil {
+		m["table_prefix"] = aws.StringValue(v)
+	}
+
+	return m
+}
+
 func flattenSourceConnectorProperties(sourceConnectorProperties *appflow.SourceConnectorProperties) map[string]interface{} {
 	if sourceConnectorProperties == nil {
 		return nil
@@ -50,6 +50,10 @@ func init() {
 	resource.AddTestSweepers("aws_appmesh_virtual_node", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_node",
 		F:    sweepVirtualNodes,
+		Dependencies: []string{
+			"aws_appmesh_route",
+			"aws_appmesh_virtual_service",
+		},
 	})
 
 	resource.AddTestSweepers("aws_appmesh_virtual_router", &resource.Sweeper{
@@ -57,12 +61,16 @@ func init() {
 		F:    sweepVirtualRouters,
 		Dependencies: []string{
 			"aws_appmesh_route",
+			"aws_appmesh_virtual_service",
 		},
 	})
 
 	resource.AddTestSweepers("aws_appmesh_virtual_service", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_service",
 		F:    sweepVirtualServices,
+		Dependencies: []string{
+			"aws_appmesh_gateway_route",
+		},
 	})
 }
 
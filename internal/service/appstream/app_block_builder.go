@@ -0,0 +1,374 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appstream
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_appstream_app_block_builder", name="App Block Builder")
+// @Tags(identifierAttribute="arn")
+func ResourceAppBlockBuilder() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAppBlockBuilderCreate,
+		ReadWithoutTimeout:   resourceAppBlockBuilderRead,
+		UpdateWithoutTimeout: resourceAppBlockBuilderUpdate,
+		DeleteWithoutTimeout: resourceAppBlockBuilderDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_endpoint": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MinItems: 1,
+				MaxItems: 4,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(appstream.AccessEndpointType_Values(), false),
+						},
+						"vpce_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"enable_default_internet_access": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"iam_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"platform": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(appstream.AppBlockBuilderPlatformType_Values(), false),
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"vpc_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAppBlockBuilderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &appstream.CreateAppBlockBuilderInput{
+		InstanceType: aws.String(d.Get("instance_type").(string)),
+		Name:         aws.String(name),
+		Platform:     aws.String(d.Get("platform").(string)),
+		Tags:         getTagsIn(ctx),
+		VpcConfig:    expandImageBuilderVPCConfig(d.Get("vpc_config").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("access_endpoint"); ok && v.(*schema.Set).Len() > 0 {
+		input.AccessEndpoints = expandAccessEndpoints(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("enable_default_internet_access"); ok {
+		input.EnableDefaultInternetAccess = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("iam_role_arn"); ok {
+		input.IamRoleArn = aws.String(v.(string))
+	}
+
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, iamPropagationTimeout, func() (interface{}, error) {
+		return conn.CreateAppBlockBuilderWithContext(ctx, input)
+	}, appstream.ErrCodeInvalidRoleException, "encountered an error because your IAM role")
+
+	if err != nil {
+		return diag.Errorf("creating AppStream App Block Builder (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(outputRaw.(*appstream.CreateAppBlockBuilderOutput).AppBlockBuilder.Name))
+
+	return resourceAppBlockBuilderRead(ctx, d, meta)
+}
+
+func resourceAppBlockBuilderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn(ctx)
+
+	appBlockBuilder, err := FindAppBlockBuilderByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream App Block Builder (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading AppStream App Block Builder (%s): %s", d.Id(), err)
+	}
+
+	if err = d.Set("access_endpoint", flattenAccessEndpoints(appBlockBuilder.AccessEndpoints)); err != nil {
+		return diag.Errorf("setting access_endpoint: %s", err)
+	}
+	d.Set("arn", appBlockBuilder.Arn)
+	d.Set("created_time", aws.TimeValue(appBlockBuilder.CreatedTime).Format(time.RFC3339))
+	d.Set("description", appBlockBuilder.Description)
+	d.Set("display_name", appBlockBuilder.DisplayName)
+	d.Set("enable_default_internet_access", appBlockBuilder.EnableDefaultInternetAccess)
+	d.Set("iam_role_arn", appBlockBuilder.IamRoleArn)
+	d.Set("instance_type", appBlockBuilder.InstanceType)
+	d.Set("name", appBlockBuilder.Name)
+	d.Set("platform", appBlockBuilder.Platform)
+	d.Set("state", appBlockBuilder.State)
+	if appBlockBuilder.VpcConfig != nil {
+		if err = d.Set("vpc_config", []interface{}{flattenVPCConfig(appBlockBuilder.VpcConfig)}); err != nil {
+			return diag.Errorf("setting vpc_config: %s", err)
+		}
+	} else {
+		d.Set("vpc_config", nil)
+	}
+
+	return nil
+}
+
+func resourceAppBlockBuilderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &appstream.UpdateAppBlockBuilderInput{
+			Name: aws.String(d.Id()),
+		}
+
+		if d.HasChange("access_endpoint") {
+			if v, ok := d.GetOk("access_endpoint"); ok && v.(*schema.Set).Len() > 0 {
+				input.AccessEndpoints = expandAccessEndpoints(v.(*schema.Set).List())
+			} else {
+				input.AttributesToDelete = append(input.AttributesToDelete, aws.String(appstream.AppBlockBuilderAttributeAccessEndpoints))
+			}
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("display_name") {
+			input.DisplayName = aws.String(d.Get("display_name").(string))
+		}
+
+		if d.HasChange("enable_default_internet_access") {
+			input.EnableDefaultInternetAccess = aws.Bool(d.Get("enable_default_internet_access").(bool))
+		}
+
+		if d.HasChange("iam_role_arn") {
+			if v, ok := d.GetOk("iam_role_arn"); ok {
+				input.IamRoleArn = aws.String(v.(string))
+			} else {
+				input.AttributesToDelete = append(input.AttributesToDelete, aws.String(appstream.AppBlockBuilderAttributeIamRoleArn))
+			}
+		}
+
+		if d.HasChange("instance_type") {
+			input.InstanceType = aws.String(d.Get("instance_type").(string))
+		}
+
+		if d.HasChange("platform") {
+			input.Platform = aws.String(d.Get("platform").(string))
+		}
+
+		if d.HasChange("vpc_config") {
+			input.VpcConfig = expandImageBuilderVPCConfig(d.Get("vpc_config").([]interface{}))
+
+			if len(input.VpcConfig.SecurityGroupIds) == 0 {
+				input.AttributesToDelete = append(input.AttributesToDelete, aws.String(appstream.AppBlockBuilderAttributeVpcConfigurationSecurityGroupIds))
+			}
+		}
+
+		// A running app block builder can only have its description and display name updated.
+		shouldStop := false
+
+		if d.HasChangesExcept("description", "display_name", "tags", "tags_all") {
+			appBlockBuilder, err := FindAppBlockBuilderByName(ctx, conn, d.Id())
+
+			if err != nil {
+				return diag.Errorf("reading AppStream App Block Builder (%s): %s", d.Id(), err)
+			}
+
+			if state := aws.StringValue(appBlockBuilder.State); state != appstream.AppBlockBuilderStateStopped {
+				shouldStop = true
+
+				if err := stopAppBlockBuilder(ctx, conn, d.Id(), state); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+
+		_, err := conn.UpdateAppBlockBuilderWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating AppStream App Block Builder (%s): %s", d.Id(), err)
+		}
+
+		if shouldStop {
+			_, err = conn.StartAppBlockBuilderWithContext(ctx, &appstream.StartAppBlockBuilderInput{
+				Name: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return diag.Errorf("starting AppStream App Block Builder (%s): %s", d.Id(), err)
+			}
+
+			if _, err = waitAppBlockBuilderStateRunning(ctx, conn, d.Id()); err != nil {
+				return diag.Errorf("waiting for AppStream App Block Builder (%s) to be running: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceAppBlockBuilderRead(ctx, d, meta)
+}
+
+func resourceAppBlockBuilderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn(ctx)
+
+	appBlockBuilder, err := FindAppBlockBuilderByName(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading AppStream App Block Builder (%s): %s", d.Id(), err)
+	}
+
+	if err := stopAppBlockBuilder(ctx, conn, d.Id(), aws.StringValue(appBlockBuilder.State)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting AppStream App Block Builder: %s", d.Id())
+	_, err = conn.DeleteAppBlockBuilderWithContext(ctx, &appstream.DeleteAppBlockBuilderInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting AppStream App Block Builder (%s): %s", d.Id(), err)
+	}
+
+	if _, err = waitAppBlockBuilderDeleted(ctx, conn, d.Id()); err != nil {
+		return diag.Errorf("waiting for AppStream App Block Builder (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func stopAppBlockBuilder(ctx context.Context, conn *appstream.AppStream, name, state string) error {
+	switch state {
+	case appstream.AppBlockBuilderStateStopped:
+		return nil
+	case appstream.AppBlockBuilderStateStarting:
+		// A starting app block builder can't be stopped.
+		if _, err := waitAppBlockBuilderStateRunning(ctx, conn, name); err != nil {
+			return fmt.Errorf("waiting for AppStream App Block Builder (%s) to be running: %w", name, err)
+		}
+	}
+
+	if state != appstream.AppBlockBuilderStateStopping {
+		_, err := conn.StopAppBlockBuilderWithContext(ctx, &appstream.StopAppBlockBuilderInput{
+			Name: aws.String(name),
+		})
+
+		if err != nil {
+			return fmt.Errorf("stopping AppStream App Block Builder (%s): %w", name, err)
+		}
+	}
+
+	if _, err := waitAppBlockBuilderStateStopped(ctx, conn, name); err != nil {
+		return fmt.Errorf("waiting for AppStream App Block Builder (%s) to be stopped: %w", name, err)
+	}
+
+	return nil
+}
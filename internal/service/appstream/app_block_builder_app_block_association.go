@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appstream
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_appstream_app_block_builder_app_block_association")
+func ResourceAppBlockBuilderAppBlockAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAppBlockBuilderAppBlockAssociationCreate,
+		ReadWithoutTimeout:   resourceAppBlockBuilderAppBlockAssociationRead,
+		DeleteWithoutTimeout: resourceAppBlockBuilderAppBlockAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_block_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"app_block_builder_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+const appBlockBuilderAppBlockAssociationResourceIDPartCount = 2
+
+func resourceAppBlockBuilderAppBlockAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn(ctx)
+
+	appBlockBuilderName, appBlockARN := d.Get("app_block_builder_name").(string), d.Get("app_block_arn").(string)
+	id, err := flex.FlattenResourceId([]string{appBlockBuilderName, appBlockARN}, appBlockBuilderAppBlockAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &appstream.AssociateAppBlockBuilderAppBlockInput{
+		AppBlockArn:         aws.String(appBlockARN),
+		AppBlockBuilderName: aws.String(appBlockBuilderName),
+	}
+
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, fleetOperationTimeout, func() (interface{}, error) {
+		return conn.AssociateAppBlockBuilderAppBlockWithContext(ctx, input)
+	}, appstream.ErrCodeResourceNotFoundException)
+
+	if err != nil {
+		return diag.Errorf("creating AppStream App Block Builder App Block Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAppBlockBuilderAppBlockAssociationRead(ctx, d, meta)
+}
+
+func resourceAppBlockBuilderAppBlockAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), appBlockBuilderAppBlockAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	appBlockBuilderName, appBlockARN := parts[0], parts[1]
+	association, err := FindAppBlockBuilderAppBlockAssociationByTwoPartKey(ctx, conn, appBlockBuilderName, appBlockARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream App Block Builder App Block Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading AppStream App Block Builder App Block Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("app_block_arn", association.AppBlockArn)
+	d.Set("app_block_builder_name", association.AppBlockBuilderName)
+
+	return nil
+}
+
+func resourceAppBlockBuilderAppBlockAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), appBlockBuilderAppBlockAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting AppStream App Block Builder App Block Association: %s", d.Id())
+	_, err = conn.DisassociateAppBlockBuilderAppBlockWithContext(ctx, &appstream.DisassociateAppBlockBuilderAppBlockInput{
+		AppBlockArn:         aws.String(parts[1]),
+		AppBlockBuilderName: aws.String(parts[0]),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting AppStream App Block Builder App Block Association (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
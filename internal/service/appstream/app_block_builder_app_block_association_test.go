@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appstream_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appstream"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfappstream "github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// App blocks can't yet be managed by the provider so an existing one must be supplied.
+func testAccAppBlockARNFromEnv(t *testing.T) string {
+	key := "APPSTREAM_APP_BLOCK_ARN"
+	appBlockARN := os.Getenv(key)
+	if appBlockARN == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	return appBlockARN
+}
+
+func TestAccAppStreamAppBlockBuilderAppBlockAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appstream_app_block_builder_app_block_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	appBlockARN := testAccAppBlockARNFromEnv(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppBlockBuilderAppBlockAssociationDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBlockBuilderAppBlockAssociationConfig_basic(rName, appBlockARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBlockBuilderAppBlockAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "app_block_arn", appBlockARN),
+					resource.TestCheckResourceAttrPair(resourceName, "app_block_builder_name", "aws_appstream_app_block_builder.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAppStreamAppBlockBuilderAppBlockAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appstream_app_block_builder_app_block_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	appBlockARN := testAccAppBlockARNFromEnv(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppBlockBuilderAppBlockAssociationDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBlockBuilderAppBlockAssociationConfig_basic(rName, appBlockARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBlockBuilderAppBlockAssociationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfappstream.ResourceAppBlockBuilderAppBlockAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAppBlockBuilderAppBlockAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamConn(ctx)
+
+		_, err = tfappstream.FindAppBlockBuilderAppBlockAssociationByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		return err
+	}
+}
+
+func testAccCheckAppBlockBuilderAppBlockAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appstream_app_block_builder_app_block_association" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfappstream.FindAppBlockBuilderAppBlockAssociationByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppStream App Block Builder App Block Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAppBlockBuilderAppBlockAssociationConfig_basic(rName, appBlockARN string) string {
+	return acctest.ConfigCompose(testAccAppBlockBuilderConfig_basic(rName, "stream.standard.small"), fmt.Sprintf(`
+resource "aws_appstream_app_block_builder_app_block_association" "test" {
+  app_block_arn          = %[1]q
+  app_block_builder_name = aws_appstream_app_block_builder.test.name
+}
+`, appBlockARN))
+}
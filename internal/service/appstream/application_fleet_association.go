@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appstream
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_appstream_application_fleet_association")
+func ResourceApplicationFleetAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationFleetAssociationCreate,
+		ReadWithoutTimeout:   resourceApplicationFleetAssociationRead,
+		DeleteWithoutTimeout: resourceApplicationFleetAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"fleet_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+const applicationFleetAssociationResourceIDPartCount = 2
+
+func resourceApplicationFleetAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn(ctx)
+
+	fleetName, applicationARN := d.Get("fleet_name").(string), d.Get("application_arn").(string)
+	id, err := flex.FlattenResourceId([]string{fleetName, applicationARN}, applicationFleetAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &appstream.AssociateApplicationFleetInput{
+		ApplicationArn: aws.String(applicationARN),
+		FleetName:      aws.String(fleetName),
+	}
+
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, fleetOperationTimeout, func() (interface{}, error) {
+		return conn.AssociateApplicationFleetWithContext(ctx, input)
+	}, appstream.ErrCodeResourceNotFoundException)
+
+	if err != nil {
+		return diag.Errorf("creating AppStream Application Fleet Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceApplicationFleetAssociationRead(ctx, d, meta)
+}
+
+func resourceApplicationFleetAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), applicationFleetAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	fleetName, applicationARN := parts[0], parts[1]
+	association, err := FindApplicationFleetAssociationByTwoPartKey(ctx, conn, fleetName, applicationARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream Application Fleet Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading AppStream Application Fleet Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application_arn", association.ApplicationArn)
+	d.Set("fleet_name", association.FleetName)
+
+	return nil
+}
+
+func resourceApplicationFleetAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), applicationFleetAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting AppStream Application Fleet Association: %s", d.Id())
+	_, err = conn.DisassociateApplicationFleetWithContext(ctx, &appstream.DisassociateApplicationFleetInput{
+		ApplicationArn: aws.String(parts[1]),
+		FleetName:      aws.String(parts[0]),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting AppStream Application Fleet Association (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -195,3 +195,159 @@ func FindFleetStackAssociation(ctx context.Context, conn *appstream.AppStream, f
 
 	return nil
 }
+
+func FindAppBlockBuilderByName(ctx context.Context, conn *appstream.AppStream, name string) (*appstream.AppBlockBuilder, error) {
+	input := &appstream.DescribeAppBlockBuildersInput{
+		Names: aws.StringSlice([]string{name}),
+	}
+
+	output, err := findAppBlockBuilder(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.Name) != name {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findAppBlockBuilders(ctx context.Context, conn *appstream.AppStream, input *appstream.DescribeAppBlockBuildersInput) ([]*appstream.AppBlockBuilder, error) {
+	var output []*appstream.AppBlockBuilder
+
+	err := describeAppBlockBuildersPages(ctx, conn, input, func(page *appstream.DescribeAppBlockBuildersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AppBlockBuilders {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findAppBlockBuilder(ctx context.Context, conn *appstream.AppStream, input *appstream.DescribeAppBlockBuildersInput) (*appstream.AppBlockBuilder, error) {
+	output, err := findAppBlockBuilders(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindAppBlockBuilderAppBlockAssociationByTwoPartKey(ctx context.Context, conn *appstream.AppStream, appBlockBuilderName, appBlockARN string) (*appstream.AppBlockBuilderAppBlockAssociation, error) {
+	input := &appstream.DescribeAppBlockBuilderAppBlockAssociationsInput{
+		AppBlockArn:         aws.String(appBlockARN),
+		AppBlockBuilderName: aws.String(appBlockBuilderName),
+	}
+	var output []*appstream.AppBlockBuilderAppBlockAssociation
+
+	err := describeAppBlockBuilderAppBlockAssociationsPages(ctx, conn, input, func(page *appstream.DescribeAppBlockBuilderAppBlockAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AppBlockBuilderAppBlockAssociations {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindApplicationFleetAssociationByTwoPartKey(ctx context.Context, conn *appstream.AppStream, fleetName, applicationARN string) (*appstream.ApplicationFleetAssociation, error) {
+	input := &appstream.DescribeApplicationFleetAssociationsInput{
+		ApplicationArn: aws.String(applicationARN),
+		FleetName:      aws.String(fleetName),
+	}
+	var output []*appstream.ApplicationFleetAssociation
+
+	err := describeApplicationFleetAssociationsPages(ctx, conn, input, func(page *appstream.DescribeApplicationFleetAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ApplicationFleetAssociations {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
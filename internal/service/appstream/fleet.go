@@ -52,7 +52,8 @@ func ResourceFleet() *schema.Resource {
 			"compute_capacity": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"available": {
@@ -154,6 +155,11 @@ func ResourceFleet() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"max_concurrent_sessions": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
 			"max_user_duration_in_seconds": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -165,6 +171,12 @@ func ResourceFleet() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"platform": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(appstream.PlatformType_Values(), false),
+			},
 			"stream_view": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -206,10 +218,14 @@ func ResourceFleet() *schema.Resource {
 func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).AppStreamConn(ctx)
 	input := &appstream.CreateFleetInput{
-		Name:            aws.String(d.Get("name").(string)),
-		InstanceType:    aws.String(d.Get("instance_type").(string)),
-		ComputeCapacity: expandComputeCapacity(d.Get("compute_capacity").([]interface{})),
-		Tags:            getTagsIn(ctx),
+		Name:         aws.String(d.Get("name").(string)),
+		InstanceType: aws.String(d.Get("instance_type").(string)),
+		Tags:         getTagsIn(ctx),
+	}
+
+	// Elastic fleets don't have a compute capacity.
+	if v, ok := d.GetOk("compute_capacity"); ok {
+		input.ComputeCapacity = expandComputeCapacity(v.([]interface{}))
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -252,10 +268,18 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.IamRoleArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("max_concurrent_sessions"); ok {
+		input.MaxConcurrentSessions = aws.Int64(int64(v.(int)))
+	}
+
 	if v, ok := d.GetOk("max_user_duration_in_seconds"); ok {
 		input.MaxUserDurationInSeconds = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := d.GetOk("platform"); ok {
+		input.Platform = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("stream_view"); ok {
 		input.StreamView = aws.String(v.(string))
 	}
@@ -363,8 +387,10 @@ func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("image_name", fleet.ImageName)
 	d.Set("image_arn", fleet.ImageArn)
 	d.Set("instance_type", fleet.InstanceType)
+	d.Set("max_concurrent_sessions", fleet.MaxConcurrentSessions)
 	d.Set("max_user_duration_in_seconds", fleet.MaxUserDurationInSeconds)
 	d.Set("name", fleet.Name)
+	d.Set("platform", fleet.Platform)
 	d.Set("state", fleet.State)
 	d.Set("stream_view", fleet.StreamView)
 
@@ -386,7 +412,7 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 	shouldStop := false
 
-	if d.HasChanges("description", "domain_join_info", "enable_default_internet_access", "iam_role_arn", "instance_type", "max_user_duration_in_seconds", "stream_view", "vpc_config") {
+	if d.HasChanges("description", "domain_join_info", "enable_default_internet_access", "iam_role_arn", "instance_type", "max_concurrent_sessions", "max_user_duration_in_seconds", "platform", "stream_view", "vpc_config") {
 		shouldStop = true
 	}
 
@@ -451,10 +477,18 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.InstanceType = aws.String(d.Get("instance_type").(string))
 	}
 
+	if d.HasChange("max_concurrent_sessions") {
+		input.MaxConcurrentSessions = aws.Int64(int64(d.Get("max_concurrent_sessions").(int)))
+	}
+
 	if d.HasChange("max_user_duration_in_seconds") {
 		input.MaxUserDurationInSeconds = aws.Int64(int64(d.Get("max_user_duration_in_seconds").(int)))
 	}
 
+	if d.HasChange("platform") {
+		input.Platform = aws.String(d.Get("platform").(string))
+	}
+
 	if d.HasChange("vpc_config") {
 		input.VpcConfig = expandVPCConfig(d.Get("vpc_config").([]interface{}))
 	}
@@ -308,6 +308,48 @@ func TestAccAppStreamFleet_emptyDomainJoin(t *testing.T) {
 	})
 }
 
+func TestAccAppStreamFleet_elastic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleetOutput appstream.Fleet
+	resourceName := "aws_appstream_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckHasIAMRole(ctx, t, "AmazonAppStreamServiceAccess")
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_elastic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleetOutput),
+					resource.TestCheckResourceAttr(resourceName, "fleet_type", appstream.FleetTypeElastic),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrent_sessions", "1"),
+					resource.TestCheckResourceAttr(resourceName, "platform", appstream.PlatformTypeAmazonLinux2),
+					resource.TestCheckResourceAttr(resourceName, "state", appstream.FleetStateRunning),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFleetConfig_elastic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleetOutput),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrent_sessions", "2"),
+					resource.TestCheckResourceAttr(resourceName, "state", appstream.FleetStateRunning),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckFleetExists(ctx context.Context, resourceName string, appStreamFleet *appstream.Fleet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -515,3 +557,19 @@ resource "aws_appstream_fleet" "test" {
 }
 `, name, instanceType, empty)
 }
+
+func testAccFleetConfig_elastic(name string, maxConcurrentSessions int) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(name, 2), fmt.Sprintf(`
+resource "aws_appstream_fleet" "test" {
+  name                    = %[1]q
+  fleet_type              = "ELASTIC"
+  instance_type           = "stream.standard.small"
+  max_concurrent_sessions = %[2]d
+  platform                = "AMAZON_LINUX2"
+
+  vpc_config {
+    subnet_ids = aws_subnet.test[*].id
+  }
+}
+`, name, maxConcurrentSessions))
+}
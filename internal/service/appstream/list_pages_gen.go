@@ -1,4 +1,4 @@
-// Code generated by "internal/generate/listpages/main.go -ListOps=DescribeDirectoryConfigs,DescribeFleets,DescribeImageBuilders,DescribeStacks,DescribeUsers,ListAssociatedStacks"; DO NOT EDIT.
+// Code generated by "internal/generate/listpages/main.go -ListOps=DescribeAppBlockBuilderAppBlockAssociations,DescribeAppBlockBuilders,DescribeApplicationFleetAssociations,DescribeDirectoryConfigs,DescribeFleets,DescribeImageBuilders,DescribeStacks,DescribeUsers,ListAssociatedStacks"; DO NOT EDIT.
 
 package appstream
 
@@ -10,6 +10,57 @@ import (
 	"github.com/aws/aws-sdk-go/service/appstream/appstreamiface"
 )
 
+func describeAppBlockBuilderAppBlockAssociationsPages(ctx context.Context, conn appstreamiface.AppStreamAPI, input *appstream.DescribeAppBlockBuilderAppBlockAssociationsInput, fn func(*appstream.DescribeAppBlockBuilderAppBlockAssociationsOutput, bool) bool) error {
+	for {
+		output, err := conn.DescribeAppBlockBuilderAppBlockAssociationsWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+
+func describeAppBlockBuildersPages(ctx context.Context, conn appstreamiface.AppStreamAPI, input *appstream.DescribeAppBlockBuildersInput, fn func(*appstream.DescribeAppBlockBuildersOutput, bool) bool) error {
+	for {
+		output, err := conn.DescribeAppBlockBuildersWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+
+func describeApplicationFleetAssociationsPages(ctx context.Context, conn appstreamiface.AppStreamAPI, input *appstream.DescribeApplicationFleetAssociationsInput, fn func(*appstream.DescribeApplicationFleetAssociationsOutput, bool) bool) error {
+	for {
+		output, err := conn.DescribeApplicationFleetAssociationsWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+
 func describeDirectoryConfigsPages(ctx context.Context, conn appstreamiface.AppStreamAPI, input *appstream.DescribeDirectoryConfigsInput, fn func(*appstream.DescribeDirectoryConfigsOutput, bool) bool) error {
 	for {
 		output, err := conn.DescribeDirectoryConfigsWithContext(ctx, input)
@@ -29,6 +29,22 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceAppBlockBuilder,
+			TypeName: "aws_appstream_app_block_builder",
+			Name:     "App Block Builder",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceAppBlockBuilderAppBlockAssociation,
+			TypeName: "aws_appstream_app_block_builder_app_block_association",
+		},
+		{
+			Factory:  ResourceApplicationFleetAssociation,
+			TypeName: "aws_appstream_application_fleet_association",
+		},
 		{
 			Factory:  ResourceDirectoryConfig,
 			TypeName: "aws_appstream_directory_config",
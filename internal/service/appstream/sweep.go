@@ -12,11 +12,23 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
+	resource.AddTestSweepers("aws_appstream_app_block_builder", &resource.Sweeper{
+		Name: "aws_appstream_app_block_builder",
+		F:    sweepAppBlockBuilders,
+	})
+
+	resource.AddTestSweepers("aws_appstream_application_fleet_association", &resource.Sweeper{
+		Name: "aws_appstream_application_fleet_association",
+		F:    sweepApplicationFleetAssociations,
+	})
+
 	resource.AddTestSweepers("aws_appstream_directory_config", &resource.Sweeper{
 		Name: "aws_appstream_directory_config",
 		F:    sweepDirectoryConfigs,
@@ -25,6 +37,9 @@ func init() {
 	resource.AddTestSweepers("aws_appstream_fleet", &resource.Sweeper{
 		Name: "aws_appstream_fleet",
 		F:    sweepFleets,
+		Dependencies: []string{
+			"aws_appstream_application_fleet_association",
+		},
 	})
 
 	resource.AddTestSweepers("aws_appstream_image_builder", &resource.Sweeper{
@@ -38,6 +53,121 @@ func init() {
 	})
 }
 
+func sweepAppBlockBuilders(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.AppStreamConn(ctx)
+	input := &appstream.DescribeAppBlockBuildersInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = describeAppBlockBuildersPages(ctx, conn, input, func(page *appstream.DescribeAppBlockBuildersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AppBlockBuilders {
+			r := ResourceAppBlockBuilder()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping AppStream App Block Builder sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing AppStream App Block Builders (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping AppStream App Block Builders (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepApplicationFleetAssociations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.AppStreamConn(ctx)
+	input := &appstream.DescribeFleetsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	err = describeFleetsPages(ctx, conn, input, func(page *appstream.DescribeFleetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Fleets {
+			fleetName := aws.StringValue(v.Name)
+			input := &appstream.DescribeApplicationFleetAssociationsInput{
+				FleetName: aws.String(fleetName),
+			}
+
+			err := describeApplicationFleetAssociationsPages(ctx, conn, input, func(page *appstream.DescribeApplicationFleetAssociationsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.ApplicationFleetAssociations {
+					id, err := flex.FlattenResourceId([]string{fleetName, aws.StringValue(v.ApplicationArn)}, applicationFleetAssociationResourceIDPartCount, false)
+
+					if err != nil {
+						errs = multierror.Append(errs, err)
+						continue
+					}
+
+					r := ResourceApplicationFleetAssociation()
+					d := r.Data(nil)
+					d.SetId(id)
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing AppStream Application Fleet Associations (%s): %w", fleetName, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping AppStream Application Fleet Association sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing AppStream Fleets (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping AppStream Application Fleet Associations (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
 func sweepDirectoryConfigs(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -23,6 +23,8 @@ const (
 	// imageBuilderStateTimeout Maximum amount of time to wait for the statusImageBuilderState to be RUNNING
 	// or for the ImageBuilder to be deleted
 	imageBuilderStateTimeout = 60 * time.Minute
+	// appBlockBuilderStateTimeout Maximum amount of time to wait for the statusAppBlockBuilderState to be RUNNING or STOPPED
+	appBlockBuilderStateTimeout = 60 * time.Minute
 	// userOperationTimeout Maximum amount of time to wait for User operation eventual consistency
 	userOperationTimeout = 4 * time.Minute
 	// iamPropagationTimeout Maximum amount of time to wait for an iam resource eventual consistency
@@ -156,3 +158,68 @@ func waitUserAvailable(ctx context.Context, conn *appstream.AppStream, username,
 
 	return nil, err
 }
+
+func waitAppBlockBuilderStateRunning(ctx context.Context, conn *appstream.AppStream, name string) (*appstream.AppBlockBuilder, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{appstream.AppBlockBuilderStateStarting},
+		Target:  []string{appstream.AppBlockBuilderStateRunning},
+		Refresh: statusAppBlockBuilderState(ctx, conn, name),
+		Timeout: appBlockBuilderStateTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*appstream.AppBlockBuilder); ok {
+		tfresource.SetLastError(err, appBlockBuilderErrors(output))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAppBlockBuilderStateStopped(ctx context.Context, conn *appstream.AppStream, name string) (*appstream.AppBlockBuilder, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{appstream.AppBlockBuilderStateStarting, appstream.AppBlockBuilderStateStopping},
+		Target:  []string{appstream.AppBlockBuilderStateStopped},
+		Refresh: statusAppBlockBuilderState(ctx, conn, name),
+		Timeout: appBlockBuilderStateTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*appstream.AppBlockBuilder); ok {
+		tfresource.SetLastError(err, appBlockBuilderErrors(output))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAppBlockBuilderDeleted(ctx context.Context, conn *appstream.AppStream, name string) (*appstream.AppBlockBuilder, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{appstream.AppBlockBuilderStateStopped},
+		Target:  []string{},
+		Refresh: statusAppBlockBuilderState(ctx, conn, name),
+		Timeout: appBlockBuilderStateTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*appstream.AppBlockBuilder); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func appBlockBuilderErrors(apiObject *appstream.AppBlockBuilder) error {
+	var errs *multierror.Error
+
+	for _, err := range apiObject.AppBlockBuilderErrors {
+		errs = multierror.Append(errs, fmt.Errorf("%s: %s", aws.StringValue(err.ErrorCode), aws.StringValue(err.ErrorMessage)))
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -218,6 +218,34 @@ func ResourceGroup() *schema.Resource {
 					},
 				},
 			},
+			"latest_instance_refresh": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_refresh_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instances_to_update": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"percentage_complete": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"launch_configuration": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -1166,6 +1194,24 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return sdkdiag.AppendErrorf(diags, "setting tag: %s", err)
 	}
 
+	// Instance refreshes are only looked up for groups that manage them.
+	if v, ok := d.GetOk("instance_refresh"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		instanceRefresh, err := findLatestInstanceRefresh(ctx, conn, d.Id())
+
+		switch {
+		case tfresource.NotFound(err):
+			d.Set("latest_instance_refresh", nil)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading Auto Scaling Group (%s) instance refreshes: %s", d.Id(), err)
+		default:
+			if err := d.Set("latest_instance_refresh", []interface{}{flattenInstanceRefresh(instanceRefresh)}); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting latest_instance_refresh: %s", err)
+			}
+		}
+	} else {
+		d.Set("latest_instance_refresh", nil)
+	}
+
 	return diags
 }
 
@@ -1932,6 +1978,34 @@ func findInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, inp
 	return output[0], nil
 }
 
+// findLatestInstanceRefresh returns the most recently started instance refresh for the specified group.
+func findLatestInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, name string) (*autoscaling.InstanceRefresh, error) {
+	input := &autoscaling.DescribeInstanceRefreshesInput{
+		AutoScalingGroupName: aws.String(name),
+		MaxRecords:           aws.Int64(1),
+	}
+
+	// Instance refreshes are returned in descending order of start time.
+	output, err := conn.DescribeInstanceRefreshesWithContext(ctx, input)
+
+	if tfawserr.ErrMessageContains(err, ErrCodeValidationError, "not found") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.InstanceRefreshes) == 0 || output.InstanceRefreshes[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.InstanceRefreshes[0], nil
+}
+
 func FindInstanceRefreshes(ctx context.Context, conn *autoscaling.AutoScaling, input *autoscaling.DescribeInstanceRefreshesInput) ([]*autoscaling.InstanceRefresh, error) {
 	var output []*autoscaling.InstanceRefresh
 
@@ -3462,6 +3536,36 @@ func flattenLaunchTemplateOverrideses(apiObjects []*autoscaling.LaunchTemplateOv
 	return tfList
 }
 
+func flattenInstanceRefresh(apiObject *autoscaling.InstanceRefresh) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.InstanceRefreshId; v != nil {
+		tfMap["instance_refresh_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.InstancesToUpdate; v != nil {
+		tfMap["instances_to_update"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.PercentageComplete; v != nil {
+		tfMap["percentage_complete"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Status; v != nil {
+		tfMap["status"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.StatusReason; v != nil {
+		tfMap["status_reason"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
 func flattenInstanceRequirements(apiObject *autoscaling.InstanceRequirements) map[string]interface{} {
 	if apiObject == nil {
 		return nil
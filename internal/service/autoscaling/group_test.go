@@ -1275,6 +1275,7 @@ func TestAccAutoScalingGroup_InstanceRefresh_start(t *testing.T) {
 					testAccCheckGroupExists(ctx, resourceName, &group),
 					resource.TestCheckResourceAttrPair(resourceName, "launch_configuration", launchConfigurationResourceName, "name"),
 					testAccCheckInstanceRefreshCount(ctx, &group, 0),
+					resource.TestCheckResourceAttr(resourceName, "latest_instance_refresh.#", "0"),
 				),
 			},
 			{
@@ -1284,6 +1285,10 @@ func TestAccAutoScalingGroup_InstanceRefresh_start(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "launch_configuration", launchConfigurationResourceName, "name"),
 					testAccCheckInstanceRefreshCount(ctx, &group, 1),
 					testAccCheckInstanceRefreshStatus(ctx, &group, 0, autoscaling.InstanceRefreshStatusPending, autoscaling.InstanceRefreshStatusInProgress),
+					resource.TestCheckResourceAttr(resourceName, "latest_instance_refresh.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "latest_instance_refresh.0.instance_refresh_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "latest_instance_refresh.0.percentage_complete"),
+					resource.TestMatchResourceAttr(resourceName, "latest_instance_refresh.0.status", regexp.MustCompile(`^(Pending|InProgress)$`)),
 				),
 			},
 			{
@@ -5,12 +5,14 @@ package ce
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/costexplorer"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -92,7 +94,10 @@ func ResourceAnomalySubscription() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceAnomalySubscriptionCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -164,7 +169,7 @@ func resourceAnomalySubscriptionRead(ctx context.Context, d *schema.ResourceData
 func resourceAnomalySubscriptionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).CEConn(ctx)
 
-	if d.HasChangesExcept("tags", "tags_All") {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
 		input := &costexplorer.UpdateAnomalySubscriptionInput{
 			SubscriptionArn: aws.String(d.Id()),
 		}
@@ -211,6 +216,43 @@ func resourceAnomalySubscriptionDelete(ctx context.Context, d *schema.ResourceDa
 	return nil
 }
 
+func resourceAnomalySubscriptionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := d.GetRawConfig()
+
+	if v := config.GetAttr("threshold_expression"); v.IsWhollyKnown() && !v.IsNull() && v.LengthInt() > 0 {
+		tfMap, _ := d.Get("threshold_expression").([]interface{})[0].(map[string]interface{})
+
+		if err := validateCostExpression(tfMap); err != nil {
+			return fmt.Errorf("threshold_expression: %w", err)
+		}
+	}
+
+	if !config.GetAttr("frequency").IsWhollyKnown() || !config.GetAttr("subscriber").IsWhollyKnown() {
+		return nil
+	}
+
+	// Immediate alerts are only sent to SNS topics and daily or weekly summaries only to email addresses.
+	frequency := d.Get("frequency").(string)
+	subscriberType := costexplorer.SubscriberTypeEmail
+	if frequency == costexplorer.AnomalySubscriptionFrequencyImmediate {
+		subscriberType = costexplorer.SubscriberTypeSns
+	}
+
+	for _, tfMapRaw := range d.Get("subscriber").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v := tfMap["type"].(string); v != subscriberType {
+			return fmt.Errorf("subscriber type %s is not supported with frequency %s, use %s", v, frequency, subscriberType)
+		}
+	}
+
+	return nil
+}
+
 func expandAnomalySubscriptionMonitorARNList(rawMonitorArnList []interface{}) []string {
 	if len(rawMonitorArnList) == 0 {
 		return nil
@@ -228,6 +228,30 @@ func TestAccCEAnomalySubscription_Subscriber(t *testing.T) {
 	})
 }
 
+func TestAccCEAnomalySubscription_planTimeValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalySubscriptionDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnomalySubscriptionConfig_frequency(rName, "IMMEDIATE", address),
+				ExpectError: regexp.MustCompile(`subscriber type EMAIL is not supported with frequency IMMEDIATE`),
+			},
+			{
+				Config:      testAccAnomalySubscriptionConfig_thresholdExpressionMultipleOperators(rName, address),
+				ExpectError: regexp.MustCompile(`threshold_expression: exactly one of`),
+			},
+		},
+	})
+}
+
 func TestAccCEAnomalySubscription_Tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var subscription costexplorer.AnomalySubscription
@@ -463,6 +487,39 @@ resource "aws_ce_anomaly_subscription" "test" {
 `, rName, rFrequency, address))
 }
 
+func testAccAnomalySubscriptionConfig_thresholdExpressionMultipleOperators(rName string, address string) string {
+	return acctest.ConfigCompose(
+		testAccAnomalySubscriptionConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_ce_anomaly_subscription" "test" {
+  name      = %[1]q
+  frequency = "DAILY"
+
+  monitor_arn_list = [
+    aws_ce_anomaly_monitor.test.arn,
+  ]
+
+  subscriber {
+    type    = "EMAIL"
+    address = %[2]q
+  }
+
+  threshold_expression {
+    dimension {
+      key           = "ANOMALY_TOTAL_IMPACT_ABSOLUTE"
+      values        = ["100.0"]
+      match_options = ["GREATER_THAN_OR_EQUAL"]
+    }
+
+    tags {
+      key    = "CostCenter"
+      values = ["10000"]
+    }
+  }
+}
+`, rName, address))
+}
+
 func testAccAnomalySubscriptionConfig_subscriber2(rName string, address1 string, address2 string) string {
 	return acctest.ConfigCompose(
 		testAccAnomalySubscriptionConfigBase(rName),
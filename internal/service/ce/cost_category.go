@@ -5,6 +5,8 @@ package ce
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/costexplorer"
@@ -35,7 +37,10 @@ func ResourceCostCategory() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: customdiff.Sequence(verify.SetTagsDiff),
+		CustomizeDiff: customdiff.Sequence(
+			resourceCostCategoryCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -376,6 +381,84 @@ func schemaCostCategoryRuleExpression() *schema.Resource {
 	}
 }
 
+// costExpressionOperators are the mutually exclusive arguments of an Expression.
+var costExpressionOperators = []string{"and", "cost_category", "dimension", "not", "or", "tags"}
+
+// validateCostExpression checks that an Expression, and each operand Expression nested in it, specifies exactly one operator.
+func validateCostExpression(tfMap map[string]interface{}) error {
+	var allowed, specified []string
+
+	for _, k := range costExpressionOperators {
+		v, ok := tfMap[k]
+
+		if !ok {
+			continue
+		}
+
+		allowed = append(allowed, k)
+
+		var tfList []interface{}
+
+		switch v := v.(type) {
+		case *schema.Set:
+			tfList = v.List()
+		case []interface{}:
+			tfList = v
+		}
+
+		if len(tfList) == 0 {
+			continue
+		}
+
+		specified = append(specified, k)
+
+		switch k {
+		case "and", "not", "or":
+			for _, tfMapRaw := range tfList {
+				tfMap, _ := tfMapRaw.(map[string]interface{})
+
+				if err := validateCostExpression(tfMap); err != nil {
+					return fmt.Errorf("%s: %w", k, err)
+				}
+			}
+		}
+	}
+
+	if len(specified) != 1 {
+		if len(allowed) == 0 {
+			allowed = costExpressionOperators
+		}
+
+		return fmt.Errorf("exactly one of %s must be specified, got %d", strings.Join(allowed, ", "), len(specified))
+	}
+
+	return nil
+}
+
+func resourceCostCategoryCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if v := d.GetRawConfig().GetAttr("rule"); !v.IsWhollyKnown() || v.IsNull() {
+		return nil
+	}
+
+	for _, tfMapRaw := range d.Get("rule").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["rule"].([]interface{}); ok && len(v) > 0 {
+			tfMap, _ := v[0].(map[string]interface{})
+
+			if err := validateCostExpression(tfMap); err != nil {
+				return fmt.Errorf("rule: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceCostCategoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).CEConn(ctx)
 
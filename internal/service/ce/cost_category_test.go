@@ -180,6 +180,24 @@ func TestAccCECostCategory_splitCharge(t *testing.T) {
 	})
 }
 
+func TestAccCECostCategory_ruleExpressionMultipleOperators(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCostCategoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCostCategoryConfig_ruleExpressionMultipleOperators(rName),
+				ExpectError: regexp.MustCompile(`rule: exactly one of`),
+			},
+		},
+	})
+}
+
 func TestAccCECostCategory_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var output costexplorer.CostCategory
@@ -319,6 +337,34 @@ resource "aws_ce_cost_category" "test" {
 `, rName)
 }
 
+func testAccCostCategoryConfig_ruleExpressionMultipleOperators(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_cost_category" "test" {
+  name         = %[1]q
+  rule_version = "CostCategoryExpression.v1"
+
+  rule {
+    value = "production"
+
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = ["-prod"]
+        match_options = ["ENDS_WITH"]
+      }
+
+      tags {
+        key    = "Environment"
+        values = ["production"]
+      }
+    }
+
+    type = "REGULAR"
+  }
+}
+`, rName)
+}
+
 func testAccCostCategoryConfig_operandAnd(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ce_cost_category" "test" {
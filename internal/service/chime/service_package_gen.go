@@ -30,36 +30,43 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
 		{
-			Factory:  ResourceVoiceConnector,
-			TypeName: "aws_chime_voice_connector",
-			Name:     "Voice Connector",
+			Factory:    ResourceVoiceConnector,
+			TypeName:   "aws_chime_voice_connector",
+			Name:       "Voice Connector",
+			Partitions: []string{"aws"},
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
 		},
 		{
-			Factory:  ResourceVoiceConnectorGroup,
-			TypeName: "aws_chime_voice_connector_group",
+			Factory:    ResourceVoiceConnectorGroup,
+			TypeName:   "aws_chime_voice_connector_group",
+			Partitions: []string{"aws"},
 		},
 		{
-			Factory:  ResourceVoiceConnectorLogging,
-			TypeName: "aws_chime_voice_connector_logging",
+			Factory:    ResourceVoiceConnectorLogging,
+			TypeName:   "aws_chime_voice_connector_logging",
+			Partitions: []string{"aws"},
 		},
 		{
-			Factory:  ResourceVoiceConnectorOrigination,
-			TypeName: "aws_chime_voice_connector_origination",
+			Factory:    ResourceVoiceConnectorOrigination,
+			TypeName:   "aws_chime_voice_connector_origination",
+			Partitions: []string{"aws"},
 		},
 		{
-			Factory:  ResourceVoiceConnectorStreaming,
-			TypeName: "aws_chime_voice_connector_streaming",
+			Factory:    ResourceVoiceConnectorStreaming,
+			TypeName:   "aws_chime_voice_connector_streaming",
+			Partitions: []string{"aws"},
 		},
 		{
-			Factory:  ResourceVoiceConnectorTermination,
-			TypeName: "aws_chime_voice_connector_termination",
+			Factory:    ResourceVoiceConnectorTermination,
+			TypeName:   "aws_chime_voice_connector_termination",
+			Partitions: []string{"aws"},
 		},
 		{
-			Factory:  ResourceVoiceConnectorTerminationCredentials,
-			TypeName: "aws_chime_voice_connector_termination_credentials",
+			Factory:    ResourceVoiceConnectorTerminationCredentials,
+			TypeName:   "aws_chime_voice_connector_termination_credentials",
+			Partitions: []string{"aws"},
 		},
 	}
 }
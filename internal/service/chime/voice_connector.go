@@ -22,6 +22,7 @@ import (
 
 // @SDKResource("aws_chime_voice_connector", name="Voice Connector")
 // @Tags(identifierAttribute="arn")
+// @Partitions("aws")
 func ResourceVoiceConnector() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVoiceConnectorCreate,
@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKResource("aws_chime_voice_connector_group")
+// @Partitions("aws")
 func ResourceVoiceConnectorGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVoiceConnectorGroupCreate,
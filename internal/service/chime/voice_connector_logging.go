@@ -16,6 +16,7 @@ import (
 )
 
 // @SDKResource("aws_chime_voice_connector_logging")
+// @Partitions("aws")
 func ResourceVoiceConnectorLogging() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVoiceConnectorLoggingCreate,
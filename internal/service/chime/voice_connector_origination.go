@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKResource("aws_chime_voice_connector_origination")
+// @Partitions("aws")
 func ResourceVoiceConnectorOrigination() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVoiceConnectorOriginationCreate,
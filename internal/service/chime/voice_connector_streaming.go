@@ -18,6 +18,7 @@ import (
 )
 
 // @SDKResource("aws_chime_voice_connector_streaming")
+// @Partitions("aws")
 func ResourceVoiceConnectorStreaming() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVoiceConnectorStreamingCreate,
@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKResource("aws_chime_voice_connector_termination")
+// @Partitions("aws")
 func ResourceVoiceConnectorTermination() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVoiceConnectorTerminationCreate,
@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKResource("aws_chime_voice_connector_termination_credentials")
+// @Partitions("aws")
 func ResourceVoiceConnectorTerminationCredentials() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVoiceConnectorTerminationCredentialsCreate,
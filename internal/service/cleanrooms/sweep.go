@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build sweep
+// +build sweep
+
+package cleanrooms
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_cleanrooms_configured_table_association", &resource.Sweeper{
+		Name: "aws_cleanrooms_configured_table_association",
+		F:    sweepConfiguredTableAssociations,
+	})
+}
+
+func sweepConfiguredTableAssociations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	conn := client.CleanRoomsClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+	in := &cleanrooms.ListMembershipsInput{
+		Status: types.MembershipStatusActive,
+	}
+	var errs *multierror.Error
+
+	pages := cleanrooms.NewListMembershipsPaginator(conn, in)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping CleanRooms Configured Table Associations sweep for %s: %s", region, err)
+			return errs.ErrorOrNil()
+		}
+
+		if err != nil {
+			return multierror.Append(errs, fmt.Errorf("retrieving CleanRooms Memberships: %w", err))
+		}
+
+		for _, membership := range page.MembershipSummaries {
+			membershipID := aws.ToString(membership.Id)
+			pages := cleanrooms.NewListConfiguredTableAssociationsPaginator(conn, &cleanrooms.ListConfiguredTableAssociationsInput{
+				MembershipIdentifier: aws.String(membershipID),
+			})
+
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if err != nil {
+					errs = multierror.Append(errs, fmt.Errorf("retrieving CleanRooms Configured Table Associations for Membership (%s): %w", membershipID, err))
+					break
+				}
+
+				for _, association := range page.ConfiguredTableAssociationSummaries {
+					r := ResourceConfiguredTableAssociation()
+					d := r.Data(nil)
+					d.SetId(ConfiguredTableAssociationCreateResourceID(membershipID, aws.ToString(association.Id)))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+			}
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping CleanRooms Configured Table Associations for %s: %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
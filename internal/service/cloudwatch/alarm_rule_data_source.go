@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_cloudwatch_alarm_rule")
+func dataSourceAlarmRule() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAlarmRuleRead,
+
+		Schema: map[string]*schema.Schema{
+			"alarm": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: []string{"alarm", "rules"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"negate": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"state": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      cloudwatch.StateValueAlarm,
+							ValidateFunc: validation.StringInSlice(cloudwatch.StateValue_Values(), false),
+						},
+					},
+				},
+			},
+			"operator": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      alarmRuleOperatorOr,
+				ValidateFunc: validation.StringInSlice(alarmRuleOperator_Values(), false),
+			},
+			"rule": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rules": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: []string{"alarm", "rules"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validAlarmRule,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlarmRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var terms []string
+
+	for _, tfMapRaw := range d.Get("alarm").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		term := fmt.Sprintf("%s(%s)", tfMap["state"].(string), quoteAlarmName(tfMap["arn"].(string)))
+
+		if tfMap["negate"].(bool) {
+			term = "NOT " + term
+		}
+
+		terms = append(terms, term)
+	}
+
+	for _, v := range d.Get("rules").([]interface{}) {
+		v, ok := v.(string)
+
+		if !ok || v == "" {
+			continue
+		}
+
+		terms = append(terms, "("+v+")")
+	}
+
+	rule := strings.Join(terms, " "+d.Get("operator").(string)+" ")
+
+	if len(rule) > 10240 {
+		return diag.Errorf("alarm rule is %d characters long, the maximum is 10240", len(rule))
+	}
+
+	if err := parseAlarmRule(rule); err != nil {
+		return diag.Errorf("building alarm rule: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(rule)))
+	d.Set("rule", rule)
+
+	return nil
+}
+
+// quoteAlarmName returns the alarm name or ARN in a form usable in an alarm rule,
+// enclosing it in double quotes if it contains characters with special meaning.
+func quoteAlarmName(name string) string {
+	if !strings.ContainsAny(name, " \t\r\n()\"\\") {
+		return name
+	}
+
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(name) + `"`
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudWatchAlarmRuleDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_alarm_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmRuleDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "operator", "OR"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule", `ALARM(arn:aws:cloudwatch:us-west-2:123456789012:alarm:alpha) OR NOT OK("arn:aws:cloudwatch:us-west-2:123456789012:alarm:bravo (\"prod\")")`), //lintignore:AWSAT003,AWSAT005
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchAlarmRuleDataSource_nested(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_alarm_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmRuleDataSourceConfig_nested,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "operator", "AND"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule", "INSUFFICIENT_DATA(arn:aws:cloudwatch:us-west-2:123456789012:alarm:charlie) AND (ALARM(arn:aws:cloudwatch:us-west-2:123456789012:alarm:alpha) OR ALARM(arn:aws:cloudwatch:us-west-2:123456789012:alarm:bravo))"), //lintignore:AWSAT003,AWSAT005
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchAlarmRuleDataSource_compositeAlarm(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_alarm_rule.test"
+	resourceName := "aws_cloudwatch_composite_alarm.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCompositeAlarmDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmRuleDataSourceConfig_compositeAlarm(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCompositeAlarmExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "alarm_rule", dataSourceName, "rule"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchAlarmRuleDataSource_invalidRule(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAlarmRuleDataSourceConfig_invalidRule,
+				ExpectError: regexp.MustCompile(`is not a valid alarm rule`),
+			},
+		},
+	})
+}
+
+// lintignore:AWSAT003,AWSAT005
+const testAccAlarmRuleDataSourceConfig_basic = `
+data "aws_cloudwatch_alarm_rule" "test" {
+  alarm {
+    arn = "arn:aws:cloudwatch:us-west-2:123456789012:alarm:alpha"
+  }
+
+  alarm {
+    arn    = "arn:aws:cloudwatch:us-west-2:123456789012:alarm:bravo (\"prod\")"
+    negate = true
+    state  = "OK"
+  }
+}
+`
+
+// lintignore:AWSAT003,AWSAT005
+const testAccAlarmRuleDataSourceConfig_nested = `
+data "aws_cloudwatch_alarm_rule" "any" {
+  alarm {
+    arn = "arn:aws:cloudwatch:us-west-2:123456789012:alarm:alpha"
+  }
+
+  alarm {
+    arn = "arn:aws:cloudwatch:us-west-2:123456789012:alarm:bravo"
+  }
+}
+
+data "aws_cloudwatch_alarm_rule" "test" {
+  operator = "AND"
+
+  alarm {
+    arn   = "arn:aws:cloudwatch:us-west-2:123456789012:alarm:charlie"
+    state = "INSUFFICIENT_DATA"
+  }
+
+  rules = [data.aws_cloudwatch_alarm_rule.any.rule]
+}
+`
+
+const testAccAlarmRuleDataSourceConfig_invalidRule = `
+data "aws_cloudwatch_alarm_rule" "test" {
+  rules = ["ALARM(alpha) OR"]
+}
+`
+
+func testAccAlarmRuleDataSourceConfig_compositeAlarm(rName string) string {
+	return acctest.ConfigCompose(testAccCompositeAlarmConfig_base(rName), fmt.Sprintf(`
+data "aws_cloudwatch_alarm_rule" "test" {
+  dynamic "alarm" {
+    for_each = aws_cloudwatch_metric_alarm.test[*].arn
+
+    content {
+      arn = alarm.value
+    }
+  }
+}
+
+resource "aws_cloudwatch_composite_alarm" "test" {
+  alarm_name = %[1]q
+  alarm_rule = data.aws_cloudwatch_alarm_rule.test.rule
+}
+`, rName))
+}
@@ -34,3 +34,15 @@ func missingData_Values() []string {
 		missingDataNotBreaching,
 	}
 }
+
+const (
+	alarmRuleOperatorAnd = "AND"
+	alarmRuleOperatorOr  = "OR"
+)
+
+func alarmRuleOperator_Values() []string {
+	return []string{
+		alarmRuleOperatorAnd,
+		alarmRuleOperatorOr,
+	}
+}
@@ -220,7 +220,13 @@ func resourceMetricStreamCreate(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	if v, ok := d.GetOk("statistics_configuration"); ok && v.(*schema.Set).Len() > 0 {
-		input.StatisticsConfigurations = expandMetricStreamStatisticsConfigurations(v.(*schema.Set))
+		configurations, err := expandMetricStreamStatisticsConfigurations(v.(*schema.Set))
+
+		if err != nil {
+			return diag.Errorf("expanding statistics_configuration: %s", err)
+		}
+
+		input.StatisticsConfigurations = configurations
 	}
 
 	output, err := conn.PutMetricStreamWithContext(ctx, input)
@@ -278,29 +284,31 @@ func resourceMetricStreamRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("creation_date", output.CreationDate.Format(time.RFC3339))
 	d.Set("firehose_arn", output.FirehoseArn)
 	d.Set("include_linked_accounts_metrics", output.IncludeLinkedAccountsMetrics)
-	d.Set("last_update_date", output.CreationDate.Format(time.RFC3339))
+	if output.LastUpdateDate != nil {
+		d.Set("last_update_date", aws.TimeValue(output.LastUpdateDate).Format(time.RFC3339))
+	} else {
+		d.Set("last_update_date", nil)
+	}
 	d.Set("name", output.Name)
 	d.Set("name_prefix", create.NamePrefixFromName(aws.StringValue(output.Name)))
 	d.Set("output_format", output.OutputFormat)
 	d.Set("role_arn", output.RoleArn)
 	d.Set("state", output.State)
 
-	if output.IncludeFilters != nil {
-		if err := d.Set("include_filter", flattenMetricStreamFilters(output.IncludeFilters)); err != nil {
-			return diag.Errorf("setting include_filter: %s", err)
-		}
+	if err := d.Set("exclude_filter", flattenMetricStreamFilters(output.ExcludeFilters)); err != nil {
+		return diag.Errorf("setting exclude_filter: %s", err)
+	}
+	if err := d.Set("include_filter", flattenMetricStreamFilters(output.IncludeFilters)); err != nil {
+		return diag.Errorf("setting include_filter: %s", err)
 	}
+	statisticsConfigurations, err := flattenMetricStreamStatisticsConfigurations(output.StatisticsConfigurations)
 
-	if output.ExcludeFilters != nil {
-		if err := d.Set("exclude_filter", flattenMetricStreamFilters(output.ExcludeFilters)); err != nil {
-			return diag.Errorf("setting exclude_filter: %s", err)
-		}
+	if err != nil {
+		return diag.Errorf("flattening statistics_configuration: %s", err)
 	}
 
-	if output.StatisticsConfigurations != nil {
-		if err := d.Set("statistics_configuration", flattenMetricStreamStatisticsConfigurations(output.StatisticsConfigurations)); err != nil {
-			return diag.Errorf("setting statistics_configuration: %s", err)
-		}
+	if err := d.Set("statistics_configuration", statisticsConfigurations); err != nil {
+		return diag.Errorf("setting statistics_configuration: %s", err)
 	}
 
 	return nil
@@ -327,7 +335,13 @@ func resourceMetricStreamUpdate(ctx context.Context, d *schema.ResourceData, met
 		}
 
 		if v, ok := d.GetOk("statistics_configuration"); ok && v.(*schema.Set).Len() > 0 {
-			input.StatisticsConfigurations = expandMetricStreamStatisticsConfigurations(v.(*schema.Set))
+			configurations, err := expandMetricStreamStatisticsConfigurations(v.(*schema.Set))
+
+			if err != nil {
+				return diag.Errorf("expanding statistics_configuration: %s", err)
+			}
+
+			input.StatisticsConfigurations = configurations
 		}
 
 		_, err := conn.PutMetricStreamWithContext(ctx, input)
@@ -488,82 +502,46 @@ func flattenMetricStreamFilters(s []*cloudwatch.MetricStreamFilter) []map[string
 	return nil
 }
 
-func expandMetricStreamStatisticsConfigurations(s *schema.Set) []*cloudwatch.MetricStreamStatisticsConfiguration {
+type metricStreamStatisticsConfigurationModel struct {
+	AdditionalStatistics []string                            `tfsdk:"additional_statistics,omitempty"`
+	IncludeMetrics       []metricStreamStatisticsMetricModel `tfsdk:"include_metric,omitempty"`
+}
+
+type metricStreamStatisticsMetricModel struct {
+	MetricName string `tfsdk:"metric_name,omitempty"`
+	Namespace  string `tfsdk:"namespace,omitempty"`
+}
+
+func expandMetricStreamStatisticsConfigurations(s *schema.Set) ([]*cloudwatch.MetricStreamStatisticsConfiguration, error) {
 	var configurations []*cloudwatch.MetricStreamStatisticsConfiguration
 
 	for _, configurationRaw := range s.List() {
 		configuration := &cloudwatch.MetricStreamStatisticsConfiguration{}
-		mConfiguration := configurationRaw.(map[string]interface{})
-
-		if v, ok := mConfiguration["additional_statistics"].(*schema.Set); ok && v.Len() > 0 {
-			configuration.AdditionalStatistics = flex.ExpandStringSet(v)
-		}
 
-		if v, ok := mConfiguration["include_metric"].(*schema.Set); ok && v.Len() > 0 {
-			configuration.IncludeMetrics = expandMetricStreamStatisticsConfigurationsIncludeMetrics(v)
+		if err := flex.ExpandTFMap[metricStreamStatisticsConfigurationModel](configurationRaw.(map[string]interface{}), configuration); err != nil {
+			return nil, err
 		}
 
 		configurations = append(configurations, configuration)
 	}
 
-	if len(configurations) > 0 {
-		return configurations
-	}
-
-	return nil
+	return configurations, nil
 }
 
-func expandMetricStreamStatisticsConfigurationsIncludeMetrics(metrics *schema.Set) []*cloudwatch.MetricStreamStatisticsMetric {
-	var includeMetrics []*cloudwatch.MetricStreamStatisticsMetric
-
-	for _, metricRaw := range metrics.List() {
-		metric := &cloudwatch.MetricStreamStatisticsMetric{}
-		mMetric := metricRaw.(map[string]interface{})
-
-		if v, ok := mMetric["metric_name"].(string); ok && v != "" {
-			metric.MetricName = aws.String(v)
-		}
-
-		if v, ok := mMetric["namespace"].(string); ok && v != "" {
-			metric.Namespace = aws.String(v)
-		}
-
-		includeMetrics = append(includeMetrics, metric)
-	}
-
-	if len(includeMetrics) > 0 {
-		return includeMetrics
-	}
-
-	return nil
-}
+func flattenMetricStreamStatisticsConfigurations(configurations []*cloudwatch.MetricStreamStatisticsConfiguration) ([]interface{}, error) {
+	flatConfigurations := make([]interface{}, 0, len(configurations))
 
-func flattenMetricStreamStatisticsConfigurations(configurations []*cloudwatch.MetricStreamStatisticsConfiguration) []map[string]interface{} {
-	flatConfigurations := make([]map[string]interface{}, len(configurations))
+	for _, configuration := range configurations {
+		flatConfiguration, err := flex.FlattenTFMap[metricStreamStatisticsConfigurationModel](configuration)
 
-	for i, configuration := range configurations {
-		flatConfiguration := map[string]interface{}{
-			"additional_statistics": flex.FlattenStringSet(configuration.AdditionalStatistics),
-			"include_metric":        flattenMetricStreamStatisticsConfigurationsIncludeMetrics(configuration.IncludeMetrics),
+		if err != nil {
+			return nil, err
 		}
 
-		flatConfigurations[i] = flatConfiguration
-	}
-
-	return flatConfigurations
-}
-
-func flattenMetricStreamStatisticsConfigurationsIncludeMetrics(metrics []*cloudwatch.MetricStreamStatisticsMetric) []map[string]interface{} {
-	flatMetrics := make([]map[string]interface{}, len(metrics))
-
-	for i, metric := range metrics {
-		flatMetric := map[string]interface{}{
-			"metric_name": aws.StringValue(metric.MetricName),
-			"namespace":   aws.StringValue(metric.Namespace),
+		if flatConfiguration != nil {
+			flatConfigurations = append(flatConfigurations, flatConfiguration)
 		}
-
-		flatMetrics[i] = flatMetric
 	}
 
-	return flatMetrics
+	return flatConfigurations, nil
 }
@@ -210,6 +210,52 @@ func TestAccCloudWatchMetricStream_includeFiltersWithMetricNames(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchMetricStream_updateFilters(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cloudwatch_metric_stream.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var creationDate string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricStreamDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricStreamConfig_includeFilters(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricStreamExists(ctx, resourceName),
+					func(s *terraform.State) error {
+						creationDate = s.RootModule().Resources[resourceName].Primary.Attributes["creation_date"]
+						return nil
+					},
+					resource.TestCheckResourceAttr(resourceName, "include_filter.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "exclude_filter.#", "0"),
+				),
+			},
+			{
+				Config: testAccMetricStreamConfig_includeFiltersWithMetricNames(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricStreamExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPtr(resourceName, "creation_date", &creationDate),
+					resource.TestCheckResourceAttr(resourceName, "include_filter.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "exclude_filter.#", "0"),
+				),
+			},
+			{
+				Config: testAccMetricStreamConfig_excludeFiltersWithMetricNames(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricStreamExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPtr(resourceName, "creation_date", &creationDate),
+					resource.TestCheckResourceAttr(resourceName, "include_filter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "exclude_filter.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudWatchMetricStream_excludeFilters(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_cloudwatch_metric_stream.test"
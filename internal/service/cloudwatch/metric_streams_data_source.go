@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_cloudwatch_metric_streams")
+func dataSourceMetricStreams() *schema.Resource {
+	metricStreamFilterSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metric_names": {
+						Type:     schema.TypeSet,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"namespace": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceMetricStreamsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"metric_streams": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"exclude_filter": metricStreamFilterSchema(),
+						"firehose_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"include_filter": metricStreamFilterSchema(),
+						"include_linked_accounts_metrics": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"last_update_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"output_format": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"statistics_configuration": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"additional_statistics": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"include_metric": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"metric_name": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"namespace": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceMetricStreamsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudWatchConn(ctx)
+
+	input := &cloudwatch.ListMetricStreamsInput{}
+	var entries []*cloudwatch.MetricStreamEntry
+
+	err := conn.ListMetricStreamsPagesWithContext(ctx, input, func(page *cloudwatch.ListMetricStreamsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Entries {
+			if v != nil {
+				entries = append(entries, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return diag.Errorf("listing CloudWatch Metric Streams: %s", err)
+	}
+
+	namePrefix := d.Get("name_prefix").(string)
+	var arns, names []string
+	var metricStreams []interface{}
+
+	for _, entry := range entries {
+		name := aws.StringValue(entry.Name)
+
+		if namePrefix != "" && !strings.HasPrefix(name, namePrefix) {
+			continue
+		}
+
+		// The list operation doesn't return filters or statistics configurations.
+		output, err := FindMetricStreamByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return diag.Errorf("reading CloudWatch Metric Stream (%s): %s", name, err)
+		}
+
+		metricStream, err := flattenMetricStream(output)
+
+		if err != nil {
+			return diag.Errorf("flattening CloudWatch Metric Stream (%s): %s", name, err)
+		}
+
+		arns = append(arns, aws.StringValue(output.Arn))
+		names = append(names, name)
+		metricStreams = append(metricStreams, metricStream)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("arns", arns)
+	if err := d.Set("metric_streams", metricStreams); err != nil {
+		return diag.Errorf("setting metric_streams: %s", err)
+	}
+	d.Set("names", names)
+
+	return nil
+}
+
+func flattenMetricStream(apiObject *cloudwatch.GetMetricStreamOutput) (map[string]interface{}, error) {
+	statisticsConfigurations, err := flattenMetricStreamStatisticsConfigurations(apiObject.StatisticsConfigurations)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tfMap := map[string]interface{}{
+		"arn":                             aws.StringValue(apiObject.Arn),
+		"exclude_filter":                  flattenMetricStreamFilters(apiObject.ExcludeFilters),
+		"firehose_arn":                    aws.StringValue(apiObject.FirehoseArn),
+		"include_filter":                  flattenMetricStreamFilters(apiObject.IncludeFilters),
+		"include_linked_accounts_metrics": aws.BoolValue(apiObject.IncludeLinkedAccountsMetrics),
+		"name":                            aws.StringValue(apiObject.Name),
+		"output_format":                   aws.StringValue(apiObject.OutputFormat),
+		"role_arn":                        aws.StringValue(apiObject.RoleArn),
+		"state":                           aws.StringValue(apiObject.State),
+		"statistics_configuration":        statisticsConfigurations,
+	}
+
+	if v := apiObject.CreationDate; v != nil {
+		tfMap["creation_date"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.LastUpdateDate; v != nil {
+		tfMap["last_update_date"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap, nil
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudWatchMetricStreamsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_metric_streams.test"
+	resourceName := "aws_cloudwatch_metric_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricStreamsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "names.0", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_streams.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "metric_streams.0.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_streams.0.exclude_filter.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_streams.0.include_filter.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "metric_streams.0.output_format", resourceName, "output_format"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMetricStreamsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccMetricStreamConfig_includeFiltersWithMetricNames(rName), `
+data "aws_cloudwatch_metric_streams" "test" {
+  name_prefix = aws_cloudwatch_metric_stream.test.name
+}
+`)
+}
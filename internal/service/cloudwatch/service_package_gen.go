@@ -24,7 +24,16 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
-	return []*types.ServicePackageSDKDataSource{}
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceAlarmRule,
+			TypeName: "aws_cloudwatch_alarm_rule",
+		},
+		{
+			Factory:  dataSourceMetricStreams,
+			TypeName: "aws_cloudwatch_metric_streams",
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
@@ -6,8 +6,23 @@ package cloudwatch
 import (
 	"fmt"
 	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
 )
 
+func validAlarmRule(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	// https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/API_PutCompositeAlarm.html
+	if err := parseAlarmRule(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid alarm rule: %w", k, err))
+	}
+
+	return
+}
+
 func validDashboardName(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if len(value) > 255 {
@@ -39,3 +54,161 @@ func validEC2AutomateARN(v interface{}, k string) (ws []string, errors []error)
 
 	return
 }
+
+// parseAlarmRule checks the syntax of a composite alarm rule expression.
+// Referenced alarms are not checked for existence.
+func parseAlarmRule(rule string) error {
+	p := &alarmRuleParser{input: rule}
+
+	if err := p.expression(); err != nil {
+		return err
+	}
+
+	if p.skipSpace(); p.pos < len(p.input) {
+		return p.errorf("unexpected %q", p.input[p.pos:])
+	}
+
+	return nil
+}
+
+type alarmRuleParser struct {
+	input string
+	pos   int
+}
+
+// expression parses terms joined by the AND and OR operators.
+func (p *alarmRuleParser) expression() error {
+	for {
+		if err := p.term(); err != nil {
+			return err
+		}
+
+		if !p.keyword(alarmRuleOperatorAnd) && !p.keyword(alarmRuleOperatorOr) {
+			return nil
+		}
+	}
+}
+
+func (p *alarmRuleParser) term() error {
+	switch {
+	case p.keyword("NOT"):
+		return p.term()
+	case p.keyword("TRUE"), p.keyword("FALSE"):
+		return nil
+	case p.consume('('):
+		if err := p.expression(); err != nil {
+			return err
+		}
+
+		if !p.consume(')') {
+			return p.errorf("expected )")
+		}
+
+		return nil
+	}
+
+	for _, state := range cloudwatch.StateValue_Values() {
+		if !p.keyword(state) {
+			continue
+		}
+
+		if !p.consume('(') {
+			return p.errorf("expected ( after %s", state)
+		}
+
+		if err := p.alarmName(); err != nil {
+			return err
+		}
+
+		if !p.consume(')') {
+			return p.errorf("expected ) after alarm name")
+		}
+
+		return nil
+	}
+
+	if p.skipSpace(); p.pos == len(p.input) {
+		return p.errorf("unexpected end of rule")
+	}
+
+	return p.errorf("expected ALARM, OK, INSUFFICIENT_DATA, TRUE, FALSE, NOT or (")
+}
+
+// alarmName parses an alarm name or ARN.
+// Names containing spaces or parentheses must be enclosed in double quotes,
+// within which double quotes and backslashes are escaped with a backslash.
+func (p *alarmRuleParser) alarmName() error {
+	if p.skipSpace(); p.consume('"') {
+		start := p.pos
+
+		for ; p.pos < len(p.input); p.pos++ {
+			switch p.input[p.pos] {
+			case '\\':
+				p.pos++
+			case '"':
+				if p.pos == start {
+					return p.errorf("empty alarm name")
+				}
+
+				p.pos++
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("unterminated quoted alarm name starting at position %d", start)
+	}
+
+	start := p.pos
+
+	for p.pos < len(p.input) && !strings.ContainsRune(`()"`, rune(p.input[p.pos])) {
+		p.pos++
+	}
+
+	if strings.TrimSpace(p.input[start:p.pos]) == "" {
+		return p.errorf("expected alarm name")
+	}
+
+	return nil
+}
+
+// keyword consumes the specified case-insensitive keyword if it is next in the input.
+func (p *alarmRuleParser) keyword(word string) bool {
+	p.skipSpace()
+
+	end := p.pos + len(word)
+
+	if end > len(p.input) || !strings.EqualFold(p.input[p.pos:end], word) {
+		return false
+	}
+
+	if end < len(p.input) {
+		if r := rune(p.input[end]); r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return false
+		}
+	}
+
+	p.pos = end
+
+	return true
+}
+
+func (p *alarmRuleParser) consume(c byte) bool {
+	if p.skipSpace(); p.pos < len(p.input) && p.input[p.pos] == c {
+		p.pos++
+
+		return true
+	}
+
+	return false
+}
+
+func (p *alarmRuleParser) skipSpace() {
+	for p.pos < len(p.input) && unicode.IsSpace(rune(p.input[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *alarmRuleParser) errorf(format string, a ...any) error {
+	return fmt.Errorf("%s at position %d", fmt.Sprintf(format, a...), p.pos+1)
+}
@@ -8,6 +8,50 @@ import (
 	"testing"
 )
 
+func TestValidAlarmRule(t *testing.T) {
+	t.Parallel()
+
+	validRules := []string{
+		"ALARM(CPUUtilizationTooHigh)",
+		"ALARM(tf-acc-test-0) OR ALARM(tf-acc-test-1)",
+		"ALARM(arn:aws:cloudwatch:us-east-1:123456789012:alarm:Test) AND NOT OK(Other)", //lintignore:AWSAT003,AWSAT005
+		`ALARM("Alarm With Spaces") AND INSUFFICIENT_DATA("quoted \"name\" (1)")`,
+		"(ALARM(A) OR ALARM(B)) AND NOT (ALARM(C) OR OK(D))",
+		"TRUE",
+		"NOT FALSE",
+		"ALARM(A)\nOR\nALARM(B)\n",
+		"alarm(A) and ok(B)",
+	}
+	for _, v := range validRules {
+		_, errors := validAlarmRule(v, "alarm_rule")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid alarm rule: %q", v, errors)
+		}
+	}
+
+	invalidRules := []string{
+		"",
+		"ALARM",
+		"ALARM()",
+		"ALARM(A",
+		"ALARM(A) OR",
+		"ALARM(A) ALARM(B)",
+		"(ALARM(A) OR ALARM(B)",
+		"ALARM(A))",
+		`ALARM("unterminated)`,
+		`ALARM("")`,
+		"ALARMED(A)",
+		"METRIC(A)",
+		"NOT",
+	}
+	for _, v := range invalidRules {
+		_, errors := validAlarmRule(v, "alarm_rule")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid alarm rule", v)
+		}
+	}
+}
+
 func TestValidDashboardName(t *testing.T) {
 	t.Parallel()
 
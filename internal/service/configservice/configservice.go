@@ -223,11 +223,9 @@ func getOrganizationConfigRuleDetailedStatus(ctx context.Context, conn *configse
 	return statuses, nil
 }
 
-func getOrganizationConformancePackDetailedStatus(ctx context.Context, conn *configservice.ConfigService, name, status string) ([]*configservice.OrganizationConformancePackDetailedStatus, error) {
+func getOrganizationConformancePackDetailedStatus(ctx context.Context, conn *configservice.ConfigService, name string, filters *configservice.OrganizationResourceDetailedStatusFilters) ([]*configservice.OrganizationConformancePackDetailedStatus, error) {
 	input := &configservice.GetOrganizationConformancePackDetailedStatusInput{
-		Filters: &configservice.OrganizationResourceDetailedStatusFilters{
-			Status: aws.String(status),
-		},
+		Filters:                         filters,
 		OrganizationConformancePackName: aws.String(name),
 	}
 
@@ -373,7 +371,9 @@ func refreshOrganizationConformancePackStatus(ctx context.Context, conn *configs
 }
 
 func organizationConformancePackDetailedStatusError(ctx context.Context, conn *configservice.ConfigService, name, status string) error {
-	memberAccountStatuses, err := getOrganizationConformancePackDetailedStatus(ctx, conn, name, status)
+	memberAccountStatuses, err := getOrganizationConformancePackDetailedStatus(ctx, conn, name, &configservice.OrganizationResourceDetailedStatusFilters{
+		Status: aws.String(status),
+	})
 
 	if err != nil {
 		return fmt.Errorf("unable to get Config Organization Conformance Pack detailed status for showing member account errors: %w", err)
@@ -443,6 +443,8 @@ func waitForOrganizationConformancePackStatusUpdateSuccessful(ctx context.Contex
 		Target:  []string{configservice.OrganizationResourceStatusUpdateSuccessful},
 		Timeout: timeout,
 		Refresh: refreshOrganizationConformancePackStatus(ctx, conn, name),
+		// Include a delay so that the previous deployment's status isn't reported
+		Delay: 30 * time.Second,
 	}
 
 	_, err := stateChangeConf.WaitForStateContext(ctx)
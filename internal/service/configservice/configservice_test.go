@@ -66,6 +66,9 @@ func TestAccConfigService_serial(t *testing.T) {
 			"updateS3Template":      testAccOrganizationConformancePack_updateS3Template,
 			"updateTemplateBody":    testAccOrganizationConformancePack_updateTemplateBody,
 		},
+		"OrganizationConformancePackStatusDataSource": {
+			"basic": testAccOrganizationConformancePackStatusDataSource_basic,
+		},
 		"OrganizationCustomRule": {
 			"basic":                     testAccOrganizationCustomRule_basic,
 			"disappears":                testAccOrganizationCustomRule_disappears,
@@ -211,42 +211,46 @@ func resourceOrganizationConformancePackUpdate(ctx context.Context, d *schema.Re
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ConfigServiceConn(ctx)
 
-	input := &configservice.PutOrganizationConformancePackInput{
-		OrganizationConformancePackName: aws.String(d.Id()),
-	}
+	// PutOrganizationConformancePack redeploys the pack to every member account,
+	// so only call it when an argument sent to the API has changed.
+	if d.HasChanges("delivery_s3_bucket", "delivery_s3_key_prefix", "excluded_accounts", "input_parameter", "template_body", "template_s3_uri") {
+		input := &configservice.PutOrganizationConformancePackInput{
+			OrganizationConformancePackName: aws.String(d.Id()),
+		}
 
-	if v, ok := d.GetOk("delivery_s3_bucket"); ok {
-		input.DeliveryS3Bucket = aws.String(v.(string))
-	}
+		if v, ok := d.GetOk("delivery_s3_bucket"); ok {
+			input.DeliveryS3Bucket = aws.String(v.(string))
+		}
 
-	if v, ok := d.GetOk("delivery_s3_key_prefix"); ok {
-		input.DeliveryS3KeyPrefix = aws.String(v.(string))
-	}
+		if v, ok := d.GetOk("delivery_s3_key_prefix"); ok {
+			input.DeliveryS3KeyPrefix = aws.String(v.(string))
+		}
 
-	if v, ok := d.GetOk("excluded_accounts"); ok {
-		input.ExcludedAccounts = flex.ExpandStringSet(v.(*schema.Set))
-	}
+		if v, ok := d.GetOk("excluded_accounts"); ok {
+			input.ExcludedAccounts = flex.ExpandStringSet(v.(*schema.Set))
+		}
 
-	if v, ok := d.GetOk("input_parameter"); ok {
-		input.ConformancePackInputParameters = expandConfigConformancePackInputParameters(v.(*schema.Set).List())
-	}
+		if v, ok := d.GetOk("input_parameter"); ok {
+			input.ConformancePackInputParameters = expandConfigConformancePackInputParameters(v.(*schema.Set).List())
+		}
 
-	if v, ok := d.GetOk("template_body"); ok {
-		input.TemplateBody = aws.String(v.(string))
-	}
+		if v, ok := d.GetOk("template_body"); ok {
+			input.TemplateBody = aws.String(v.(string))
+		}
 
-	if v, ok := d.GetOk("template_s3_uri"); ok {
-		input.TemplateS3Uri = aws.String(v.(string))
-	}
+		if v, ok := d.GetOk("template_s3_uri"); ok {
+			input.TemplateS3Uri = aws.String(v.(string))
+		}
 
-	_, err := conn.PutOrganizationConformancePackWithContext(ctx, input)
+		_, err := conn.PutOrganizationConformancePackWithContext(ctx, input)
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating Config Organization Conformance Pack (%s): %s", d.Id(), err)
-	}
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Config Organization Conformance Pack (%s): %s", d.Id(), err)
+		}
 
-	if err := waitForOrganizationConformancePackStatusUpdateSuccessful(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for Config Organization Conformance Pack (%s) to be updated: %s", d.Id(), err)
+		if err := waitForOrganizationConformancePackStatusUpdateSuccessful(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Config Organization Conformance Pack (%s) to be updated: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceOrganizationConformancePackRead(ctx, d, meta)...)
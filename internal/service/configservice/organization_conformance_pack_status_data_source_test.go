@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configservice_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/configservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccOrganizationConformancePackStatusDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_config_organization_conformance_pack_status.test"
+	resourceName := "aws_config_organization_conformance_pack.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOrganizationsAccount(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationConformancePackDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConformancePackStatusDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", configservice.OrganizationResourceStatusCreateSuccessful),
+					resource.TestCheckResourceAttrSet(dataSourceName, "last_update_time"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "member_account_status.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationConformancePackStatusDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccOrganizationConformancePackConfig_basic(rName),
+		`
+data "aws_config_organization_conformance_pack_status" "test" {
+  name = aws_config_organization_conformance_pack.test.name
+}
+`)
+}
@@ -7,8 +7,10 @@
 package connect
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/connect"
@@ -17,10 +19,84 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
+// Acceptance tests name Connect instance child resources with this prefix.
+// Every instance is created with a set of default resources (e.g. "Basic Hours",
+// "Admin" security profile) that cannot be deleted, so child resource sweepers
+// only remove resources created by the acceptance tests.
+const sweepResourcePrefix = "resource-test-terraform"
+
 func init() {
+	resource.AddTestSweepers("aws_connect_contact_flow", &resource.Sweeper{
+		Name: "aws_connect_contact_flow",
+		F:    sweepContactFlows,
+		Dependencies: []string{
+			"aws_connect_quick_connect",
+		},
+	})
+
+	resource.AddTestSweepers("aws_connect_contact_flow_module", &resource.Sweeper{
+		Name: "aws_connect_contact_flow_module",
+		F:    sweepContactFlowModules,
+		Dependencies: []string{
+			"aws_connect_contact_flow",
+		},
+	})
+
+	resource.AddTestSweepers("aws_connect_hours_of_operation", &resource.Sweeper{
+		Name: "aws_connect_hours_of_operation",
+		F:    sweepHoursOfOperations,
+	})
+
 	resource.AddTestSweepers("aws_connect_instance", &resource.Sweeper{
 		Name: "aws_connect_instance",
 		F:    sweepInstance,
+		Dependencies: []string{
+			"aws_connect_contact_flow",
+			"aws_connect_contact_flow_module",
+			"aws_connect_hours_of_operation",
+			"aws_connect_phone_number",
+			"aws_connect_quick_connect",
+			"aws_connect_security_profile",
+			"aws_connect_user",
+			"aws_connect_user_hierarchy_group",
+			"aws_connect_vocabulary",
+		},
+	})
+
+	resource.AddTestSweepers("aws_connect_phone_number", &resource.Sweeper{
+		Name: "aws_connect_phone_number",
+		F:    sweepPhoneNumbers,
+	})
+
+	resource.AddTestSweepers("aws_connect_quick_connect", &resource.Sweeper{
+		Name: "aws_connect_quick_connect",
+		F:    sweepQuickConnects,
+	})
+
+	resource.AddTestSweepers("aws_connect_security_profile", &resource.Sweeper{
+		Name: "aws_connect_security_profile",
+		F:    sweepSecurityProfiles,
+		Dependencies: []string{
+			"aws_connect_user",
+		},
+	})
+
+	resource.AddTestSweepers("aws_connect_user", &resource.Sweeper{
+		Name: "aws_connect_user",
+		F:    sweepUsers,
+	})
+
+	resource.AddTestSweepers("aws_connect_user_hierarchy_group", &resource.Sweeper{
+		Name: "aws_connect_user_hierarchy_group",
+		F:    sweepUserHierarchyGroups,
+		Dependencies: []string{
+			"aws_connect_user",
+		},
+	})
+
+	resource.AddTestSweepers("aws_connect_vocabulary", &resource.Sweeper{
+		Name: "aws_connect_vocabulary",
+		F:    sweepVocabularies,
 	})
 }
 
@@ -77,3 +153,578 @@ func sweepInstance(region string) error {
 
 	return errs.ErrorOrNil()
 }
+
+// listInstances returns the summaries of all Connect instances in the current Region.
+func listInstances(ctx context.Context, conn *connect.Connect) ([]*connect.InstanceSummary, error) {
+	input := &connect.ListInstancesInput{MaxResults: aws.Int64(ListInstancesMaxResults)}
+	var output []*connect.InstanceSummary
+
+	err := conn.ListInstancesPagesWithContext(ctx, input, func(page *connect.ListInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.InstanceSummaryList {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func sweepContactFlows(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.ConnectConn(ctx)
+	var errs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	instances, err := listInstances(ctx, conn)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Connect Contact Flow sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Connect Instances (%s): %w", region, err)
+	}
+
+	for _, instance := range instances {
+		instanceID := aws.StringValue(instance.Id)
+		input := &connect.ListContactFlowsInput{
+			InstanceId: aws.String(instanceID),
+			MaxResults: aws.Int64(ListContactFlowsMaxResults),
+		}
+
+		err := conn.ListContactFlowsPagesWithContext(ctx, input, func(page *connect.ListContactFlowsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.ContactFlowSummaryList {
+				if name := aws.StringValue(v.Name); !strings.HasPrefix(name, sweepResourcePrefix) {
+					log.Printf("[INFO] Skipping Connect Contact Flow %s", name)
+					continue
+				}
+
+				r := ResourceContactFlow()
+				d := r.Data(nil)
+				d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(v.Id)))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing Connect Contact Flows (%s): %w", instanceID, err))
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Connect Contact Flows (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepContactFlowModules(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.ConnectConn(ctx)
+	var errs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	instances, err := listInstances(ctx, conn)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Connect Contact Flow Module sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Connect Instances (%s): %w", region, err)
+	}
+
+	for _, instance := range instances {
+		instanceID := aws.StringValue(instance.Id)
+		input := &connect.ListContactFlowModulesInput{
+			InstanceId: aws.String(instanceID),
+			MaxResults: aws.Int64(ListContactFlowModulesMaxResults),
+		}
+
+		err := conn.ListContactFlowModulesPagesWithContext(ctx, input, func(page *connect.ListContactFlowModulesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.ContactFlowModulesSummaryList {
+				if name := aws.StringValue(v.Name); !strings.HasPrefix(name, sweepResourcePrefix) {
+					log.Printf("[INFO] Skipping Connect Contact Flow Module %s", name)
+					continue
+				}
+
+				r := ResourceContactFlowModule()
+				d := r.Data(nil)
+				d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(v.Id)))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing Connect Contact Flow Modules (%s): %w", instanceID, err))
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Connect Contact Flow Modules (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepHoursOfOperations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.ConnectConn(ctx)
+	var errs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	instances, err := listInstances(ctx, conn)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Connect Hours of Operation sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Connect Instances (%s): %w", region, err)
+	}
+
+	for _, instance := range instances {
+		instanceID := aws.StringValue(instance.Id)
+		input := &connect.ListHoursOfOperationsInput{
+			InstanceId: aws.String(instanceID),
+			MaxResults: aws.Int64(ListHoursOfOperationsMaxResults),
+		}
+
+		err := conn.ListHoursOfOperationsPagesWithContext(ctx, input, func(page *connect.ListHoursOfOperationsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.HoursOfOperationSummaryList {
+				if name := aws.StringValue(v.Name); !strings.HasPrefix(name, sweepResourcePrefix) {
+					log.Printf("[INFO] Skipping Connect Hours of Operation %s", name)
+					continue
+				}
+
+				r := ResourceHoursOfOperation()
+				d := r.Data(nil)
+				d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(v.Id)))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing Connect Hours of Operations (%s): %w", instanceID, err))
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Connect Hours of Operations (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepPhoneNumbers(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.ConnectConn(ctx)
+	var errs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	instances, err := listInstances(ctx, conn)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Connect Phone Number sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Connect Instances (%s): %w", region, err)
+	}
+
+	for _, instance := range instances {
+		// Phone numbers have no name, so only release those claimed by acceptance test instances.
+		if alias := aws.StringValue(instance.InstanceAlias); !strings.HasPrefix(alias, sweep.ResourcePrefix) {
+			log.Printf("[INFO] Skipping Connect Phone Numbers for Instance %s", alias)
+			continue
+		}
+
+		instanceARN := aws.StringValue(instance.Arn)
+		input := &connect.ListPhoneNumbersV2Input{
+			TargetArn: aws.String(instanceARN),
+		}
+
+		err := conn.ListPhoneNumbersV2PagesWithContext(ctx, input, func(page *connect.ListPhoneNumbersV2Output, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.ListPhoneNumbersSummaryList {
+				r := ResourcePhoneNumber()
+				d := r.Data(nil)
+				d.SetId(aws.StringValue(v.PhoneNumberId))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing Connect Phone Numbers (%s): %w", instanceARN, err))
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Connect Phone Numbers (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepQuickConnects(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.ConnectConn(ctx)
+	var errs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	instances, err := listInstances(ctx, conn)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Connect Quick Connect sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Connect Instances (%s): %w", region, err)
+	}
+
+	for _, instance := range instances {
+		instanceID := aws.StringValue(instance.Id)
+		input := &connect.ListQuickConnectsInput{
+			InstanceId: aws.String(instanceID),
+			MaxResults: aws.Int64(ListQuickConnectsMaxResults),
+		}
+
+		err := conn.ListQuickConnectsPagesWithContext(ctx, input, func(page *connect.ListQuickConnectsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.QuickConnectSummaryList {
+				if name := aws.StringValue(v.Name); !strings.HasPrefix(name, sweepResourcePrefix) {
+					log.Printf("[INFO] Skipping Connect Quick Connect %s", name)
+					continue
+				}
+
+				r := ResourceQuickConnect()
+				d := r.Data(nil)
+				d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(v.Id)))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing Connect Quick Connects (%s): %w", instanceID, err))
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Connect Quick Connects (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepSecurityProfiles(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.ConnectConn(ctx)
+	var errs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	instances, err := listInstances(ctx, conn)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Connect Security Profile sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Connect Instances (%s): %w", region, err)
+	}
+
+	for _, instance := range instances {
+		instanceID := aws.StringValue(instance.Id)
+		input := &connect.ListSecurityProfilesInput{
+			InstanceId: aws.String(instanceID),
+			MaxResults: aws.Int64(ListSecurityProfilesMaxResults),
+		}
+
+		err := conn.ListSecurityProfilesPagesWithContext(ctx, input, func(page *connect.ListSecurityProfilesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.SecurityProfileSummaryList {
+				if name := aws.StringValue(v.Name); !strings.HasPrefix(name, sweepResourcePrefix) {
+					log.Printf("[INFO] Skipping Connect Security Profile %s", name)
+					continue
+				}
+
+				r := ResourceSecurityProfile()
+				d := r.Data(nil)
+				d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(v.Id)))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing Connect Security Profiles (%s): %w", instanceID, err))
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Connect Security Profiles (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepUsers(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.ConnectConn(ctx)
+	var errs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	instances, err := listInstances(ctx, conn)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Connect User sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Connect Instances (%s): %w", region, err)
+	}
+
+	for _, instance := range instances {
+		instanceID := aws.StringValue(instance.Id)
+		input := &connect.ListUsersInput{
+			InstanceId: aws.String(instanceID),
+			MaxResults: aws.Int64(ListUsersMaxResults),
+		}
+
+		err := conn.ListUsersPagesWithContext(ctx, input, func(page *connect.ListUsersOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.UserSummaryList {
+				if name := aws.StringValue(v.Username); !strings.HasPrefix(name, sweepResourcePrefix) {
+					log.Printf("[INFO] Skipping Connect User %s", name)
+					continue
+				}
+
+				r := ResourceUser()
+				d := r.Data(nil)
+				d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(v.Id)))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing Connect Users (%s): %w", instanceID, err))
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Connect Users (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepUserHierarchyGroups(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.ConnectConn(ctx)
+	var errs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	instances, err := listInstances(ctx, conn)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Connect User Hierarchy Group sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Connect Instances (%s): %w", region, err)
+	}
+
+	for _, instance := range instances {
+		instanceID := aws.StringValue(instance.Id)
+		input := &connect.ListUserHierarchyGroupsInput{
+			InstanceId: aws.String(instanceID),
+			MaxResults: aws.Int64(ListUserHierarchyGroupsMaxResults),
+		}
+
+		err := conn.ListUserHierarchyGroupsPagesWithContext(ctx, input, func(page *connect.ListUserHierarchyGroupsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.UserHierarchyGroupSummaryList {
+				if name := aws.StringValue(v.Name); !strings.HasPrefix(name, sweepResourcePrefix) {
+					log.Printf("[INFO] Skipping Connect User Hierarchy Group %s", name)
+					continue
+				}
+
+				r := ResourceUserHierarchyGroup()
+				d := r.Data(nil)
+				d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(v.Id)))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing Connect User Hierarchy Groups (%s): %w", instanceID, err))
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Connect User Hierarchy Groups (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepVocabularies(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.ConnectConn(ctx)
+	var errs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	instances, err := listInstances(ctx, conn)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Connect Vocabulary sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Connect Instances (%s): %w", region, err)
+	}
+
+	for _, instance := range instances {
+		instanceID := aws.StringValue(instance.Id)
+		input := &connect.SearchVocabulariesInput{
+			InstanceId: aws.String(instanceID),
+			MaxResults: aws.Int64(SearchVocabulariesMaxResults),
+		}
+
+		err := conn.SearchVocabulariesPagesWithContext(ctx, input, func(page *connect.SearchVocabulariesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.VocabularySummaryList {
+				if name := aws.StringValue(v.Name); !strings.HasPrefix(name, sweepResourcePrefix) {
+					log.Printf("[INFO] Skipping Connect Vocabulary %s", name)
+					continue
+				}
+
+				r := ResourceVocabulary()
+				d := r.Data(nil)
+				d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(v.Id)))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing Connect Vocabularies (%s): %w", instanceID, err))
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Connect Vocabularies (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
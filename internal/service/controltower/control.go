@@ -66,7 +66,14 @@ func resourceControlCreate(ctx context.Context, d *schema.ResourceData, meta int
 		TargetIdentifier:  aws.String(targetIdentifier),
 	}
 
-	output, err := conn.EnableControlWithContext(ctx, input)
+	// Control Tower rejects operations that conflict with in-progress operations, so sequence them per target.
+	conns.GlobalMutexKV.Lock(targetIdentifier)
+	defer conns.GlobalMutexKV.Unlock(targetIdentifier)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	outputRaw, err := retryWhenControlOperationConflict(ctx, timeout, id, func() (interface{}, error) {
+		return conn.EnableControlWithContext(ctx, input)
+	})
 
 	if err != nil {
 		return diag.Errorf("creating ControlTower Control (%s): %s", id, err)
@@ -74,7 +81,7 @@ func resourceControlCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	d.SetId(id)
 
-	if _, err := waitOperationSucceeded(ctx, conn, aws.StringValue(output.OperationIdentifier), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitOperationSucceeded(ctx, conn, aws.StringValue(outputRaw.(*controltower.EnableControlOutput).OperationIdentifier), timeout); err != nil {
 		return diag.Errorf("waiting for ControlTower Control (%s) create: %s", d.Id(), err)
 	}
 
@@ -117,23 +124,42 @@ func resourceControlDelete(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.FromErr(err)
 	}
 
+	conns.GlobalMutexKV.Lock(targetIdentifier)
+	defer conns.GlobalMutexKV.Unlock(targetIdentifier)
+
 	log.Printf("[DEBUG] Deleting ControlTower Control: %s", d.Id())
-	output, err := conn.DisableControlWithContext(ctx, &controltower.DisableControlInput{
-		ControlIdentifier: aws.String(controlIdentifier),
-		TargetIdentifier:  aws.String(targetIdentifier),
+	timeout := d.Timeout(schema.TimeoutDelete)
+	outputRaw, err := retryWhenControlOperationConflict(ctx, timeout, d.Id(), func() (interface{}, error) {
+		return conn.DisableControlWithContext(ctx, &controltower.DisableControlInput{
+			ControlIdentifier: aws.String(controlIdentifier),
+			TargetIdentifier:  aws.String(targetIdentifier),
+		})
 	})
 
 	if err != nil {
 		return diag.Errorf("deleting ControlTower Control (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitOperationSucceeded(ctx, conn, aws.StringValue(output.OperationIdentifier), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitOperationSucceeded(ctx, conn, aws.StringValue(outputRaw.(*controltower.DisableControlOutput).OperationIdentifier), timeout); err != nil {
 		return diag.Errorf("waiting for ControlTower Control (%s) delete: %s", d.Id(), err)
 	}
 
 	return nil
 }
 
+// retryWhenControlOperationConflict retries the specified function while another Control Tower operation,
+// e.g. one started outside of this provider instance, is in progress.
+func retryWhenControlOperationConflict(ctx context.Context, timeout time.Duration, id string, f func() (interface{}, error)) (interface{}, error) {
+	return tfresource.RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
+		if tfawserr.ErrCodeEquals(err, controltower.ErrCodeConflictException) {
+			log.Printf("[INFO] ControlTower Control (%s) waiting for in-progress Control Tower operation: %s", id, err)
+			return true, err
+		}
+
+		return false, err
+	})
+}
+
 const controlResourceIDSeparator = ","
 
 func ControlCreateResourceID(targetIdentifier, controlIdentifier string) string {
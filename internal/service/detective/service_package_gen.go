@@ -45,6 +45,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceMember,
 			TypeName: "aws_detective_member",
 		},
+		{
+			Factory:  ResourceOrganizationConfiguration,
+			TypeName: "aws_detective_organization_configuration",
+		},
 	}
 }
 
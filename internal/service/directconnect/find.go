@@ -65,6 +65,30 @@ func FindConnectionAssociationExists(ctx context.Context, conn *directconnect.Di
 	return nil
 }
 
+func FindMacSecKeyByTwoPartKey(ctx context.Context, conn *directconnect.DirectConnect, connectionID, secretARN string) (*directconnect.MacSecKey, error) {
+	connection, err := FindConnectionByID(ctx, conn, connectionID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range connection.MacSecKeys {
+		if aws.StringValue(key.SecretARN) != secretARN {
+			continue
+		}
+
+		if state := aws.StringValue(key.State); state == macSecKeyStateDisassociated {
+			return nil, &retry.NotFoundError{
+				Message: state,
+			}
+		}
+
+		return key, nil
+	}
+
+	return nil, &retry.NotFoundError{}
+}
+
 func FindGatewayByID(ctx context.Context, conn *directconnect.DirectConnect, id string) (*directconnect.Gateway, error) {
 	input := &directconnect.DescribeDirectConnectGatewaysInput{
 		DirectConnectGatewayId: aws.String(id),
@@ -9,6 +9,7 @@ import (
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directconnect"
@@ -18,6 +19,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	macSecKeyStateAssociating    = "associating"
+	macSecKeyStateAssociated     = "associated"
+	macSecKeyStateDisassociating = "disassociating"
+	macSecKeyStateDisassociated  = "disassociated"
 )
 
 // @SDKResource("aws_dx_macsec_key_association")
@@ -31,6 +40,11 @@ func ResourceMacSecKeyAssociation() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"cak": {
 				Type:     schema.TypeString,
@@ -103,6 +117,12 @@ func resourceMacSecKeyCreate(ctx context.Context, d *schema.ResourceData, meta i
 
 	d.Set("secret_arn", secret_arn)
 
+	// Wait for the new key to be associated so that, when rotating keys with
+	// create_before_destroy, the old key isn't disassociated too early.
+	if _, err := waitMacSecKeyAssociated(ctx, conn, aws.StringValue(output.ConnectionId), secret_arn, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MACSec secret key (%s) association with Direct Connect Connection (%s): %s", secret_arn, aws.StringValue(output.ConnectionId), err)
+	}
+
 	return append(diags, resourceMacSecKeyRead(ctx, d, meta)...)
 }
 
@@ -115,25 +135,24 @@ func resourceMacSecKeyRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "unexpected format of ID (%s), expected secretArn_connectionId", d.Id())
 	}
 
-	connection, err := FindConnectionByID(ctx, conn, connId)
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading Direct Connect Connection (%s): %s", d.Id(), err)
-	}
+	key, err := FindMacSecKeyByTwoPartKey(ctx, conn, connId, secretArn)
 
-	if connection.MacSecKeys == nil {
-		return sdkdiag.AppendErrorf(diags, "no MACSec keys found on Direct Connect Connection (%s)", d.Id())
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MACSec secret key (%s) on Direct Connect Connection (%s) not found, removing from state", secretArn, connId)
+		d.SetId("")
+		return diags
 	}
 
-	for _, key := range connection.MacSecKeys {
-		if aws.StringValue(key.SecretARN) == aws.StringValue(&secretArn) {
-			d.Set("ckn", key.Ckn)
-			d.Set("connection_id", connId)
-			d.Set("secret_arn", key.SecretARN)
-			d.Set("start_on", key.StartOn)
-			d.Set("state", key.State)
-		}
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MACSec secret key (%s) on Direct Connect Connection (%s): %s", secretArn, connId, err)
 	}
 
+	d.Set("ckn", key.Ckn)
+	d.Set("connection_id", connId)
+	d.Set("secret_arn", key.SecretARN)
+	d.Set("start_on", key.StartOn)
+	d.Set("state", key.State)
+
 	return diags
 }
 
@@ -153,6 +172,10 @@ func resourceMacSecKeyDelete(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "Unable to disassociate MACSec secret key on Direct Connect Connection (%s): %s", *input.ConnectionId, err)
 	}
 
+	if _, err := waitMacSecKeyDisassociated(ctx, conn, aws.StringValue(input.ConnectionId), aws.StringValue(input.SecretARN), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MACSec secret key (%s) disassociation from Direct Connect Connection (%s): %s", aws.StringValue(input.SecretARN), aws.StringValue(input.ConnectionId), err)
+	}
+
 	return diags
 }
 
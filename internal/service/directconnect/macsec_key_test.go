@@ -91,6 +91,44 @@ func TestAccDirectConnectMacSecKey_withSecret(t *testing.T) {
 	})
 }
 
+func TestAccDirectConnectMacSecKey_rotation(t *testing.T) {
+	ctx := acctest.Context(t)
+	// Requires an existing MACsec-capable DX connection set as environmental variable
+	key := "DX_CONNECTION_ID"
+	connectionId := os.Getenv(key)
+	if connectionId == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+	resourceName := "aws_dx_macsec_key_association.test"
+	ckn1 := testAccDirecConnectMacSecGenerateHex()
+	cak1 := testAccDirecConnectMacSecGenerateHex()
+	ckn2 := testAccDirecConnectMacSecGenerateHex()
+	cak2 := testAccDirecConnectMacSecGenerateHex()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, directconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMacSecConfig_rotation(ckn1, cak1, connectionId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ckn", ckn1),
+					resource.TestCheckResourceAttr(resourceName, "state", "associated"),
+				),
+			},
+			{
+				Config: testAccMacSecConfig_rotation(ckn2, cak2, connectionId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ckn", ckn2),
+					resource.TestCheckResourceAttr(resourceName, "state", "associated"),
+				),
+			},
+		},
+	})
+}
+
 // testAccDirecConnectMacSecGenerateKey generates a 64-character hex string to be used as CKN or CAK
 func testAccDirecConnectMacSecGenerateHex() string {
 	s := make([]byte, 32)
@@ -127,3 +165,17 @@ resource "aws_dx_macsec_key_association" "test" {
 
 `, secretArn, connectionId)
 }
+
+func testAccMacSecConfig_rotation(ckn, cak, connectionId string) string {
+	return fmt.Sprintf(`
+resource "aws_dx_macsec_key_association" "test" {
+  connection_id = %[3]q
+  ckn           = %[1]q
+  cak           = %[2]q
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, ckn, cak, connectionId)
+}
@@ -76,6 +76,22 @@ func statusHostedConnectionState(ctx context.Context, conn *directconnect.Direct
 	}
 }
 
+func statusMacSecKeyState(ctx context.Context, conn *directconnect.DirectConnect, connectionID, secretARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindMacSecKeyByTwoPartKey(ctx, conn, connectionID, secretARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
 func statusLagState(ctx context.Context, conn *directconnect.DirectConnect, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindLagByID(ctx, conn, id)
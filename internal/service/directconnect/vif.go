@@ -35,21 +35,16 @@ func virtualInterfaceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DirectConnectConn(ctx)
 
-	if d.HasChange("mtu") {
+	// MTU and SiteLink are changed in place, in a single request.
+	if d.HasChanges("mtu", "sitelink_enabled") {
 		req := &directconnect.UpdateVirtualInterfaceAttributesInput{
-			Mtu:                aws.Int64(int64(d.Get("mtu").(int))),
 			VirtualInterfaceId: aws.String(d.Id()),
 		}
-		log.Printf("[DEBUG] Modifying Direct Connect virtual interface attributes: %s", req)
-		_, err := conn.UpdateVirtualInterfaceAttributesWithContext(ctx, req)
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "modifying Direct Connect virtual interface (%s) attributes: %s", d.Id(), err)
+		if d.HasChange("mtu") {
+			req.Mtu = aws.Int64(int64(d.Get("mtu").(int)))
 		}
-	}
-	if d.HasChange("sitelink_enabled") {
-		req := &directconnect.UpdateVirtualInterfaceAttributesInput{
-			EnableSiteLink:     aws.Bool(d.Get("sitelink_enabled").(bool)),
-			VirtualInterfaceId: aws.String(d.Id()),
+		if d.HasChange("sitelink_enabled") {
+			req.EnableSiteLink = aws.Bool(d.Get("sitelink_enabled").(bool))
 		}
 		log.Printf("[DEBUG] Modifying Direct Connect virtual interface attributes: %s", req)
 		_, err := conn.UpdateVirtualInterfaceAttributesWithContext(ctx, req)
@@ -17,6 +17,20 @@ const (
 	replicationTaskStatusStarting  = "starting"
 )
 
+const (
+	replicationTaskAssessmentRunStatusCancelling        = "cancelling"
+	replicationTaskAssessmentRunStatusDeleting          = "deleting"
+	replicationTaskAssessmentRunStatusErrorExecuting    = "error-executing"
+	replicationTaskAssessmentRunStatusErrorProvisioning = "error-provisioning"
+	replicationTaskAssessmentRunStatusFailed            = "failed"
+	replicationTaskAssessmentRunStatusInvalidState      = "invalid state"
+	replicationTaskAssessmentRunStatusPassed            = "passed"
+	replicationTaskAssessmentRunStatusProvisioning      = "provisioning"
+	replicationTaskAssessmentRunStatusRunning           = "running"
+	replicationTaskAssessmentRunStatusStarting          = "starting"
+	replicationTaskAssessmentRunStatusWarning           = "warning"
+)
+
 const (
 	engineNameAurora                     = "aurora"
 	engineNameAuroraPostgresql           = "aurora-postgresql"
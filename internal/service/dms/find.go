@@ -95,3 +95,82 @@ func FindReplicationTaskByID(ctx context.Context, conn *dms.DatabaseMigrationSer
 
 	return results[0], nil
 }
+
+func FindReplicationTaskAssessmentRunByARN(ctx context.Context, conn *dms.DatabaseMigrationService, arn string) (*dms.ReplicationTaskAssessmentRun, error) {
+	input := &dms.DescribeReplicationTaskAssessmentRunsInput{
+		Filters: []*dms.Filter{
+			{
+				Name:   aws.String("replication-task-assessment-run-arn"),
+				Values: aws.StringSlice([]string{arn}),
+			},
+		},
+	}
+
+	var results []*dms.ReplicationTaskAssessmentRun
+
+	err := conn.DescribeReplicationTaskAssessmentRunsPagesWithContext(ctx, input, func(page *dms.DescribeReplicationTaskAssessmentRunsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ReplicationTaskAssessmentRuns {
+			if v != nil {
+				results = append(results, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, dms.ErrCodeResourceNotFoundFault) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(results) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(results); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return results[0], nil
+}
+
+func FindReplicationTaskIndividualAssessments(ctx context.Context, conn *dms.DatabaseMigrationService, input *dms.DescribeReplicationTaskIndividualAssessmentsInput) ([]*dms.ReplicationTaskIndividualAssessment, error) {
+	var output []*dms.ReplicationTaskIndividualAssessment
+
+	err := conn.DescribeReplicationTaskIndividualAssessmentsPagesWithContext(ctx, input, func(page *dms.DescribeReplicationTaskIndividualAssessmentsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ReplicationTaskIndividualAssessments {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, dms.ErrCodeResourceNotFoundFault) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
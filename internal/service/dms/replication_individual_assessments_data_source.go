@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dms
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_dms_replication_individual_assessments")
+func DataSourceReplicationIndividualAssessments() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceReplicationIndividualAssessmentsRead,
+
+		Schema: map[string]*schema.Schema{
+			"individual_assessments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"individual_assessment_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replication_assessment_run_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"replication_assessment_run_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				AtLeastOneOf: []string{"replication_assessment_run_arn", "replication_task_arn"},
+			},
+			"replication_task_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				AtLeastOneOf: []string{"replication_assessment_run_arn", "replication_task_arn"},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceReplicationIndividualAssessmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn(ctx)
+
+	input := &dms.DescribeReplicationTaskIndividualAssessmentsInput{}
+
+	if v, ok := d.GetOk("replication_assessment_run_arn"); ok {
+		input.Filters = append(input.Filters, &dms.Filter{
+			Name:   aws.String("replication-task-assessment-run-arn"),
+			Values: aws.StringSlice([]string{v.(string)}),
+		})
+	}
+
+	if v, ok := d.GetOk("replication_task_arn"); ok {
+		input.Filters = append(input.Filters, &dms.Filter{
+			Name:   aws.String("replication-task-arn"),
+			Values: aws.StringSlice([]string{v.(string)}),
+		})
+	}
+
+	if v, ok := d.GetOk("status"); ok {
+		input.Filters = append(input.Filters, &dms.Filter{
+			Name:   aws.String("status"),
+			Values: aws.StringSlice([]string{v.(string)}),
+		})
+	}
+
+	output, err := FindReplicationTaskIndividualAssessments(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DMS Replication Individual Assessments: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("individual_assessments", flattenReplicationTaskIndividualAssessments(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting individual_assessments: %s", err)
+	}
+
+	return diags
+}
+
+func flattenReplicationTaskIndividualAssessments(apiObjects []*dms.ReplicationTaskIndividualAssessment) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"arn":                            aws.StringValue(apiObject.ReplicationTaskIndividualAssessmentArn),
+			"individual_assessment_name":     aws.StringValue(apiObject.IndividualAssessmentName),
+			"replication_assessment_run_arn": aws.StringValue(apiObject.ReplicationTaskAssessmentRunArn),
+			"status":                         aws.StringValue(apiObject.Status),
+		}
+
+		if v := apiObject.ReplicationTaskIndividualAssessmentStartDate; v != nil {
+			tfMap["start_date"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dms_test
+
+import (
+	"testing"
+
+	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccDMSReplicationIndividualAssessmentsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dms_replication_assessment_run.test"
+	dataSourceName := "data.aws_dms_replication_individual_assessments.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReplicationAssessmentRunDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationIndividualAssessmentsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "individual_assessments.#", resourceName, "individual_assessment_count"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "individual_assessments.0.replication_assessment_run_arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "individual_assessments.0.individual_assessment_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "individual_assessments.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccReplicationIndividualAssessmentsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccReplicationAssessmentRunConfig_basic(rName), `
+data "aws_dms_replication_individual_assessments" "test" {
+  replication_assessment_run_arn = aws_dms_replication_assessment_run.test.arn
+}
+`)
+}
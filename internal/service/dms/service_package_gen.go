@@ -33,6 +33,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceEndpoint,
 			TypeName: "aws_dms_endpoint",
 		},
+		{
+			Factory:  DataSourceReplicationIndividualAssessments,
+			TypeName: "aws_dms_replication_individual_assessments",
+		},
 		{
 			Factory:  DataSourceReplicationInstance,
 			TypeName: "aws_dms_replication_instance",
@@ -74,6 +78,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceReplicationAssessmentRun,
+			TypeName: "aws_dms_replication_assessment_run",
+			Name:     "Replication Assessment Run",
+		},
 		{
 			Factory:  ResourceReplicationInstance,
 			TypeName: "aws_dms_replication_instance",
@@ -5,10 +5,14 @@ package dms
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -108,3 +112,91 @@ func waitReplicationTaskStopped(ctx context.Context, conn *dms.DatabaseMigration
 
 	return err
 }
+
+func waitReplicationTaskAssessmentRunCompleted(ctx context.Context, conn *dms.DatabaseMigrationService, arn string, timeout time.Duration) (*dms.ReplicationTaskAssessmentRun, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{replicationTaskAssessmentRunStatusProvisioning, replicationTaskAssessmentRunStatusRunning, replicationTaskAssessmentRunStatusStarting},
+		Target: []string{
+			replicationTaskAssessmentRunStatusErrorExecuting,
+			replicationTaskAssessmentRunStatusErrorProvisioning,
+			replicationTaskAssessmentRunStatusFailed,
+			replicationTaskAssessmentRunStatusPassed,
+			replicationTaskAssessmentRunStatusWarning,
+		},
+		Refresh:    statusReplicationTaskAssessmentRun(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dms.ReplicationTaskAssessmentRun); ok {
+		if v := aws.StringValue(output.LastFailureMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		// The run couldn't be provisioned or executed.
+		if status := aws.StringValue(output.Status); err == nil && (status == replicationTaskAssessmentRunStatusErrorExecuting || status == replicationTaskAssessmentRunStatusErrorProvisioning) {
+			if v := aws.StringValue(output.LastFailureMessage); v != "" {
+				err = fmt.Errorf("assessment run status %q: %s", status, v)
+			} else {
+				err = fmt.Errorf("assessment run status %q", status)
+			}
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReplicationTaskAssessmentRunCancelled(ctx context.Context, conn *dms.DatabaseMigrationService, arn string, timeout time.Duration) (*dms.ReplicationTaskAssessmentRun, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{replicationTaskAssessmentRunStatusCancelling, replicationTaskAssessmentRunStatusProvisioning, replicationTaskAssessmentRunStatusRunning, replicationTaskAssessmentRunStatusStarting},
+		Target: []string{
+			replicationTaskAssessmentRunStatusDeleting,
+			replicationTaskAssessmentRunStatusErrorExecuting,
+			replicationTaskAssessmentRunStatusErrorProvisioning,
+			replicationTaskAssessmentRunStatusFailed,
+			replicationTaskAssessmentRunStatusInvalidState,
+			replicationTaskAssessmentRunStatusPassed,
+			replicationTaskAssessmentRunStatusWarning,
+		},
+		Refresh:        statusReplicationTaskAssessmentRun(ctx, conn, arn),
+		Timeout:        timeout,
+		MinTimeout:     10 * time.Second,
+		NotFoundChecks: 1,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	// A cancelled run may be removed rather than moved to a terminal status.
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if output, ok := outputRaw.(*dms.ReplicationTaskAssessmentRun); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReplicationTaskAssessmentRunDeleted(ctx context.Context, conn *dms.DatabaseMigrationService, arn string, timeout time.Duration) (*dms.ReplicationTaskAssessmentRun, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{replicationTaskAssessmentRunStatusDeleting},
+		Target:     []string{},
+		Refresh:    statusReplicationTaskAssessmentRun(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dms.ReplicationTaskAssessmentRun); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ds
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	clientAuthenticationSettingsIDPartCount = 2
+)
+
+// @SDKResource("aws_directory_service_client_authentication_settings", name="Client Authentication Settings")
+func ResourceClientAuthenticationSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceClientAuthenticationSettingsCreate,
+		ReadWithoutTimeout:   resourceClientAuthenticationSettingsRead,
+		DeleteWithoutTimeout: resourceClientAuthenticationSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"last_updated_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(directoryservice.ClientAuthenticationType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceClientAuthenticationSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	directoryID := d.Get("directory_id").(string)
+	authenticationType := d.Get("type").(string)
+	id, err := flex.FlattenResourceId([]string{directoryID, authenticationType}, clientAuthenticationSettingsIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &directoryservice.EnableClientAuthenticationInput{
+		DirectoryId: aws.String(directoryID),
+		Type:        aws.String(authenticationType),
+	}
+
+	_, err = conn.EnableClientAuthenticationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("enabling Directory Service Directory (%s) %s client authentication: %s", directoryID, authenticationType, err)
+	}
+
+	d.SetId(id)
+
+	return resourceClientAuthenticationSettingsRead(ctx, d, meta)
+}
+
+func resourceClientAuthenticationSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), clientAuthenticationSettingsIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	directoryID, authenticationType := parts[0], parts[1]
+	output, err := FindClientAuthenticationSettings(ctx, conn, directoryID, authenticationType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Directory Service Client Authentication Settings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Directory Service Client Authentication Settings (%s): %s", d.Id(), err)
+	}
+
+	d.Set("directory_id", directoryID)
+	if output.LastUpdatedDateTime != nil {
+		d.Set("last_updated_date_time", aws.TimeValue(output.LastUpdatedDateTime).Format(time.RFC3339))
+	} else {
+		d.Set("last_updated_date_time", nil)
+	}
+	d.Set("type", output.Type)
+
+	return nil
+}
+
+func resourceClientAuthenticationSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	directoryID := d.Get("directory_id").(string)
+	authenticationType := d.Get("type").(string)
+
+	log.Printf("[DEBUG] Disabling Directory Service Directory (%s) %s client authentication", directoryID, authenticationType)
+	_, err := conn.DisableClientAuthenticationWithContext(ctx, &directoryservice.DisableClientAuthenticationInput{
+		DirectoryId: aws.String(directoryID),
+		Type:        aws.String(authenticationType),
+	})
+
+	if tfawserr.ErrCodeEquals(err, directoryservice.ErrCodeDirectoryDoesNotExistException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("disabling Directory Service Directory (%s) %s client authentication: %s", directoryID, authenticationType, err)
+	}
+
+	return nil
+}
@@ -245,3 +245,154 @@ func FindSharedDirectory(ctx context.Context, conn *directoryservice.DirectorySe
 
 	return sharedDirectory, nil
 }
+
+func FindLDAPSSettings(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, ldapsType string) (*directoryservice.LDAPSSettingInfo, error) {
+	input := &directoryservice.DescribeLDAPSSettingsInput{
+		DirectoryId: aws.String(directoryID),
+		Type:        aws.String(ldapsType),
+	}
+	var output []*directoryservice.LDAPSSettingInfo
+
+	err := conn.DescribeLDAPSSettingsPagesWithContext(ctx, input, func(page *directoryservice.DescribeLDAPSSettingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LDAPSSettingsInfo {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, directoryservice.ErrCodeDirectoryDoesNotExistException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	settings := output[0]
+
+	if status := aws.StringValue(settings.LDAPSStatus); status == directoryservice.LDAPSStatusDisabled {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return settings, nil
+}
+
+func FindClientAuthenticationSettings(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, authenticationType string) (*directoryservice.ClientAuthenticationSettingInfo, error) {
+	input := &directoryservice.DescribeClientAuthenticationSettingsInput{
+		DirectoryId: aws.String(directoryID),
+		Type:        aws.String(authenticationType),
+	}
+	var output []*directoryservice.ClientAuthenticationSettingInfo
+
+	err := conn.DescribeClientAuthenticationSettingsPagesWithContext(ctx, input, func(page *directoryservice.DescribeClientAuthenticationSettingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ClientAuthenticationSettingsInfo {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, directoryservice.ErrCodeDirectoryDoesNotExistException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	settings := output[0]
+
+	if status := aws.StringValue(settings.Status); status == directoryservice.ClientAuthenticationStatusDisabled {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return settings, nil
+}
+
+func FindSchemaExtension(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, schemaExtensionID string) (*directoryservice.SchemaExtensionInfo, error) {
+	input := &directoryservice.ListSchemaExtensionsInput{
+		DirectoryId: aws.String(directoryID),
+	}
+	var output *directoryservice.SchemaExtensionInfo
+
+	err := conn.ListSchemaExtensionsPagesWithContext(ctx, input, func(page *directoryservice.ListSchemaExtensionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SchemaExtensionsInfo {
+			if v != nil && aws.StringValue(v.SchemaExtensionId) == schemaExtensionID {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, directoryservice.ErrCodeDirectoryDoesNotExistException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.SchemaExtensionStatus); status == directoryservice.SchemaExtensionStatusCancelled {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ds
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	ldapSettingsIDPartCount = 2
+)
+
+// @SDKResource("aws_directory_service_ldap_settings", name="LDAP Settings")
+func ResourceLDAPSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLDAPSettingsCreate,
+		ReadWithoutTimeout:   resourceLDAPSettingsRead,
+		DeleteWithoutTimeout: resourceLDAPSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"last_updated_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      directoryservice.LDAPSTypeClient,
+				ValidateFunc: validation.StringInSlice(directoryservice.LDAPSType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceLDAPSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	directoryID := d.Get("directory_id").(string)
+	ldapsType := d.Get("type").(string)
+	id, err := flex.FlattenResourceId([]string{directoryID, ldapsType}, ldapSettingsIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &directoryservice.EnableLDAPSInput{
+		DirectoryId: aws.String(directoryID),
+		Type:        aws.String(ldapsType),
+	}
+
+	_, err = conn.EnableLDAPSWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("enabling Directory Service Directory (%s) LDAPS: %s", directoryID, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitLDAPSSettingsEnabled(ctx, conn, directoryID, ldapsType, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Directory Service Directory (%s) LDAPS enable: %s", directoryID, err)
+	}
+
+	return resourceLDAPSettingsRead(ctx, d, meta)
+}
+
+func resourceLDAPSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), ldapSettingsIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	directoryID, ldapsType := parts[0], parts[1]
+	output, err := FindLDAPSSettings(ctx, conn, directoryID, ldapsType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Directory Service LDAP Settings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Directory Service LDAP Settings (%s): %s", d.Id(), err)
+	}
+
+	d.Set("directory_id", directoryID)
+	if output.LastUpdatedDateTime != nil {
+		d.Set("last_updated_date_time", aws.TimeValue(output.LastUpdatedDateTime).Format(time.RFC3339))
+	} else {
+		d.Set("last_updated_date_time", nil)
+	}
+	d.Set("type", ldapsType)
+
+	return nil
+}
+
+func resourceLDAPSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	directoryID := d.Get("directory_id").(string)
+	ldapsType := d.Get("type").(string)
+
+	log.Printf("[DEBUG] Disabling Directory Service Directory (%s) LDAPS", directoryID)
+	_, err := conn.DisableLDAPSWithContext(ctx, &directoryservice.DisableLDAPSInput{
+		DirectoryId: aws.String(directoryID),
+		Type:        aws.String(ldapsType),
+	})
+
+	if tfawserr.ErrCodeEquals(err, directoryservice.ErrCodeDirectoryDoesNotExistException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("disabling Directory Service Directory (%s) LDAPS: %s", directoryID, err)
+	}
+
+	if _, err := waitLDAPSSettingsDisabled(ctx, conn, directoryID, ldapsType, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Directory Service Directory (%s) LDAPS disable: %s", directoryID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ds_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfds "github.com/hashicorp/terraform-provider-aws/internal/service/ds"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccDSLDAPSettings_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "DIRECTORY_SERVICE_LDAPS_DIRECTORY_ID"
+	directoryID := os.Getenv(key)
+	if directoryID == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var v directoryservice.LDAPSSettingInfo
+	resourceName := "aws_directory_service_ldap_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckDirectoryService(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, directoryservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLDAPSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLDAPSettingsConfig_basic(directoryID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLDAPSettingsExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "directory_id", directoryID),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_date_time"),
+					resource.TestCheckResourceAttr(resourceName, "type", "Client"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDSLDAPSettings_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "DIRECTORY_SERVICE_LDAPS_DIRECTORY_ID"
+	directoryID := os.Getenv(key)
+	if directoryID == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var v directoryservice.LDAPSSettingInfo
+	resourceName := "aws_directory_service_ldap_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckDirectoryService(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, directoryservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLDAPSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLDAPSettingsConfig_basic(directoryID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLDAPSettingsExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfds.ResourceLDAPSettings(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLDAPSettingsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DSConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_directory_service_ldap_settings" {
+				continue
+			}
+
+			_, err := tfds.FindLDAPSSettings(ctx, conn, rs.Primary.Attributes["directory_id"], rs.Primary.Attributes["type"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Directory Service LDAP Settings %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLDAPSettingsExists(ctx context.Context, n string, v *directoryservice.LDAPSSettingInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Directory Service LDAP Settings ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DSConn(ctx)
+
+		output, err := tfds.FindLDAPSSettings(ctx, conn, rs.Primary.Attributes["directory_id"], rs.Primary.Attributes["type"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccLDAPSettingsConfig_basic(directoryID string) string {
+	return fmt.Sprintf(`
+resource "aws_directory_service_ldap_settings" "test" {
+  directory_id = %[1]q
+}
+`, directoryID)
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ds_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfds "github.com/hashicorp/terraform-provider-aws/internal/service/ds"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccDSSchemaExtension_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v directoryservice.SchemaExtensionInfo
+	resourceName := "aws_directory_service_schema_extension.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckDirectoryService(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, directoryservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaExtensionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaExtensionConfig_basic(rName, domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSchemaExtensionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "create_snapshot_before_schema_extension", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", "aws_directory_service_directory.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "end_date_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "schema_extension_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_date_time"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_snapshot_before_schema_extension", "ldif_content"},
+			},
+		},
+	})
+}
+
+// Applied schema extensions cannot be removed, so only the removal from state is checked.
+func testAccCheckSchemaExtensionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DSConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_directory_service_schema_extension" {
+				continue
+			}
+
+			output, err := tfds.FindSchemaExtension(ctx, conn, rs.Primary.Attributes["directory_id"], rs.Primary.Attributes["schema_extension_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if status := aws.StringValue(output.SchemaExtensionStatus); status != directoryservice.SchemaExtensionStatusCompleted && status != directoryservice.SchemaExtensionStatusFailed {
+				return fmt.Errorf("Directory Service Schema Extension %s still in progress", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSchemaExtensionExists(ctx context.Context, n string, v *directoryservice.SchemaExtensionInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Directory Service Schema Extension ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DSConn(ctx)
+
+		output, err := tfds.FindSchemaExtension(ctx, conn, rs.Primary.Attributes["directory_id"], rs.Primary.Attributes["schema_extension_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSchemaExtensionConfig_basic(rName, domain string) string {
+	dn := "DC=" + strings.Join(strings.Split(domain, "."), ",DC=")
+
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_directory_service_directory" "test" {
+  name     = %[2]q
+  password = "SuperSecretPassw0rd"
+  type     = "MicrosoftAD"
+  edition  = "Standard"
+
+  vpc_settings {
+    vpc_id     = aws_vpc.test.id
+    subnet_ids = aws_subnet.test[*].id
+  }
+}
+
+resource "aws_directory_service_schema_extension" "test" {
+  directory_id = aws_directory_service_directory.test.id
+  description  = %[1]q
+
+  create_snapshot_before_schema_extension = false
+
+  ldif_content = <<-EOT
+dn: CN=tfacctest-EmployeeBadge,CN=Schema,CN=Configuration,%[3]s
+changetype: add
+objectClass: top
+objectClass: attributeSchema
+cn: tfacctest-EmployeeBadge
+attributeID: 1.2.840.113556.1.8000.2554.999.1
+attributeSyntax: 2.5.5.12
+isSingleValued: TRUE
+lDAPDisplayName: tfacctestEmployeeBadge
+oMSyntax: 64
+searchFlags: 0
+
+dn:
+changetype: modify
+add: schemaUpdateNow
+schemaUpdateNow: 1
+-
+EOT
+}
+`, rName, domain, dn))
+}
@@ -40,6 +40,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceClientAuthenticationSettings,
+			TypeName: "aws_directory_service_client_authentication_settings",
+			Name:     "Client Authentication Settings",
+		},
 		{
 			Factory:  ResourceConditionalForwarder,
 			TypeName: "aws_directory_service_conditional_forwarder",
@@ -52,6 +57,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceLDAPSettings,
+			TypeName: "aws_directory_service_ldap_settings",
+			Name:     "LDAP Settings",
+		},
 		{
 			Factory:  ResourceLogSubscription,
 			TypeName: "aws_directory_service_log_subscription",
@@ -66,6 +76,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Region",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  ResourceSchemaExtension,
+			TypeName: "aws_directory_service_schema_extension",
+			Name:     "Schema Extension",
+		},
 		{
 			Factory:  ResourceSharedDirectory,
 			TypeName: "aws_directory_service_shared_directory",
@@ -60,6 +60,22 @@ func statusDomainController(ctx context.Context, conn *directoryservice.Director
 	}
 }
 
+func statusLDAPSSettings(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, ldapsType string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindLDAPSSettings(ctx, conn, directoryID, ldapsType)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.LDAPSStatus), nil
+	}
+}
+
 func statusRadius(ctx context.Context, conn *directoryservice.DirectoryService, directoryID string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindDirectoryByID(ctx, conn, directoryID)
@@ -92,6 +108,22 @@ func statusRegion(ctx context.Context, conn *directoryservice.DirectoryService,
 	}
 }
 
+func statusSchemaExtension(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, schemaExtensionID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindSchemaExtension(ctx, conn, directoryID, schemaExtensionID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.SchemaExtensionStatus), nil
+	}
+}
+
 func statusSharedDirectory(ctx context.Context, conn *directoryservice.DirectoryService, ownerDirectoryID, sharedDirectoryID string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindSharedDirectory(ctx, conn, ownerDirectoryID, sharedDirectoryID)
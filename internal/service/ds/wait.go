@@ -52,6 +52,44 @@ func waitDomainControllerDeleted(ctx context.Context, conn *directoryservice.Dir
 	return nil, err
 }
 
+func waitLDAPSSettingsEnabled(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, ldapsType string, timeout time.Duration) (*directoryservice.LDAPSSettingInfo, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{directoryservice.LDAPSStatusEnabling},
+		Target:  []string{directoryservice.LDAPSStatusEnabled},
+		Refresh: statusLDAPSSettings(ctx, conn, directoryID, ldapsType),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*directoryservice.LDAPSSettingInfo); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.LDAPSStatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitLDAPSSettingsDisabled(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, ldapsType string, timeout time.Duration) (*directoryservice.LDAPSSettingInfo, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{directoryservice.LDAPSStatusEnabled, directoryservice.LDAPSStatusEnableFailed},
+		Target:  []string{},
+		Refresh: statusLDAPSSettings(ctx, conn, directoryID, ldapsType),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*directoryservice.LDAPSSettingInfo); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.LDAPSStatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitRadiusCompleted(ctx context.Context, conn *directoryservice.DirectoryService, directoryID string, timeout time.Duration) (*directoryservice.DirectoryDescription, error) { //nolint:unparam
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{directoryservice.RadiusStatusCreating},
@@ -103,6 +141,57 @@ func waitRegionDeleted(ctx context.Context, conn *directoryservice.DirectoryServ
 	return nil, err
 }
 
+func waitSchemaExtensionCompleted(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, schemaExtensionID string, timeout time.Duration) (*directoryservice.SchemaExtensionInfo, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			directoryservice.SchemaExtensionStatusInitializing,
+			directoryservice.SchemaExtensionStatusCreatingSnapshot,
+			directoryservice.SchemaExtensionStatusUpdatingSchema,
+			directoryservice.SchemaExtensionStatusReplicating,
+		},
+		Target:     []string{directoryservice.SchemaExtensionStatusCompleted},
+		Refresh:    statusSchemaExtension(ctx, conn, directoryID, schemaExtensionID),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*directoryservice.SchemaExtensionInfo); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.SchemaExtensionStatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSchemaExtensionCancelled(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, schemaExtensionID string, timeout time.Duration) (*directoryservice.SchemaExtensionInfo, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			directoryservice.SchemaExtensionStatusInitializing,
+			directoryservice.SchemaExtensionStatusCreatingSnapshot,
+			directoryservice.SchemaExtensionStatusUpdatingSchema,
+			directoryservice.SchemaExtensionStatusCancelInProgress,
+			directoryservice.SchemaExtensionStatusRollbackInProgress,
+		},
+		Target:     []string{},
+		Refresh:    statusSchemaExtension(ctx, conn, directoryID, schemaExtensionID),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*directoryservice.SchemaExtensionInfo); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.SchemaExtensionStatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitSharedDirectoryDeleted(ctx context.Context, conn *directoryservice.DirectoryService, ownerDirectoryID, sharedDirectoryID string, timeout time.Duration) (*directoryservice.SharedDirectory, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
@@ -55,6 +55,10 @@ func ResourceTableReplica() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			verify.SetTagsDiff,
+			// A replica's global secondary index read capacity override can be changed but not removed.
+			customdiff.ForceNewIfChange("global_secondary_index", func(_ context.Context, old, new, meta interface{}) bool {
+				return replicaGlobalSecondaryIndexNames(old.(*schema.Set)).Difference(replicaGlobalSecondaryIndexNames(new.(*schema.Set))).Len() > 0
+			}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -62,7 +66,27 @@ func ResourceTableReplica() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			// global_secondary_index read capacity override can be set but not return by aws atm either through main/replica nor directly
+			"deletion_protection_enabled": { // direct to replica
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"global_secondary_index": { // through main table
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"read_capacity_override": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
 			"global_table_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -74,7 +98,6 @@ func ResourceTableReplica() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ValidateFunc: verify.ValidARN,
-				ForceNew:     true,
 			},
 			"point_in_time_recovery": { // direct to replica
 				Type:     schema.TypeBool,
@@ -124,6 +147,10 @@ func resourceTableReplicaCreate(ctx context.Context, d *schema.ResourceData, met
 		replicaInput.KMSMasterKeyId = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("global_secondary_index"); ok && v.(*schema.Set).Len() > 0 {
+		replicaInput.GlobalSecondaryIndexes = expandReplicaGlobalSecondaryIndexes(v.(*schema.Set).List())
+	}
+
 	if v, ok := d.GetOk("table_class_override"); ok {
 		replicaInput.TableClassOverride = aws.String(v.(string))
 	}
@@ -140,6 +167,12 @@ func resourceTableReplicaCreate(ctx context.Context, d *schema.ResourceData, met
 		}},
 	}
 
+	// Replicas of the same table are added one at a time. Queue for the table here rather than
+	// contending for it in the retry loop so that replicas created in parallel wait to become
+	// active concurrently.
+	mutexKey := tableReplicaMutexKey(tableName, mainRegion)
+	conns.GlobalMutexKV.Lock(mutexKey)
+
 	err = retry.RetryContext(ctx, maxDuration(replicaUpdateTimeout, d.Timeout(schema.TimeoutCreate)), func() *retry.RetryError {
 		_, err := conn.UpdateTableWithContext(ctx, input)
 		if err != nil {
@@ -162,6 +195,8 @@ func resourceTableReplicaCreate(ctx context.Context, d *schema.ResourceData, met
 		_, err = conn.UpdateTableWithContext(ctx, input)
 	}
 
+	conns.GlobalMutexKV.Unlock(mutexKey)
+
 	if err != nil {
 		return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTableReplica, d.Get("global_table_arn").(string), err)
 	}
@@ -187,7 +222,6 @@ func resourceTableReplicaRead(ctx context.Context, d *schema.ResourceData, meta
 	// handled through main table (global table)
 	// * global_secondary_index
 	// * kms_key_arn
-	// * read_capacity_override
 	// * table_class_override
 	diags diag.Diagnostics
 
@@ -266,6 +300,10 @@ func resourceTableReplicaRead(ctx context.Context, d *schema.ResourceData, meta
 		d.Set(names.AttrKMSKeyARN, replica.KMSMasterKeyId)
 	}
 
+	if err := d.Set("global_secondary_index", flattenReplicaGlobalSecondaryIndexes(replica.GlobalSecondaryIndexes)); err != nil {
+		return create.DiagError(names.DynamoDB, create.ErrActionSetting, ResNameTableReplica, d.Id(), err)
+	}
+
 	if replica.ReplicaTableClassSummary != nil {
 		d.Set("table_class_override", replica.ReplicaTableClassSummary.TableClass)
 	} else {
@@ -279,6 +317,7 @@ func resourceTableReplicaReadReplica(ctx context.Context, d *schema.ResourceData
 	var
 	// handled direct to replica
 	// * arn
+	// * deletion_protection_enabled
 	// * point_in_time_recovery
 	// * tags
 	diags diag.Diagnostics
@@ -314,6 +353,7 @@ func resourceTableReplicaReadReplica(ctx context.Context, d *schema.ResourceData
 	}
 
 	d.Set(names.AttrARN, result.Table.TableArn)
+	d.Set("deletion_protection_enabled", result.Table.DeletionProtectionEnabled)
 
 	pitrOut, err := conn.DescribeContinuousBackupsWithContext(ctx, &dynamodb.DescribeContinuousBackupsInput{
 		TableName: aws.String(tableName),
@@ -345,7 +385,6 @@ func resourceTableReplicaUpdate(ctx context.Context, d *schema.ResourceData, met
 	// handled through main table (main)
 	// * global_secondary_index
 	// * kms_key_arn
-	// * read_capacity_override
 	// * table_class_override
 	diags diag.Diagnostics
 
@@ -386,6 +425,11 @@ func resourceTableReplicaUpdate(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
+	if d.HasChange("global_secondary_index") && !d.IsNewResource() { // set on create
+		viaMainChanges = true
+		viaMainInput.GlobalSecondaryIndexes = expandReplicaGlobalSecondaryIndexes(d.Get("global_secondary_index").(*schema.Set).List())
+	}
+
 	if viaMainChanges {
 		input := &dynamodb.UpdateTableInput{
 			ReplicaUpdates: []*dynamodb.ReplicationGroupUpdate{{
@@ -394,6 +438,9 @@ func resourceTableReplicaUpdate(ctx context.Context, d *schema.ResourceData, met
 			TableName: aws.String(tableName),
 		}
 
+		mutexKey := tableReplicaMutexKey(tableName, mainRegion)
+		conns.GlobalMutexKV.Lock(mutexKey)
+
 		err := retry.RetryContext(ctx, maxDuration(replicaUpdateTimeout, d.Timeout(schema.TimeoutUpdate)), func() *retry.RetryError {
 			_, err := tabConn.UpdateTableWithContext(ctx, input)
 			if err != nil {
@@ -416,6 +463,8 @@ func resourceTableReplicaUpdate(ctx context.Context, d *schema.ResourceData, met
 			_, err = tabConn.UpdateTableWithContext(ctx, input)
 		}
 
+		conns.GlobalMutexKV.Unlock(mutexKey)
+
 		if err != nil && !tfawserr.ErrMessageContains(err, "ValidationException", "no actions specified") {
 			return create.DiagError(names.DynamoDB, create.ErrActionUpdating, ResNameTableReplica, d.Id(), err)
 		}
@@ -426,9 +475,25 @@ func resourceTableReplicaUpdate(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	// handled direct to replica
+	// * deletion_protection_enabled
 	// * point_in_time_recovery
 	// * tags
-	if d.HasChanges("point_in_time_recovery", names.AttrTagsAll) {
+	if d.HasChanges("deletion_protection_enabled", "point_in_time_recovery", names.AttrTagsAll) {
+		if d.HasChange("deletion_protection_enabled") {
+			input := &dynamodb.UpdateTableInput{
+				DeletionProtectionEnabled: aws.Bool(d.Get("deletion_protection_enabled").(bool)),
+				TableName:                 aws.String(tableName),
+			}
+
+			_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+				return repConn.UpdateTableWithContext(ctx, input)
+			}, dynamodb.ErrCodeResourceInUseException)
+
+			if err != nil {
+				return create.DiagError(names.DynamoDB, create.ErrActionUpdating, ResNameTableReplica, d.Id(), fmt.Errorf("deletion protection: %w", err))
+			}
+		}
+
 		if d.HasChange(names.AttrTagsAll) {
 			o, n := d.GetChange(names.AttrTagsAll)
 			if err := updateTags(ctx, repConn, d.Get(names.AttrARN).(string), o, n); err != nil {
@@ -481,6 +546,9 @@ func resourceTableReplicaDelete(ctx context.Context, d *schema.ResourceData, met
 		},
 	}
 
+	mutexKey := tableReplicaMutexKey(tableName, mainRegion)
+	conns.GlobalMutexKV.Lock(mutexKey)
+
 	err = retry.RetryContext(ctx, updateTableTimeout, func() *retry.RetryError {
 		_, err := conn.UpdateTableWithContext(ctx, input)
 		if err != nil {
@@ -503,6 +571,8 @@ func resourceTableReplicaDelete(ctx context.Context, d *schema.ResourceData, met
 		_, err = conn.UpdateTableWithContext(ctx, input)
 	}
 
+	conns.GlobalMutexKV.Unlock(mutexKey)
+
 	if err != nil {
 		return create.DiagError(names.DynamoDB, create.ErrActionDeleting, ResNameTableReplica, d.Id(), err)
 	}
@@ -528,6 +598,10 @@ func tableReplicaID(tableName, mainRegion string) string {
 	return fmt.Sprintf("%s:%s", tableName, mainRegion)
 }
 
+func tableReplicaMutexKey(tableName, mainRegion string) string {
+	return fmt.Sprintf("dynamodb-table-replica-%s", tableReplicaID(tableName, mainRegion))
+}
+
 func FilterReplicasByRegion(replicas []*dynamodb.ReplicaDescription, region string) (*dynamodb.ReplicaDescription, error) {
 	if len(replicas) == 0 {
 		return nil, errors.New("no replicas found")
@@ -541,3 +615,65 @@ func FilterReplicasByRegion(replicas []*dynamodb.ReplicaDescription, region stri
 
 	return nil, errors.New("replica not found")
 }
+
+func expandReplicaGlobalSecondaryIndexes(tfList []interface{}) []*dynamodb.ReplicaGlobalSecondaryIndex {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*dynamodb.ReplicaGlobalSecondaryIndex
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &dynamodb.ReplicaGlobalSecondaryIndex{
+			IndexName: aws.String(tfMap[names.AttrName].(string)),
+			ProvisionedThroughputOverride: &dynamodb.ProvisionedThroughputOverride{
+				ReadCapacityUnits: aws.Int64(int64(tfMap["read_capacity_override"].(int))),
+			},
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// flattenReplicaGlobalSecondaryIndexes returns only those indexes with a read capacity override.
+// Other indexes inherit their provisioned throughput and auto scaling settings from the main table.
+func flattenReplicaGlobalSecondaryIndexes(apiObjects []*dynamodb.ReplicaGlobalSecondaryIndexDescription) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.ProvisionedThroughputOverride == nil || apiObject.ProvisionedThroughputOverride.ReadCapacityUnits == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrName:           aws.StringValue(apiObject.IndexName),
+			"read_capacity_override": aws.Int64Value(apiObject.ProvisionedThroughputOverride.ReadCapacityUnits),
+		})
+	}
+
+	return tfList
+}
+
+func replicaGlobalSecondaryIndexNames(s *schema.Set) *schema.Set {
+	v := schema.NewSet(schema.HashString, nil)
+
+	for _, tfMapRaw := range s.List() {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			v.Add(tfMap[names.AttrName].(string))
+		}
+	}
+
+	return v
+}
@@ -404,317 +404,7 @@ func ResourceSpotFleetRequest() *schema.Resource {
 										Optional: true,
 										ForceNew: true,
 									},
-									"instance_requirements": {
-										Type:     schema.TypeList,
-										Optional: true,
-										ForceNew: true,
-										MaxItems: 1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"accelerator_count": {
-													Type:     schema.TypeList,
-													Optional: true,
-													ForceNew: true,
-													MaxItems: 1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"max": {
-																Type:         schema.TypeInt,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: validation.IntAtLeast(0),
-															},
-															"min": {
-																Type:         schema.TypeInt,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: validation.IntAtLeast(1),
-															},
-														},
-													},
-												},
-												"accelerator_manufacturers": {
-													Type:     schema.TypeSet,
-													Optional: true,
-													ForceNew: true,
-													Elem: &schema.Schema{
-														Type:         schema.TypeString,
-														ValidateFunc: validation.StringInSlice(ec2.AcceleratorManufacturer_Values(), false),
-													},
-												},
-												"accelerator_names": {
-													Type:     schema.TypeSet,
-													Optional: true,
-													ForceNew: true,
-													Elem: &schema.Schema{
-														Type:         schema.TypeString,
-														ValidateFunc: validation.StringInSlice(ec2.AcceleratorName_Values(), false),
-													},
-												},
-												"accelerator_total_memory_mib": {
-													Type:     schema.TypeList,
-													Optional: true,
-													ForceNew: true,
-													MaxItems: 1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"max": {
-																Type:         schema.TypeInt,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: validation.IntAtLeast(1),
-															},
-															"min": {
-																Type:         schema.TypeInt,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: validation.IntAtLeast(1),
-															},
-														},
-													},
-												},
-												"accelerator_types": {
-													Type:     schema.TypeSet,
-													Optional: true,
-													ForceNew: true,
-													Elem: &schema.Schema{
-														Type:         schema.TypeString,
-														ValidateFunc: validation.StringInSlice(ec2.AcceleratorType_Values(), false),
-													},
-												},
-												"allowed_instance_types": {
-													Type:     schema.TypeSet,
-													Optional: true,
-													ForceNew: true,
-													MaxItems: 400,
-													Elem:     &schema.Schema{Type: schema.TypeString},
-												},
-												"bare_metal": {
-													Type:         schema.TypeString,
-													Optional:     true,
-													ForceNew:     true,
-													ValidateFunc: validation.StringInSlice(ec2.BareMetal_Values(), false),
-												},
-												"baseline_ebs_bandwidth_mbps": {
-													Type:     schema.TypeList,
-													Optional: true,
-													ForceNew: true,
-													MaxItems: 1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"max": {
-																Type:         schema.TypeInt,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: validation.IntAtLeast(1),
-															},
-															"min": {
-																Type:         schema.TypeInt,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: validation.IntAtLeast(1),
-															},
-														},
-													},
-												},
-												"burstable_performance": {
-													Type:         schema.TypeString,
-													Optional:     true,
-													ForceNew:     true,
-													ValidateFunc: validation.StringInSlice(ec2.BurstablePerformance_Values(), false),
-												},
-												"cpu_manufacturers": {
-													Type:     schema.TypeSet,
-													Optional: true,
-													ForceNew: true,
-													Elem: &schema.Schema{
-														Type:         schema.TypeString,
-														ValidateFunc: validation.StringInSlice(ec2.CpuManufacturer_Values(), false),
-													},
-												},
-												"excluded_instance_types": {
-													Type:     schema.TypeSet,
-													Optional: true,
-													ForceNew: true,
-													MaxItems: 400,
-													Elem:     &schema.Schema{Type: schema.TypeString},
-												},
-												"instance_generations": {
-													Type:     schema.TypeSet,
-													Optional: true,
-													ForceNew: true,
-													Elem: &schema.Schema{
-														Type:         schema.TypeString,
-														ValidateFunc: validation.StringInSlice(ec2.InstanceGeneration_Values(), false),
-													},
-												},
-												"local_storage": {
-													Type:         schema.TypeString,
-													Optional:     true,
-													ForceNew:     true,
-													ValidateFunc: validation.StringInSlice(ec2.LocalStorage_Values(), false),
-												},
-												"local_storage_types": {
-													Type:     schema.TypeSet,
-													Optional: true,
-													ForceNew: true,
-													Elem: &schema.Schema{
-														Type:         schema.TypeString,
-														ValidateFunc: validation.StringInSlice(ec2.LocalStorageType_Values(), false),
-													},
-												},
-												"memory_gib_per_vcpu": {
-													Type:     schema.TypeList,
-													Optional: true,
-													ForceNew: true,
-													MaxItems: 1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"max": {
-																Type:         schema.TypeFloat,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: verify.FloatGreaterThan(0.0),
-															},
-															"min": {
-																Type:         schema.TypeFloat,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: verify.FloatGreaterThan(0.0),
-															},
-														},
-													},
-												},
-												"memory_mib": {
-													Type:     schema.TypeList,
-													Optional: true,
-													ForceNew: true,
-													MaxItems: 1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"max": {
-																Type:         schema.TypeInt,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: validation.IntAtLeast(1),
-															},
-															"min": {
-																Type:         schema.TypeInt,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: validation.IntAtLeast(1),
-															},
-														},
-													},
-												},
-												"network_bandwidth_gbps": {
-													Type:     schema.TypeList,
-													Optional: true,
-													ForceNew: true,
-													MaxItems: 1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"max": {
-																Type:         schema.TypeFloat,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: verify.FloatGreaterThan(0.0),
-															},
-															"min": {
-																Type:         schema.TypeFloat,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: verify.FloatGreaterThan(0.0),
-															},
-														},
-													},
-												},
-												"network_interface_count": {
-													Type:     schema.TypeList,
-													Optional: true,
-													ForceNew: true,
-													MaxItems: 1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"max": {
-																Type:         schema.TypeInt,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: validation.IntAtLeast(1),
-															},
-															"min": {
-																Type:         schema.TypeInt,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: validation.IntAtLeast(1),
-															},
-														},
-													},
-												},
-												"on_demand_max_price_percentage_over_lowest_price": {
-													Type:         schema.TypeInt,
-													Optional:     true,
-													ForceNew:     true,
-													ValidateFunc: validation.IntAtLeast(1),
-												},
-												"require_hibernate_support": {
-													Type:     schema.TypeBool,
-													Optional: true,
-													ForceNew: true,
-												},
-												"spot_max_price_percentage_over_lowest_price": {
-													Type:         schema.TypeInt,
-													Optional:     true,
-													ForceNew:     true,
-													ValidateFunc: validation.IntAtLeast(1),
-												},
-												"total_local_storage_gb": {
-													Type:     schema.TypeList,
-													Optional: true,
-													ForceNew: true,
-													MaxItems: 1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"max": {
-																Type:         schema.TypeFloat,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: verify.FloatGreaterThan(0.0),
-															},
-															"min": {
-																Type:         schema.TypeFloat,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: verify.FloatGreaterThan(0.0),
-															},
-														},
-													},
-												},
-												"vcpu_count": {
-													Type:     schema.TypeList,
-													Optional: true,
-													ForceNew: true,
-													MaxItems: 1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"max": {
-																Type:         schema.TypeInt,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: validation.IntAtLeast(1),
-															},
-															"min": {
-																Type:         schema.TypeInt,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: validation.IntAtLeast(1),
-															},
-														},
-													},
-												},
-											},
-										},
-									},
+									"instance_requirements": instanceRequirementsSchema(true, false, ""),
 									"instance_type": {
 										Type:     schema.TypeString,
 										Optional: true,
@@ -1591,248 +1281,6 @@ func expandLaunchTemplateOverrideses(tfList []interface{}) []*ec2.LaunchTemplate
 	return apiObjects
 }
 
-func expandInstanceRequirements(tfMap map[string]interface{}) *ec2.InstanceRequirements {
-	if tfMap == nil {
-		return nil
-	}
-
-	apiObject := &ec2.InstanceRequirements{}
-
-	if v, ok := tfMap["accelerator_count"].([]interface{}); ok && len(v) > 0 {
-		apiObject.AcceleratorCount = expandAcceleratorCount(v[0].(map[string]interface{}))
-	}
-
-	if v, ok := tfMap["accelerator_manufacturers"].(*schema.Set); ok && v.Len() > 0 {
-		apiObject.AcceleratorManufacturers = flex.ExpandStringSet(v)
-	}
-
-	if v, ok := tfMap["accelerator_names"].(*schema.Set); ok && v.Len() > 0 {
-		apiObject.AcceleratorNames = flex.ExpandStringSet(v)
-	}
-
-	if v, ok := tfMap["accelerator_total_memory_mib"].([]interface{}); ok && len(v) > 0 {
-		apiObject.AcceleratorTotalMemoryMiB = expandAcceleratorTotalMemoryMiB(v[0].(map[string]interface{}))
-	}
-
-	if v, ok := tfMap["accelerator_types"].(*schema.Set); ok && v.Len() > 0 {
-		apiObject.AcceleratorTypes = flex.ExpandStringSet(v)
-	}
-
-	if v, ok := tfMap["allowed_instance_types"].(*schema.Set); ok && v.Len() > 0 {
-		apiObject.AllowedInstanceTypes = flex.ExpandStringSet(v)
-	}
-
-	if v, ok := tfMap["bare_metal"].(string); ok && v != "" {
-		apiObject.BareMetal = aws.String(v)
-	}
-
-	if v, ok := tfMap["baseline_ebs_bandwidth_mbps"].([]interface{}); ok && len(v) > 0 {
-		apiObject.BaselineEbsBandwidthMbps = expandBaselineEBSBandwidthMbps(v[0].(map[string]interface{}))
-	}
-
-	if v, ok := tfMap["burstable_performance"].(string); ok && v != "" {
-		apiObject.BurstablePerformance = aws.String(v)
-	}
-
-	if v, ok := tfMap["cpu_manufacturers"].(*schema.Set); ok && v.Len() > 0 {
-		apiObject.CpuManufacturers = flex.ExpandStringSet(v)
-	}
-
-	if v, ok := tfMap["excluded_instance_types"].(*schema.Set); ok && v.Len() > 0 {
-		apiObject.ExcludedInstanceTypes = flex.ExpandStringSet(v)
-	}
-
-	if v, ok := tfMap["instance_generations"].(*schema.Set); ok && v.Len() > 0 {
-		apiObject.InstanceGenerations = flex.ExpandStringSet(v)
-	}
-
-	if v, ok := tfMap["local_storage"].(string); ok && v != "" {
-		apiObject.LocalStorage = aws.String(v)
-	}
-
-	if v, ok := tfMap["local_storage_types"].(*schema.Set); ok && v.Len() > 0 {
-		apiObject.LocalStorageTypes = flex.ExpandStringSet(v)
-	}
-
-	if v, ok := tfMap["memory_gib_per_vcpu"].([]interface{}); ok && len(v) > 0 {
-		apiObject.MemoryGiBPerVCpu = expandMemoryGiBPerVCPU(v[0].(map[string]interface{}))
-	}
-
-	if v, ok := tfMap["memory_mib"].([]interface{}); ok && len(v) > 0 {
-		apiObject.MemoryMiB = expandMemoryMiB(v[0].(map[string]interface{}))
-	}
-
-	if v, ok := tfMap["network_interface_count"].([]interface{}); ok && len(v) > 0 {
-		apiObject.NetworkInterfaceCount = expandNetworkInterfaceCount(v[0].(map[string]interface{}))
-	}
-
-	if v, ok := tfMap["on_demand_max_price_percentage_over_lowest_price"].(int); ok && v != 0 {
-		apiObject.OnDemandMaxPricePercentageOverLowestPrice = aws.Int64(int64(v))
-	}
-
-	if v, ok := tfMap["require_hibernate_support"].(bool); ok && v {
-		apiObject.RequireHibernateSupport = aws.Bool(v)
-	}
-
-	if v, ok := tfMap["spot_max_price_percentage_over_lowest_price"].(int); ok && v != 0 {
-		apiObject.SpotMaxPricePercentageOverLowestPrice = aws.Int64(int64(v))
-	}
-
-	if v, ok := tfMap["total_local_storage_gb"].([]interface{}); ok && len(v) > 0 {
-		apiObject.TotalLocalStorageGB = expandTotalLocalStorageGB(v[0].(map[string]interface{}))
-	}
-
-	if v, ok := tfMap["vcpu_count"].([]interface{}); ok && len(v) > 0 {
-		apiObject.VCpuCount = expandVCPUCountRange(v[0].(map[string]interface{}))
-	}
-
-	return apiObject
-}
-
-func expandAcceleratorCount(tfMap map[string]interface{}) *ec2.AcceleratorCount {
-	if tfMap == nil {
-		return nil
-	}
-
-	apiObject := &ec2.AcceleratorCount{}
-
-	if v, ok := tfMap["max"].(int); ok {
-		apiObject.Max = aws.Int64(int64(v))
-	}
-
-	if v, ok := tfMap["min"].(int); ok {
-		apiObject.Min = aws.Int64(int64(v))
-	}
-
-	return apiObject
-}
-
-func expandAcceleratorTotalMemoryMiB(tfMap map[string]interface{}) *ec2.AcceleratorTotalMemoryMiB {
-	if tfMap == nil {
-		return nil
-	}
-
-	apiObject := &ec2.AcceleratorTotalMemoryMiB{}
-
-	if v, ok := tfMap["max"].(int); ok {
-		apiObject.Max = aws.Int64(int64(v))
-	}
-
-	if v, ok := tfMap["min"].(int); ok {
-		apiObject.Min = aws.Int64(int64(v))
-	}
-
-	return apiObject
-}
-
-func expandBaselineEBSBandwidthMbps(tfMap map[string]interface{}) *ec2.BaselineEbsBandwidthMbps {
-	if tfMap == nil {
-		return nil
-	}
-
-	apiObject := &ec2.BaselineEbsBandwidthMbps{}
-
-	if v, ok := tfMap["max"].(int); ok {
-		apiObject.Max = aws.Int64(int64(v))
-	}
-
-	if v, ok := tfMap["min"].(int); ok {
-		apiObject.Min = aws.Int64(int64(v))
-	}
-
-	return apiObject
-}
-
-func expandMemoryGiBPerVCPU(tfMap map[string]interface{}) *ec2.MemoryGiBPerVCpu {
-	if tfMap == nil {
-		return nil
-	}
-
-	apiObject := &ec2.MemoryGiBPerVCpu{}
-
-	if v, ok := tfMap["max"].(float64); ok {
-		apiObject.Max = aws.Float64(v)
-	}
-
-	if v, ok := tfMap["min"].(float64); ok {
-		apiObject.Min = aws.Float64(v)
-	}
-
-	return apiObject
-}
-
-func expandMemoryMiB(tfMap map[string]interface{}) *ec2.MemoryMiB {
-	if tfMap == nil {
-		return nil
-	}
-
-	apiObject := &ec2.MemoryMiB{}
-
-	if v, ok := tfMap["max"].(int); ok {
-		apiObject.Max = aws.Int64(int64(v))
-	}
-
-	if v, ok := tfMap["min"].(int); ok {
-		apiObject.Min = aws.Int64(int64(v))
-	}
-
-	return apiObject
-}
-
-func expandNetworkInterfaceCount(tfMap map[string]interface{}) *ec2.NetworkInterfaceCount {
-	if tfMap == nil {
-		return nil
-	}
-
-	apiObject := &ec2.NetworkInterfaceCount{}
-
-	if v, ok := tfMap["max"].(int); ok {
-		apiObject.Max = aws.Int64(int64(v))
-	}
-
-	if v, ok := tfMap["min"].(int); ok {
-		apiObject.Min = aws.Int64(int64(v))
-	}
-
-	return apiObject
-}
-
-func expandTotalLocalStorageGB(tfMap map[string]interface{}) *ec2.TotalLocalStorageGB {
-	if tfMap == nil {
-		return nil
-	}
-
-	apiObject := &ec2.TotalLocalStorageGB{}
-
-	if v, ok := tfMap["max"].(float64); ok {
-		apiObject.Max = aws.Float64(v)
-	}
-
-	if v, ok := tfMap["min"].(float64); ok {
-		apiObject.Min = aws.Float64(v)
-	}
-
-	return apiObject
-}
-
-func expandVCPUCountRange(tfMap map[string]interface{}) *ec2.VCpuCountRange {
-	if tfMap == nil {
-		return nil
-	}
-
-	apiObject := &ec2.VCpuCountRange{}
-
-	if v, ok := tfMap["max"].(int); ok {
-		apiObject.Max = aws.Int64(int64(v))
-	}
-
-	if v, ok := tfMap["min"].(int); ok {
-		apiObject.Min = aws.Int64(int64(v))
-	}
-
-	return apiObject
-}
-
 func expandSpotMaintenanceStrategies(l []interface{}) *ec2.SpotMaintenanceStrategies {
 	if len(l) == 0 || l[0] == nil {
 		return nil
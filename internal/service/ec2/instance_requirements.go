@@ -0,0 +1,961 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// instanceRequirementsSchema returns the schema for an instance_requirements block.
+// It is shared by launch templates, EC2 Fleets and Spot Fleet requests.
+// requireMemoryAndVCPU makes memory_mib and vcpu_count, and their min values, required.
+// Spot Fleet requests have always accepted the block without them.
+// attrPath is the path of the block, used to make allowed_instance_types and excluded_instance_types mutually exclusive.
+// It must be empty if the block is nested inside a list or set, e.g. in launch template overrides.
+func instanceRequirementsSchema(forceNew, requireMemoryAndVCPU bool, attrPath string) *schema.Schema {
+	conflictsWith := func(attrName string) []string {
+		if attrPath == "" {
+			return nil
+		}
+
+		return []string{attrPath + ".0." + attrName}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: forceNew,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"accelerator_count": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: forceNew,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     forceNew,
+								ValidateFunc: validation.IntAtLeast(0),
+							},
+							"min": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     forceNew,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+						},
+					},
+				},
+				"accelerator_manufacturers": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					ForceNew: forceNew,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(ec2.AcceleratorManufacturer_Values(), false),
+					},
+				},
+				"accelerator_names": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					ForceNew: forceNew,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(ec2.AcceleratorName_Values(), false),
+					},
+				},
+				"accelerator_total_memory_mib": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: forceNew,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     forceNew,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"min": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     forceNew,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+						},
+					},
+				},
+				"accelerator_types": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					ForceNew: forceNew,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(ec2.AcceleratorType_Values(), false),
+					},
+				},
+				"allowed_instance_types": {
+					Type:          schema.TypeSet,
+					Optional:      true,
+					ForceNew:      forceNew,
+					MaxItems:      400,
+					Elem:          &schema.Schema{Type: schema.TypeString},
+					ConflictsWith: conflictsWith("excluded_instance_types"),
+				},
+				"bare_metal": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validation.StringInSlice(ec2.BareMetal_Values(), false),
+				},
+				"baseline_ebs_bandwidth_mbps": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: forceNew,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     forceNew,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"min": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     forceNew,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+						},
+					},
+				},
+				"burstable_performance": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validation.StringInSlice(ec2.BurstablePerformance_Values(), false),
+				},
+				"cpu_manufacturers": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					ForceNew: forceNew,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(ec2.CpuManufacturer_Values(), false),
+					},
+				},
+				"excluded_instance_types": {
+					Type:          schema.TypeSet,
+					Optional:      true,
+					ForceNew:      forceNew,
+					MaxItems:      400,
+					Elem:          &schema.Schema{Type: schema.TypeString},
+					ConflictsWith: conflictsWith("allowed_instance_types"),
+				},
+				"instance_generations": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					ForceNew: forceNew,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(ec2.InstanceGeneration_Values(), false),
+					},
+				},
+				"local_storage": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validation.StringInSlice(ec2.LocalStorage_Values(), false),
+				},
+				"local_storage_types": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					ForceNew: forceNew,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(ec2.LocalStorageType_Values(), false),
+					},
+				},
+				"memory_gib_per_vcpu": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: forceNew,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max": {
+								Type:         schema.TypeFloat,
+								Optional:     true,
+								ForceNew:     forceNew,
+								ValidateFunc: verify.FloatGreaterThan(0.0),
+							},
+							"min": {
+								Type:         schema.TypeFloat,
+								Optional:     true,
+								ForceNew:     forceNew,
+								ValidateFunc: verify.FloatGreaterThan(0.0),
+							},
+						},
+					},
+				},
+				"memory_mib": {
+					Type:     schema.TypeList,
+					Required: requireMemoryAndVCPU,
+					Optional: !requireMemoryAndVCPU,
+					ForceNew: forceNew,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     forceNew,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"min": {
+								Type:         schema.TypeInt,
+								Required:     requireMemoryAndVCPU,
+								Optional:     !requireMemoryAndVCPU,
+								ForceNew:     forceNew,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+						},
+					},
+				},
+				"network_bandwidth_gbps": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: forceNew,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max": {
+								Type:         schema.TypeFloat,
+								Optional:     true,
+								ForceNew:     forceNew,
+								ValidateFunc: verify.FloatGreaterThan(0.0),
+							},
+							"min": {
+								Type:         schema.TypeFloat,
+								Optional:     true,
+								ForceNew:     forceNew,
+								ValidateFunc: verify.FloatGreaterThan(0.0),
+							},
+						},
+					},
+				},
+				"network_interface_count": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: forceNew,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     forceNew,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"min": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     forceNew,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+						},
+					},
+				},
+				"on_demand_max_price_percentage_over_lowest_price": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"require_hibernate_support": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: forceNew,
+				},
+				"spot_max_price_percentage_over_lowest_price": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"total_local_storage_gb": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: forceNew,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max": {
+								Type:         schema.TypeFloat,
+								Optional:     true,
+								ForceNew:     forceNew,
+								ValidateFunc: verify.FloatGreaterThan(0.0),
+							},
+							"min": {
+								Type:         schema.TypeFloat,
+								Optional:     true,
+								ForceNew:     forceNew,
+								ValidateFunc: verify.FloatGreaterThan(0.0),
+							},
+						},
+					},
+				},
+				"vcpu_count": {
+					Type:     schema.TypeList,
+					Required: requireMemoryAndVCPU,
+					Optional: !requireMemoryAndVCPU,
+					ForceNew: forceNew,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     forceNew,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"min": {
+								Type:         schema.TypeInt,
+								Required:     requireMemoryAndVCPU,
+								Optional:     !requireMemoryAndVCPU,
+								ForceNew:     forceNew,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandInstanceRequirementsRequest(tfMap map[string]interface{}) *ec2.InstanceRequirementsRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.InstanceRequirementsRequest{}
+
+	if v, ok := tfMap["accelerator_count"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AcceleratorCount = expandAcceleratorCountRequest(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["accelerator_manufacturers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AcceleratorManufacturers = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["accelerator_names"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AcceleratorNames = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["accelerator_total_memory_mib"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AcceleratorTotalMemoryMiB = expandAcceleratorTotalMemoryMiBRequest(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["accelerator_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AcceleratorTypes = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["allowed_instance_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedInstanceTypes = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["bare_metal"].(string); ok && v != "" {
+		apiObject.BareMetal = aws.String(v)
+	}
+
+	if v, ok := tfMap["baseline_ebs_bandwidth_mbps"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.BaselineEbsBandwidthMbps = expandBaselineEBSBandwidthMbpsRequest(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["burstable_performance"].(string); ok && v != "" {
+		apiObject.BurstablePerformance = aws.String(v)
+	}
+
+	if v, ok := tfMap["cpu_manufacturers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CpuManufacturers = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["excluded_instance_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ExcludedInstanceTypes = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["instance_generations"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.InstanceGenerations = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["local_storage"].(string); ok && v != "" {
+		apiObject.LocalStorage = aws.String(v)
+	}
+
+	if v, ok := tfMap["local_storage_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LocalStorageTypes = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["memory_gib_per_vcpu"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MemoryGiBPerVCpu = expandMemoryGiBPerVCPURequest(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["memory_mib"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MemoryMiB = expandMemoryMiBRequest(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["network_bandwidth_gbps"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NetworkBandwidthGbps = expandNetworkBandwidthGbpsRequest(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["network_interface_count"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NetworkInterfaceCount = expandNetworkInterfaceCountRequest(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["on_demand_max_price_percentage_over_lowest_price"].(int); ok && v != 0 {
+		apiObject.OnDemandMaxPricePercentageOverLowestPrice = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["require_hibernate_support"].(bool); ok && v {
+		apiObject.RequireHibernateSupport = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["spot_max_price_percentage_over_lowest_price"].(int); ok && v != 0 {
+		apiObject.SpotMaxPricePercentageOverLowestPrice = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["total_local_storage_gb"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.TotalLocalStorageGB = expandTotalLocalStorageGBRequest(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["vcpu_count"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.VCpuCount = expandVCPUCountRangeRequest(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandAcceleratorCountRequest(tfMap map[string]interface{}) *ec2.AcceleratorCountRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.AcceleratorCountRequest{}
+
+	var min int
+	if v, ok := tfMap["min"].(int); ok {
+		min = v
+		apiObject.Min = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max"].(int); ok && v >= min {
+		apiObject.Max = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandAcceleratorTotalMemoryMiBRequest(tfMap map[string]interface{}) *ec2.AcceleratorTotalMemoryMiBRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.AcceleratorTotalMemoryMiBRequest{}
+
+	var min int
+	if v, ok := tfMap["min"].(int); ok {
+		min = v
+		apiObject.Min = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max"].(int); ok && v >= min {
+		apiObject.Max = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandBaselineEBSBandwidthMbpsRequest(tfMap map[string]interface{}) *ec2.BaselineEbsBandwidthMbpsRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.BaselineEbsBandwidthMbpsRequest{}
+
+	var min int
+	if v, ok := tfMap["min"].(int); ok {
+		min = v
+		apiObject.Min = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max"].(int); ok && v >= min {
+		apiObject.Max = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandMemoryGiBPerVCPURequest(tfMap map[string]interface{}) *ec2.MemoryGiBPerVCpuRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.MemoryGiBPerVCpuRequest{}
+
+	var min float64
+	if v, ok := tfMap["min"].(float64); ok {
+		min = v
+		apiObject.Min = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["max"].(float64); ok && v >= min {
+		apiObject.Max = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func expandMemoryMiBRequest(tfMap map[string]interface{}) *ec2.MemoryMiBRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.MemoryMiBRequest{}
+
+	var min int
+	if v, ok := tfMap["min"].(int); ok {
+		min = v
+		apiObject.Min = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max"].(int); ok && v >= min {
+		apiObject.Max = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandNetworkBandwidthGbpsRequest(tfMap map[string]interface{}) *ec2.NetworkBandwidthGbpsRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.NetworkBandwidthGbpsRequest{}
+
+	var min float64
+	if v, ok := tfMap["min"].(float64); ok {
+		min = v
+		apiObject.Min = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["max"].(float64); ok && v >= min {
+		apiObject.Max = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func expandNetworkInterfaceCountRequest(tfMap map[string]interface{}) *ec2.NetworkInterfaceCountRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.NetworkInterfaceCountRequest{}
+
+	var min int
+	if v, ok := tfMap["min"].(int); ok {
+		min = v
+		apiObject.Min = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max"].(int); ok && v >= min {
+		apiObject.Max = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandTotalLocalStorageGBRequest(tfMap map[string]interface{}) *ec2.TotalLocalStorageGBRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.TotalLocalStorageGBRequest{}
+
+	var min float64
+	if v, ok := tfMap["min"].(float64); ok {
+		min = v
+		apiObject.Min = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["max"].(float64); ok && v >= min {
+		apiObject.Max = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func expandVCPUCountRangeRequest(tfMap map[string]interface{}) *ec2.VCpuCountRangeRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.VCpuCountRangeRequest{}
+
+	min := 0
+	if v, ok := tfMap["min"].(int); ok {
+		min = v
+		apiObject.Min = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max"].(int); ok && v >= min {
+		apiObject.Max = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+// expandInstanceRequirements returns the Spot Fleet request form of an instance_requirements block.
+// The EC2 API uses separate, but identically shaped, types for Spot Fleet requests, so the block is
+// expanded once by expandInstanceRequirementsRequest and then copied.
+func expandInstanceRequirements(tfMap map[string]interface{}) *ec2.InstanceRequirements {
+	return instanceRequirementsFromRequest(expandInstanceRequirementsRequest(tfMap))
+}
+
+func instanceRequirementsFromRequest(r *ec2.InstanceRequirementsRequest) *ec2.InstanceRequirements {
+	if r == nil {
+		return nil
+	}
+
+	apiObject := &ec2.InstanceRequirements{
+		AcceleratorManufacturers: r.AcceleratorManufacturers,
+		AcceleratorNames:         r.AcceleratorNames,
+		AcceleratorTypes:         r.AcceleratorTypes,
+		AllowedInstanceTypes:     r.AllowedInstanceTypes,
+		BareMetal:                r.BareMetal,
+		BurstablePerformance:     r.BurstablePerformance,
+		CpuManufacturers:         r.CpuManufacturers,
+		ExcludedInstanceTypes:    r.ExcludedInstanceTypes,
+		InstanceGenerations:      r.InstanceGenerations,
+		LocalStorage:             r.LocalStorage,
+		LocalStorageTypes:        r.LocalStorageTypes,
+		OnDemandMaxPricePercentageOverLowestPrice: r.OnDemandMaxPricePercentageOverLowestPrice,
+		RequireHibernateSupport:                   r.RequireHibernateSupport,
+		SpotMaxPricePercentageOverLowestPrice:     r.SpotMaxPricePercentageOverLowestPrice,
+	}
+
+	if v := r.AcceleratorCount; v != nil {
+		apiObject.AcceleratorCount = &ec2.AcceleratorCount{Max: v.Max, Min: v.Min}
+	}
+
+	if v := r.AcceleratorTotalMemoryMiB; v != nil {
+		apiObject.AcceleratorTotalMemoryMiB = &ec2.AcceleratorTotalMemoryMiB{Max: v.Max, Min: v.Min}
+	}
+
+	if v := r.BaselineEbsBandwidthMbps; v != nil {
+		apiObject.BaselineEbsBandwidthMbps = &ec2.BaselineEbsBandwidthMbps{Max: v.Max, Min: v.Min}
+	}
+
+	if v := r.MemoryGiBPerVCpu; v != nil {
+		apiObject.MemoryGiBPerVCpu = &ec2.MemoryGiBPerVCpu{Max: v.Max, Min: v.Min}
+	}
+
+	if v := r.MemoryMiB; v != nil {
+		apiObject.MemoryMiB = &ec2.MemoryMiB{Max: v.Max, Min: v.Min}
+	}
+
+	if v := r.NetworkBandwidthGbps; v != nil {
+		apiObject.NetworkBandwidthGbps = &ec2.NetworkBandwidthGbps{Max: v.Max, Min: v.Min}
+	}
+
+	if v := r.NetworkInterfaceCount; v != nil {
+		apiObject.NetworkInterfaceCount = &ec2.NetworkInterfaceCount{Max: v.Max, Min: v.Min}
+	}
+
+	if v := r.TotalLocalStorageGB; v != nil {
+		apiObject.TotalLocalStorageGB = &ec2.TotalLocalStorageGB{Max: v.Max, Min: v.Min}
+	}
+
+	if v := r.VCpuCount; v != nil {
+		apiObject.VCpuCount = &ec2.VCpuCountRange{Max: v.Max, Min: v.Min}
+	}
+
+	return apiObject
+}
+
+func flattenInstanceRequirements(apiObject *ec2.InstanceRequirements) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AcceleratorCount; v != nil {
+		tfMap["accelerator_count"] = []interface{}{flattenAcceleratorCount(v)}
+	}
+
+	if v := apiObject.AcceleratorManufacturers; v != nil {
+		tfMap["accelerator_manufacturers"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.AcceleratorNames; v != nil {
+		tfMap["accelerator_names"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.AcceleratorTotalMemoryMiB; v != nil {
+		tfMap["accelerator_total_memory_mib"] = []interface{}{flattenAcceleratorTotalMemoryMiB(v)}
+	}
+
+	if v := apiObject.AcceleratorTypes; v != nil {
+		tfMap["accelerator_types"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.AllowedInstanceTypes; v != nil {
+		tfMap["allowed_instance_types"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.BareMetal; v != nil {
+		tfMap["bare_metal"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.BaselineEbsBandwidthMbps; v != nil {
+		tfMap["baseline_ebs_bandwidth_mbps"] = []interface{}{flattenBaselineEBSBandwidthMbps(v)}
+	}
+
+	if v := apiObject.BurstablePerformance; v != nil {
+		tfMap["burstable_performance"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.CpuManufacturers; v != nil {
+		tfMap["cpu_manufacturers"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.ExcludedInstanceTypes; v != nil {
+		tfMap["excluded_instance_types"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.InstanceGenerations; v != nil {
+		tfMap["instance_generations"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.LocalStorage; v != nil {
+		tfMap["local_storage"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.LocalStorageTypes; v != nil {
+		tfMap["local_storage_types"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.MemoryGiBPerVCpu; v != nil {
+		tfMap["memory_gib_per_vcpu"] = []interface{}{flattenMemoryGiBPerVCPU(v)}
+	}
+
+	if v := apiObject.MemoryMiB; v != nil {
+		tfMap["memory_mib"] = []interface{}{flattenMemoryMiB(v)}
+	}
+
+	if v := apiObject.NetworkBandwidthGbps; v != nil {
+		tfMap["network_bandwidth_gbps"] = []interface{}{flattenNetworkBandwidthGbps(v)}
+	}
+
+	if v := apiObject.NetworkInterfaceCount; v != nil {
+		tfMap["network_interface_count"] = []interface{}{flattenNetworkInterfaceCount(v)}
+	}
+
+	if v := apiObject.OnDemandMaxPricePercentageOverLowestPrice; v != nil {
+		tfMap["on_demand_max_price_percentage_over_lowest_price"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.RequireHibernateSupport; v != nil {
+		tfMap["require_hibernate_support"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.SpotMaxPricePercentageOverLowestPrice; v != nil {
+		tfMap["spot_max_price_percentage_over_lowest_price"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.TotalLocalStorageGB; v != nil {
+		tfMap["total_local_storage_gb"] = []interface{}{flattenTotalLocalStorageGB(v)}
+	}
+
+	if v := apiObject.VCpuCount; v != nil {
+		tfMap["vcpu_count"] = []interface{}{flattenVCPUCountRange(v)}
+	}
+
+	return tfMap
+}
+
+func flattenAcceleratorCount(apiObject *ec2.AcceleratorCount) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Max; v != nil {
+		tfMap["max"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Min; v != nil {
+		tfMap["min"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenAcceleratorTotalMemoryMiB(apiObject *ec2.AcceleratorTotalMemoryMiB) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Max; v != nil {
+		tfMap["max"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Min; v != nil {
+		tfMap["min"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenBaselineEBSBandwidthMbps(apiObject *ec2.BaselineEbsBandwidthMbps) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Max; v != nil {
+		tfMap["max"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Min; v != nil {
+		tfMap["min"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenMemoryGiBPerVCPU(apiObject *ec2.MemoryGiBPerVCpu) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Max; v != nil {
+		tfMap["max"] = aws.Float64Value(v)
+	}
+
+	if v := apiObject.Min; v != nil {
+		tfMap["min"] = aws.Float64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenMemoryMiB(apiObject *ec2.MemoryMiB) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Max; v != nil {
+		tfMap["max"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Min; v != nil {
+		tfMap["min"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenNetworkBandwidthGbps(apiObject *ec2.NetworkBandwidthGbps) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Max; v != nil {
+		tfMap["max"] = aws.Float64Value(v)
+	}
+
+	if v := apiObject.Min; v != nil {
+		tfMap["min"] = aws.Float64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenNetworkInterfaceCount(apiObject *ec2.NetworkInterfaceCount) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Max; v != nil {
+		tfMap["max"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Min; v != nil {
+		tfMap["min"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenTotalLocalStorageGB(apiObject *ec2.TotalLocalStorageGB) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Max; v != nil {
+		tfMap["max"] = aws.Float64Value(v)
+	}
+
+	if v := apiObject.Min; v != nil {
+		tfMap["min"] = aws.Float64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenVCPUCountRange(apiObject *ec2.VCpuCountRange) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Max; v != nil {
+		tfMap["max"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Min; v != nil {
+		tfMap["min"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpandInstanceRequirements(t *testing.T) {
+	t.Parallel()
+
+	tfMap := map[string]interface{}{
+		"allowed_instance_types": schema.NewSet(schema.HashString, []interface{}{"m5.*"}),
+		"memory_mib": []interface{}{map[string]interface{}{
+			"max": 0,
+			"min": 500,
+		}},
+		"network_bandwidth_gbps": []interface{}{map[string]interface{}{
+			"max": 10.0,
+			"min": 1.5,
+		}},
+		"vcpu_count": []interface{}{map[string]interface{}{
+			"max": 8,
+			"min": 2,
+		}},
+	}
+
+	got := expandInstanceRequirements(tfMap)
+	want := &ec2.InstanceRequirements{
+		AllowedInstanceTypes: aws.StringSlice([]string{"m5.*"}),
+		MemoryMiB: &ec2.MemoryMiB{
+			Min: aws.Int64(500),
+		},
+		NetworkBandwidthGbps: &ec2.NetworkBandwidthGbps{
+			Max: aws.Float64(10.0),
+			Min: aws.Float64(1.5),
+		},
+		VCpuCount: &ec2.VCpuCountRange{
+			Max: aws.Int64(8),
+			Min: aws.Int64(2),
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
@@ -332,6 +332,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceSubnets,
 			TypeName: "aws_subnets",
 		},
+		{
+			Factory:  DataSourceVerifiedAccessInstanceLoggingConfiguration,
+			TypeName: "aws_verifiedaccess_instance_logging_configuration",
+		},
 		{
 			Factory:  DataSourceVPC,
 			TypeName: "aws_vpc",
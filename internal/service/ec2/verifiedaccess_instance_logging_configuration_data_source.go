@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_verifiedaccess_instance_logging_configuration")
+func DataSourceVerifiedAccessInstanceLoggingConfiguration() *schema.Resource {
+	deliveryStatusSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"code": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"message": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceVerifiedAccessInstanceLoggingConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"access_logs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_logs": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delivery_status": deliveryStatusSchema(),
+									"enabled": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"log_group": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"include_trust_context": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"kinesis_data_firehose": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delivery_status": deliveryStatusSchema(),
+									"delivery_stream": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"enabled": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"log_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"s3": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"bucket_owner": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"delivery_status": deliveryStatusSchema(),
+									"enabled": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"verifiedaccess_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceVerifiedAccessInstanceLoggingConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	id := d.Get("verifiedaccess_instance_id").(string)
+	output, err := FindVerifiedAccessInstanceLoggingConfigurationByInstanceID(ctx, conn, id)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("EC2 Verified Access Instance Logging Configuration", err))
+	}
+
+	d.SetId(id)
+	if err := d.Set("access_logs", []interface{}{flattenVerifiedAccessLogs(output.AccessLogs)}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting access_logs: %s", err)
+	}
+	d.Set("verifiedaccess_instance_id", output.VerifiedAccessInstanceId)
+
+	return diags
+}
+
+func flattenVerifiedAccessLogs(apiObject *ec2.VerifiedAccessLogs) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"include_trust_context": aws.BoolValue(apiObject.IncludeTrustContext),
+		"log_version":           aws.StringValue(apiObject.LogVersion),
+	}
+
+	if v := apiObject.CloudWatchLogs; v != nil {
+		tfMap["cloudwatch_logs"] = []interface{}{map[string]interface{}{
+			"delivery_status": flattenVerifiedAccessLogDeliveryStatus(v.DeliveryStatus),
+			"enabled":         aws.BoolValue(v.Enabled),
+			"log_group":       aws.StringValue(v.LogGroup),
+		}}
+	}
+
+	if v := apiObject.KinesisDataFirehose; v != nil {
+		tfMap["kinesis_data_firehose"] = []interface{}{map[string]interface{}{
+			"delivery_status": flattenVerifiedAccessLogDeliveryStatus(v.DeliveryStatus),
+			"delivery_stream": aws.StringValue(v.DeliveryStream),
+			"enabled":         aws.BoolValue(v.Enabled),
+		}}
+	}
+
+	if v := apiObject.S3; v != nil {
+		tfMap["s3"] = []interface{}{map[string]interface{}{
+			"bucket_name":     aws.StringValue(v.BucketName),
+			"bucket_owner":    aws.StringValue(v.BucketOwner),
+			"delivery_status": flattenVerifiedAccessLogDeliveryStatus(v.DeliveryStatus),
+			"enabled":         aws.BoolValue(v.Enabled),
+			"prefix":          aws.StringValue(v.Prefix),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenVerifiedAccessLogDeliveryStatus(apiObject *ec2.VerifiedAccessLogDeliveryStatus) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"code":    aws.StringValue(apiObject.Code),
+		"message": aws.StringValue(apiObject.Message),
+	}}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccVerifiedAccessInstanceLoggingConfigurationDataSource_nonExistent(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVerifiedAccessInstanceLoggingConfigurationDataSourceConfig_nonExistent,
+				ExpectError: regexp.MustCompile(`no matching EC2 Verified Access Instance Logging Configuration found`),
+			},
+		},
+	})
+}
+
+const testAccVerifiedAccessInstanceLoggingConfigurationDataSourceConfig_nonExistent = `
+data "aws_verifiedaccess_instance_logging_configuration" "test" {
+  verifiedaccess_instance_id = "vai-00000000000000000"
+}
+`
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -187,7 +188,10 @@ func ResourceVPCEndpoint() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceVPCEndpointCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -492,8 +496,33 @@ func vpcEndpointAccept(ctx context.Context, conn *ec2.EC2, vpceID, serviceName s
 	return nil
 }
 
+func resourceVPCEndpointCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("dns_options.0.private_dns_only_for_inbound_resolver_endpoint").(bool) {
+		return nil
+	}
+
+	if !diff.NewValueKnown("service_name") || !diff.NewValueKnown("vpc_endpoint_type") || !diff.NewValueKnown("private_dns_enabled") {
+		return nil
+	}
+
+	// https://docs.aws.amazon.com/AmazonS3/latest/userguide/privatelink-interface-endpoints.html#private-dns.
+	if serviceName := diff.Get("service_name").(string); !isAmazonS3VPCEndpoint(serviceName) {
+		return fmt.Errorf("dns_options.0.private_dns_only_for_inbound_resolver_endpoint is not supported for service %s, only Amazon S3 supports it", serviceName)
+	}
+
+	if v := diff.Get("vpc_endpoint_type").(string); v != ec2.VpcEndpointTypeInterface {
+		return fmt.Errorf("dns_options.0.private_dns_only_for_inbound_resolver_endpoint requires vpc_endpoint_type to be %s, got %s", ec2.VpcEndpointTypeInterface, v)
+	}
+
+	if !diff.Get("private_dns_enabled").(bool) {
+		return fmt.Errorf("dns_options.0.private_dns_only_for_inbound_resolver_endpoint requires private_dns_enabled to be true")
+	}
+
+	return nil
+}
+
 func isAmazonS3VPCEndpoint(serviceName string) bool {
-	ok, _ := regexp.MatchString("com\\.amazonaws\\.([a-z]+\\-[a-z]+\\-[0-9])\\.s3", serviceName)
+	ok, _ := regexp.MatchString("com\\.amazonaws\\.([a-z]+(\\-[a-z]+)+\\-[0-9])\\.s3", serviceName)
 	return ok
 }
 
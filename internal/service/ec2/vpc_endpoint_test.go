@@ -185,6 +185,32 @@ func TestAccVPCEndpoint_interfacePrivateDNSNoGateway(t *testing.T) {
 	})
 }
 
+func TestAccVPCEndpoint_interfacePrivateDNSOnlyValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCEndpointConfig_interfacePrivateDNSOnly(rName, "ec2", "Interface", true),
+				ExpectError: regexp.MustCompile(`private_dns_only_for_inbound_resolver_endpoint is not supported for service`),
+			},
+			{
+				Config:      testAccVPCEndpointConfig_interfacePrivateDNSOnly(rName, "s3", "Gateway", true),
+				ExpectError: regexp.MustCompile(`private_dns_only_for_inbound_resolver_endpoint requires vpc_endpoint_type to be Interface`),
+			},
+			{
+				Config:      testAccVPCEndpointConfig_interfacePrivateDNSOnly(rName, "s3", "Interface", false),
+				ExpectError: regexp.MustCompile(`private_dns_only_for_inbound_resolver_endpoint requires private_dns_enabled to be true`),
+			},
+		},
+	})
+}
+
 func TestAccVPCEndpoint_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var endpoint ec2.VpcEndpoint
@@ -856,6 +882,37 @@ resource "aws_vpc_endpoint" "test" {
 `, rName, privateDNSOnlyForInboundResolverEndpoint)
 }
 
+func testAccVPCEndpointConfig_interfacePrivateDNSOnly(rName, service, endpointType string, privateDNSEnabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block           = "10.0.0.0/16"
+  enable_dns_support   = true
+  enable_dns_hostnames = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_region" "current" {}
+
+resource "aws_vpc_endpoint" "test" {
+  vpc_id              = aws_vpc.test.id
+  service_name        = "com.amazonaws.${data.aws_region.current.name}.%[2]s"
+  private_dns_enabled = %[4]t
+  vpc_endpoint_type   = %[3]q
+
+  dns_options {
+    private_dns_only_for_inbound_resolver_endpoint = true
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, service, endpointType, privateDNSEnabled)
+}
+
 func testAccVPCEndpointConfig_ipAddressType(rName, addressType string) string {
 	return acctest.ConfigCompose(testAccVPCEndpointServiceConfig_baseSupportedIPAddressTypes(rName), fmt.Sprintf(`
 resource "aws_vpc_endpoint_service" "test" {
@@ -19,6 +19,7 @@ import (
 )
 
 func TestAccVPCNATGateway_basic(t *testing.T) {
+	acctest.Expensive(t, acctest.CostNATGateway)
 	ctx := acctest.Context(t)
 	var natGateway ec2.NatGateway
 	resourceName := "aws_nat_gateway.test"
@@ -53,6 +54,7 @@ func TestAccVPCNATGateway_basic(t *testing.T) {
 }
 
 func TestAccVPCNATGateway_disappears(t *testing.T) {
+	acctest.Expensive(t, acctest.CostNATGateway)
 	ctx := acctest.Context(t)
 	var natGateway ec2.NatGateway
 	resourceName := "aws_nat_gateway.test"
@@ -77,6 +79,7 @@ func TestAccVPCNATGateway_disappears(t *testing.T) {
 }
 
 func TestAccVPCNATGateway_ConnectivityType_private(t *testing.T) {
+	acctest.Expensive(t, acctest.CostNATGateway)
 	ctx := acctest.Context(t)
 	var natGateway ec2.NatGateway
 	resourceName := "aws_nat_gateway.test"
@@ -112,6 +115,7 @@ func TestAccVPCNATGateway_ConnectivityType_private(t *testing.T) {
 }
 
 func TestAccVPCNATGateway_privateIP(t *testing.T) {
+	acctest.Expensive(t, acctest.CostNATGateway)
 	ctx := acctest.Context(t)
 	var natGateway ec2.NatGateway
 	resourceName := "aws_nat_gateway.test"
@@ -145,6 +149,7 @@ func TestAccVPCNATGateway_privateIP(t *testing.T) {
 }
 
 func TestAccVPCNATGateway_tags(t *testing.T) {
+	acctest.Expensive(t, acctest.CostNATGateway)
 	ctx := acctest.Context(t)
 	var natGateway ec2.NatGateway
 	resourceName := "aws_nat_gateway.test"
@@ -123,6 +123,10 @@ func ResourceVPNConnection() *schema.Resource {
 					},
 				},
 			},
+			"skip_tunnel_replacement": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"static_routes_only": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -917,6 +921,7 @@ func resourceVPNConnectionUpdate(ctx context.Context, d *schema.ResourceData, me
 	for i, prefix := range []string{"tunnel1_", "tunnel2_"} {
 		if options, address := expandModifyVPNTunnelOptionsSpecification(d, prefix), d.Get(prefix+"address").(string); options != nil && address != "" {
 			input := &ec2.ModifyVpnTunnelOptionsInput{
+				SkipTunnelReplacement:     aws.Bool(d.Get("skip_tunnel_replacement").(bool)),
 				TunnelOptions:             options,
 				VpnConnectionId:           aws.String(d.Id()),
 				VpnTunnelOutsideIpAddress: aws.String(address),
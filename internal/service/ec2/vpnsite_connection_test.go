@@ -1208,6 +1208,40 @@ func TestAccSiteVPNConnection_tunnelOptionsLesser(t *testing.T) {
 	})
 }
 
+func TestAccSiteVPNConnection_skipTunnelReplacement(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
+	resourceName := "aws_vpn_connection.test"
+	var vpn1, vpn2 ec2.VpnConnection
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPNConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSiteVPNConnectionConfig_skipTunnelReplacement(rName, rBgpAsn, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccVPNConnectionExists(ctx, resourceName, &vpn1),
+					resource.TestCheckResourceAttr(resourceName, "skip_tunnel_replacement", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_dpd_timeout_seconds", "30"),
+				),
+			},
+			{
+				Config: testAccSiteVPNConnectionConfig_skipTunnelReplacement(rName, rBgpAsn, 40),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccVPNConnectionExists(ctx, resourceName, &vpn2),
+					testAccCheckVPNConnectionNotRecreated(&vpn1, &vpn2),
+					resource.TestCheckResourceAttr(resourceName, "skip_tunnel_replacement", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_dpd_timeout_seconds", "40"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSiteVPNConnection_staticRoutes(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -2189,6 +2223,40 @@ resource "aws_vpn_connection" "test" {
 `, rName, rBgpAsn, localIpv6NetworkCidr, remoteIpv6NetworkCidr, tunnel1InsideIpv6Cidr, tunnel2InsideIpv6Cidr)
 }
 
+func testAccSiteVPNConnectionConfig_skipTunnelReplacement(rName string, rBgpAsn, dpdTimeoutSeconds int) string {
+	return fmt.Sprintf(`
+resource "aws_vpn_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_customer_gateway" "test" {
+  bgp_asn    = %[2]d
+  ip_address = "178.0.0.1"
+  type       = "ipsec.1"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpn_connection" "test" {
+  vpn_gateway_id      = aws_vpn_gateway.test.id
+  customer_gateway_id = aws_customer_gateway.test.id
+  type                = "ipsec.1"
+  static_routes_only  = false
+
+  skip_tunnel_replacement     = true
+  tunnel1_dpd_timeout_seconds = %[3]d
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, rBgpAsn, dpdTimeoutSeconds)
+}
+
 func testAccSiteVPNConnectionConfig_singleTunnelOptions(rName string, rBgpAsn int, psk string, tunnelCidr string) string {
 	return fmt.Sprintf(`
 resource "aws_vpn_gateway" "test" {
@@ -49,6 +49,10 @@ func ResourcePullThroughCacheRule() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
+				// The upstream registry is identified by its hostname, e.g. "public.ecr.aws", without a scheme or path.
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[0-9a-z]([0-9a-z-]*[0-9a-z])?(\.[0-9a-z]([0-9a-z-]*[0-9a-z])?)+$`),
+					"must be the hostname of the upstream registry, without a scheme or path (e.g. public.ecr.aws)"),
 			},
 		},
 	}
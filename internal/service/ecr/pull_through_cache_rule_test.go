@@ -97,6 +97,28 @@ func TestAccECRPullThroughCacheRule_failWhenAlreadyExists(t *testing.T) {
 	})
 }
 
+func TestAccECRPullThroughCacheRule_invalidUpstreamRegistryURL(t *testing.T) {
+	ctx := acctest.Context(t)
+	repositoryPrefix := "tf-test-" + sdkacctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPullThroughCacheRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPullThroughCacheRuleConfig_upstreamRegistryURL(repositoryPrefix, "https://public.ecr.aws"),
+				ExpectError: regexp.MustCompile(`must be the hostname of the upstream registry`),
+			},
+			{
+				Config:      testAccPullThroughCacheRuleConfig_upstreamRegistryURL(repositoryPrefix, "quay.io/v2"),
+				ExpectError: regexp.MustCompile(`must be the hostname of the upstream registry`),
+			},
+		},
+	})
+}
+
 func testAccCheckPullThroughCacheRuleDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRConn(ctx)
@@ -172,3 +194,12 @@ resource "aws_ecr_pull_through_cache_rule" "duplicate" {
 }
 `, repositoryPrefix)
 }
+
+func testAccPullThroughCacheRuleConfig_upstreamRegistryURL(repositoryPrefix, upstreamRegistryURL string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_pull_through_cache_rule" "test" {
+  ecr_repository_prefix = %[1]q
+  upstream_registry_url = %[2]q
+}
+`, repositoryPrefix, upstreamRegistryURL)
+}
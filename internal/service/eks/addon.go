@@ -5,6 +5,7 @@ package eks
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"regexp"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -37,7 +39,10 @@ func ResourceAddon() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			addonConfigurationValuesCustomizeDiff,
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
@@ -325,3 +330,72 @@ func resourceAddonDelete(ctx context.Context, d *schema.ResourceData, meta inter
 
 	return diags
 }
+
+// addonConfigurationValuesCustomizeDiff validates configuration_values against the add-on version's
+// configuration schema so that invalid values are reported at plan time rather than by the EKS API during apply.
+func addonConfigurationValuesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("addon_version", "configuration_values") {
+		return nil
+	}
+
+	for _, k := range []string{"addon_name", "addon_version", "cluster_name", "configuration_values"} {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+
+	configurationValues := d.Get("configuration_values").(string)
+
+	if configurationValues == "" {
+		return nil
+	}
+
+	// Validation is best effort. Lookup failures, such as AccessDenied for a plan role
+	// without the Describe* permissions, or throttling, skip validation rather than fail the plan.
+	conn := meta.(*conns.AWSClient).EKSConn(ctx)
+	addonName := d.Get("addon_name").(string)
+	addonVersion := d.Get("addon_version").(string)
+
+	if addonVersion == "" {
+		// The add-on's default version for the cluster's Kubernetes version is used.
+		clusterName := d.Get("cluster_name").(string)
+		cluster, err := FindClusterByName(ctx, conn, clusterName)
+
+		if tfresource.NotFound(err) {
+			// The cluster is being created in the same plan.
+			return nil
+		}
+
+		if err != nil {
+			log.Printf("[WARN] Skipping EKS Add-On (%s) configuration_values validation, reading EKS Cluster (%s): %s", addonName, clusterName, err)
+			return nil
+		}
+
+		versionInfo, err := FindAddonVersionByAddonNameAndKubernetesVersion(ctx, conn, addonName, aws.StringValue(cluster.Version), false)
+
+		if err != nil {
+			log.Printf("[WARN] Skipping EKS Add-On (%s) configuration_values validation, reading version info (%s): %s", addonName, aws.StringValue(cluster.Version), err)
+			return nil
+		}
+
+		addonVersion = aws.StringValue(versionInfo.AddonVersion)
+	}
+
+	configurationSchema, err := FindAddonConfigurationSchemaByAddonNameAndAddonVersion(ctx, conn, addonName, addonVersion)
+
+	if tfresource.NotFound(err) {
+		// The add-on version does not support configuration values, or does not exist. The EKS API reports either case.
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[WARN] Skipping EKS Add-On (%s) version (%s) configuration_values validation, reading configuration schema: %s", addonName, addonVersion, err)
+		return nil
+	}
+
+	if err := validateAddonConfigurationValues(configurationSchema, configurationValues); err != nil {
+		return fmt.Errorf("configuration_values are not valid for EKS Add-On (%s) version (%s): %w", addonName, addonVersion, err)
+	}
+
+	return nil
+}
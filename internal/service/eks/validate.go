@@ -4,8 +4,13 @@
 package eks
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+	"gopkg.in/yaml.v3"
 )
 
 func validClusterName(v interface{}, k string) (ws []string, errors []error) {
@@ -25,3 +30,30 @@ func validClusterName(v interface{}, k string) (ws []string, errors []error) {
 
 	return
 }
+
+// validateAddonConfigurationValues validates add-on configuration values, in JSON or YAML format,
+// against the add-on version's JSON schema.
+func validateAddonConfigurationValues(configurationSchema, configurationValues string) error {
+	// JSON is valid YAML.
+	var v interface{}
+	if err := yaml.Unmarshal([]byte(configurationValues), &v); err != nil {
+		return fmt.Errorf("parsing configuration values: %w", err)
+	}
+
+	result, err := gojsonschema.Validate(gojsonschema.NewStringLoader(configurationSchema), gojsonschema.NewGoLoader(v))
+
+	if err != nil {
+		return fmt.Errorf("validating configuration values: %w", err)
+	}
+
+	if result.Valid() {
+		return nil
+	}
+
+	var errs []string
+	for _, err := range result.Errors() {
+		errs = append(errs, err.String())
+	}
+
+	return errors.New(strings.Join(errs, "; "))
+}
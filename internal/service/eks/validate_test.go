@@ -62,3 +62,64 @@ func TestValidClusterName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateAddonConfigurationValues(t *testing.T) {
+	t.Parallel()
+
+	configurationSchema := `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "additionalProperties": false,
+  "properties": {
+    "env": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "WARM_ENI_TARGET": {"type": "string"}
+      }
+    },
+    "replicaCount": {"type": "integer"}
+  }
+}`
+
+	testCases := map[string]struct {
+		configurationValues string
+		expectError         bool
+	}{
+		"valid JSON": {
+			configurationValues: `{"env": {"WARM_ENI_TARGET": "2"}, "replicaCount": 2}`,
+		},
+		"valid YAML": {
+			configurationValues: "env:\n  WARM_ENI_TARGET: \"2\"\nreplicaCount: 2\n",
+		},
+		"empty": {
+			configurationValues: `{}`,
+		},
+		"unknown property": {
+			configurationValues: `{"env": {"INVALID_FIELD": "2"}}`,
+			expectError:         true,
+		},
+		"wrong type": {
+			configurationValues: `{"replicaCount": "two"}`,
+			expectError:         true,
+		},
+		"not JSON or YAML": {
+			configurationValues: `{"env": `,
+			expectError:         true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateAddonConfigurationValues(configurationSchema, testCase.configurationValues)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("validateAddonConfigurationValues() error = %v, want error = %t", err, want)
+			}
+		})
+	}
+}
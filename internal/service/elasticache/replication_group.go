@@ -83,6 +83,12 @@ func ResourceReplicationGroup() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"cluster_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(elasticache.ClusterMode_Values(), false),
+			},
 			"configuration_endpoint_address": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -346,6 +352,9 @@ func ResourceReplicationGroup() *schema.Resource {
 		CustomizeDiff: customdiff.Sequence(
 			CustomizeDiffValidateReplicationGroupAutomaticFailover,
 			customizeDiffEngineVersionForceNewOnDowngrade,
+			customdiff.ComputedIf("cluster_enabled", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("cluster_mode")
+			}),
 			customdiff.ComputedIf("member_clusters", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("num_cache_clusters") ||
 					diff.HasChange("num_node_groups") ||
@@ -370,6 +379,10 @@ func resourceReplicationGroupCreate(ctx context.Context, d *schema.ResourceData,
 		input.ReplicationGroupDescription = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("cluster_mode"); ok {
+		input.ClusterMode = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("data_tiering_enabled"); ok {
 		input.DataTieringEnabled = aws.Bool(v.(bool))
 	}
@@ -593,6 +606,7 @@ func resourceReplicationGroupRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set("replicas_per_node_group", len(rgp.NodeGroups[0].NodeGroupMembers)-1)
 
 	d.Set("cluster_enabled", rgp.ClusterEnabled)
+	d.Set("cluster_mode", rgp.ClusterMode)
 	d.Set("replication_group_id", rgp.ReplicationGroupId)
 	d.Set("arn", rgp.ARN)
 	d.Set("data_tiering_enabled", aws.StringValue(rgp.DataTiering) == elasticache.DataTieringStatusEnabled)
@@ -760,7 +774,8 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 			requestUpdate = true
 		}
 
-		if d.HasChange("parameter_group_name") {
+		// A change of parameter group that accompanies a change of cluster mode is applied with the first cluster mode change.
+		if d.HasChange("parameter_group_name") && !d.HasChange("cluster_mode") {
 			input.CacheParameterGroupName = aws.String(d.Get("parameter_group_name").(string))
 			requestUpdate = true
 		}
@@ -821,6 +836,12 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 			}
 		}
 
+		if d.HasChange("cluster_mode") {
+			if err := modifyReplicationGroupClusterMode(ctx, conn, d); err != nil {
+				return sdkdiag.AppendErrorf(diags, "modifying ElastiCache Replication Group (%s) cluster mode: %s", d.Id(), err)
+			}
+		}
+
 		if d.HasChange("auth_token") {
 			params := &elasticache.ModifyReplicationGroupInput{
 				ApplyImmediately:        aws.Bool(true),
@@ -1053,6 +1074,52 @@ func modifyReplicationGroupShardConfigurationReplicasPerNodeGroup(ctx context.Co
 	return nil
 }
 
+// modifyReplicationGroupClusterMode changes the cluster mode of a replication group online.
+// A replication group cannot move directly from cluster mode disabled to cluster mode enabled;
+// it must first be configured as compatible, so that clients can migrate, and then as enabled.
+func modifyReplicationGroupClusterMode(ctx context.Context, conn *elasticache.ElastiCache, d *schema.ResourceData) error {
+	o, n := d.GetChange("cluster_mode")
+	oldClusterMode, newClusterMode := o.(string), n.(string)
+
+	if newClusterMode == "" {
+		return nil
+	}
+
+	clusterModes := []string{newClusterMode}
+	if oldClusterMode == elasticache.ClusterModeDisabled && newClusterMode == elasticache.ClusterModeEnabled {
+		clusterModes = []string{elasticache.ClusterModeCompatible, elasticache.ClusterModeEnabled}
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	for i, clusterMode := range clusterModes {
+		input := &elasticache.ModifyReplicationGroupInput{
+			ApplyImmediately:   aws.Bool(true),
+			ClusterMode:        aws.String(clusterMode),
+			ReplicationGroupId: aws.String(d.Id()),
+		}
+
+		if i == 0 && d.HasChange("parameter_group_name") {
+			input.CacheParameterGroupName = aws.String(d.Get("parameter_group_name").(string))
+		}
+
+		log.Printf("[DEBUG] Modifying ElastiCache Replication Group (%s) cluster mode to %s", d.Id(), clusterMode)
+		if _, err := conn.ModifyReplicationGroupWithContext(ctx, input); err != nil {
+			return fmt.Errorf("setting cluster mode to %s: %w", clusterMode, err)
+		}
+
+		if _, err := WaitReplicationGroupAvailable(ctx, conn, d.Id(), timeout); err != nil {
+			return fmt.Errorf("waiting for cluster mode %s: %w", clusterMode, err)
+		}
+
+		if _, err := waitReplicationGroupClusterModeUpdated(ctx, conn, d.Id(), clusterMode, timeout); err != nil {
+			return fmt.Errorf("waiting for cluster mode %s: %w", clusterMode, err)
+		}
+	}
+
+	return nil
+}
+
 func modifyReplicationGroupNumCacheClusters(ctx context.Context, conn *elasticache.ElastiCache, d *schema.ResourceData, argument string) error {
 	o, n := d.GetChange(argument)
 	oldNumberCacheClusters := o.(int)
@@ -36,6 +36,25 @@ func StatusReplicationGroup(ctx context.Context, conn *elasticache.ElastiCache,
 	}
 }
 
+// statusReplicationGroupClusterMode fetches the Replication Group and its cluster mode
+func statusReplicationGroupClusterMode(ctx context.Context, conn *elasticache.ElastiCache, replicationGroupID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		rg, err := FindReplicationGroupByID(ctx, conn, replicationGroupID)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		if rg.PendingModifiedValues != nil && rg.PendingModifiedValues.ClusterMode != nil {
+			return rg, ReplicationGroupStatusModifying, nil
+		}
+
+		return rg, aws.StringValue(rg.ClusterMode), nil
+	}
+}
+
 // StatusReplicationGroupMemberClusters fetches the Replication Group's Member Clusters and either "available" or the first non-"available" status.
 // NOTE: This function assumes that the intended end-state is to have all member clusters in "available" status.
 func StatusReplicationGroupMemberClusters(ctx context.Context, conn *elasticache.ElastiCache, replicationGroupID string) retry.StateRefreshFunc {
@@ -45,6 +45,30 @@ func WaitReplicationGroupAvailable(ctx context.Context, conn *elasticache.Elasti
 	return nil, err
 }
 
+// waitReplicationGroupClusterModeUpdated waits for a ReplicationGroup's cluster mode to be updated
+func waitReplicationGroupClusterModeUpdated(ctx context.Context, conn *elasticache.ElastiCache, replicationGroupID, clusterMode string, timeout time.Duration) (*elasticache.ReplicationGroup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{ReplicationGroupStatusModifying},
+		Target:     []string{clusterMode},
+		Refresh:    statusReplicationGroupClusterMode(ctx, conn, replicationGroupID),
+		Timeout:    timeout,
+		MinTimeout: replicationGroupAvailableMinTimeout,
+		Delay:      replicationGroupAvailableDelay,
+	}
+
+	for _, v := range elasticache.ClusterMode_Values() {
+		if v != clusterMode {
+			stateConf.Pending = append(stateConf.Pending, v)
+		}
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if v, ok := outputRaw.(*elasticache.ReplicationGroup); ok {
+		return v, err
+	}
+	return nil, err
+}
+
 // WaitReplicationGroupDeleted waits for a ReplicationGroup to be deleted
 func WaitReplicationGroupDeleted(ctx context.Context, conn *elasticache.ElastiCache, replicationGroupID string, timeout time.Duration) (*elasticache.ReplicationGroup, error) {
 	stateConf := &retry.StateChangeConf{
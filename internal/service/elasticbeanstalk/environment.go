@@ -9,6 +9,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"log"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -16,6 +17,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -25,6 +27,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdktypes"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -74,6 +77,21 @@ const (
 	environmentTierTypeStandard = "Standard"
 )
 
+const (
+	optionSettingNamespaceEC2Instances            = "aws:ec2:instances"
+	optionSettingNamespaceELBV2LoadBalancer       = "aws:elbv2:loadbalancer"
+	optionSettingNamespaceEnvironment             = "aws:elasticbeanstalk:environment"
+	optionSettingNameEnableSpot                   = "EnableSpot"
+	optionSettingNameInstanceTypes                = "InstanceTypes"
+	optionSettingNameLoadBalancerIsShared         = "LoadBalancerIsShared"
+	optionSettingNameLoadBalancerType             = "LoadBalancerType"
+	optionSettingNameSharedLoadBalancer           = "SharedLoadBalancer"
+	optionSettingNameSpotFleetOnDemandAboveBase   = "SpotFleetOnDemandAboveBasePercentage"
+	optionSettingNameSpotFleetOnDemandBase        = "SpotFleetOnDemandBase"
+	optionSettingNameSpotMaxPrice                 = "SpotMaxPrice"
+	optionSettingValueLoadBalancerTypeApplication = "application"
+)
+
 var (
 	environmentCNAMERegex = regexp.MustCompile(`(^[^.]+)(.\w{2}-\w{4,9}-\d)?\.(elasticbeanstalk\.com|eb\.amazonaws\.com\.cn)$`)
 )
@@ -177,12 +195,49 @@ func ResourceEnvironment() *schema.Resource {
 				Elem:     settingSchema(),
 				Set:      optionSettingValueHash,
 			},
+			"shared_load_balancer_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"solution_stack_name": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				Computed:      true,
 				ConflictsWith: []string{"platform_arn", "template_name"},
 			},
+			"spot": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_types": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"max_price": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"on_demand_above_base_percentage": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"on_demand_base_capacity": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"template_name": {
@@ -229,6 +284,14 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta
 		Tags:            getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("shared_load_balancer_arn"); ok {
+		input.OptionSettings = append(input.OptionSettings, expandSharedLoadBalancerOptionSettings(v.(string))...)
+	}
+
+	if v, ok := d.GetOk("spot"); ok && len(v.([]interface{})) > 0 {
+		input.OptionSettings = append(input.OptionSettings, expandSpotOptionSettings(v.([]interface{}), d.GetRawConfig().GetAttr("spot"))...)
+	}
+
 	if v := d.Get("description"); v.(string) != "" {
 		input.Description = aws.String(v.(string))
 	}
@@ -372,7 +435,11 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta i
 	if err := d.Set("queues", flattenQueues(resources.EnvironmentResources.Queues)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting queues: %s", err)
 	}
+	d.Set("shared_load_balancer_arn", flattenSharedLoadBalancerOptionSettings(configurationSettings.OptionSettings))
 	d.Set("solution_stack_name", env.SolutionStackName)
+	if err := d.Set("spot", flattenSpotOptionSettings(configurationSettings.OptionSettings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting spot: %s", err)
+	}
 	d.Set("tier", env.Tier.Name)
 	if err := d.Set("triggers", flattenTriggers(resources.EnvironmentResources.Triggers)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting triggers: %s", err)
@@ -525,6 +592,10 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.OptionSettings = add
 		}
 
+		if d.HasChange("spot") {
+			input.OptionSettings = append(input.OptionSettings, expandSpotOptionSettings(d.Get("spot").([]interface{}), d.GetRawConfig().GetAttr("spot"))...)
+		}
+
 		if d.HasChange("platform_arn") {
 			if v, ok := d.GetOk("platform_arn"); ok {
 				input.PlatformArn = aws.String(v.(string))
@@ -783,6 +854,7 @@ func optionSettingValueHash(v interface{}) int {
 	}
 	value, _ := rd["value"].(string)
 	value, _ = structure.NormalizeJsonString(value)
+	value = normalizeBooleanValue(value)
 	hk := fmt.Sprintf("%s:%s%s=%s", namespace, optionName, resourceName, sortValues(value))
 	log.Printf("[DEBUG] Elastic Beanstalk optionSettingValueHash(%#v): %s: hk=%s,hc=%d", v, optionName, hk, create.StringHashcode(hk))
 	return create.StringHashcode(hk)
@@ -801,6 +873,15 @@ func optionSettingKeyHash(v interface{}) int {
 	return create.StringHashcode(hk)
 }
 
+// normalizeBooleanValue returns boolean option values in the lower case form returned by the API.
+func normalizeBooleanValue(v string) string {
+	if strings.EqualFold(v, "true") || strings.EqualFold(v, "false") {
+		return strings.ToLower(v)
+	}
+
+	return v
+}
+
 func sortValues(v string) string {
 	values := strings.Split(v, ",")
 	sort.Strings(values)
@@ -829,6 +910,129 @@ func extractOptionSettings(s *schema.Set) []*elasticbeanstalk.ConfigurationOptio
 	return settings
 }
 
+func expandSharedLoadBalancerOptionSettings(arn string) []*elasticbeanstalk.ConfigurationOptionSetting {
+	return []*elasticbeanstalk.ConfigurationOptionSetting{
+		{
+			Namespace:  aws.String(optionSettingNamespaceEnvironment),
+			OptionName: aws.String(optionSettingNameLoadBalancerType),
+			Value:      aws.String(optionSettingValueLoadBalancerTypeApplication),
+		},
+		{
+			Namespace:  aws.String(optionSettingNamespaceEnvironment),
+			OptionName: aws.String(optionSettingNameLoadBalancerIsShared),
+			Value:      aws.String("true"),
+		},
+		{
+			Namespace:  aws.String(optionSettingNamespaceELBV2LoadBalancer),
+			OptionName: aws.String(optionSettingNameSharedLoadBalancer),
+			Value:      aws.String(arn),
+		},
+	}
+}
+
+func flattenSharedLoadBalancerOptionSettings(apiObjects []*elasticbeanstalk.ConfigurationOptionSetting) string {
+	if !strings.EqualFold(optionSettingValue(apiObjects, optionSettingNamespaceEnvironment, optionSettingNameLoadBalancerIsShared), "true") {
+		return ""
+	}
+
+	return optionSettingValue(apiObjects, optionSettingNamespaceELBV2LoadBalancer, optionSettingNameSharedLoadBalancer)
+}
+
+// expandSpotOptionSettings returns the option settings for the spot configuration block.
+// An empty configuration block list disables Spot Instances.
+// On-Demand capacity is only sent if configured, as its default depends on the environment type.
+func expandSpotOptionSettings(tfList []interface{}, rawConfig cty.Value) []*elasticbeanstalk.ConfigurationOptionSetting {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return []*elasticbeanstalk.ConfigurationOptionSetting{
+			{
+				Namespace:  aws.String(optionSettingNamespaceEC2Instances),
+				OptionName: aws.String(optionSettingNameEnableSpot),
+				Value:      aws.String("false"),
+			},
+		}
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObjects := []*elasticbeanstalk.ConfigurationOptionSetting{
+		{
+			Namespace:  aws.String(optionSettingNamespaceEC2Instances),
+			OptionName: aws.String(optionSettingNameEnableSpot),
+			Value:      aws.String("true"),
+		},
+	}
+
+	if v, ok := tfMap["max_price"].(string); ok && v != "" {
+		apiObjects = append(apiObjects, &elasticbeanstalk.ConfigurationOptionSetting{
+			Namespace:  aws.String(optionSettingNamespaceEC2Instances),
+			OptionName: aws.String(optionSettingNameSpotMaxPrice),
+			Value:      aws.String(v),
+		})
+	}
+
+	if v, ok := tfMap["instance_types"].([]interface{}); ok && len(v) > 0 {
+		apiObjects = append(apiObjects, &elasticbeanstalk.ConfigurationOptionSetting{
+			Namespace:  aws.String(optionSettingNamespaceEC2Instances),
+			OptionName: aws.String(optionSettingNameInstanceTypes),
+			Value:      aws.String(strings.Join(flex.ExpandStringValueList(v), ",")),
+		})
+	}
+
+	for _, v := range []struct {
+		key  string
+		name string
+	}{
+		{key: "on_demand_above_base_percentage", name: optionSettingNameSpotFleetOnDemandAboveBase},
+		{key: "on_demand_base_capacity", name: optionSettingNameSpotFleetOnDemandBase},
+	} {
+		if !rawConfig.IsWhollyKnown() || rawConfig.IsNull() || rawConfig.LengthInt() == 0 || rawConfig.Index(cty.NumberIntVal(0)).GetAttr(v.key).IsNull() {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &elasticbeanstalk.ConfigurationOptionSetting{
+			Namespace:  aws.String(optionSettingNamespaceEC2Instances),
+			OptionName: aws.String(v.name),
+			Value:      aws.String(strconv.Itoa(tfMap[v.key].(int))),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenSpotOptionSettings(apiObjects []*elasticbeanstalk.ConfigurationOptionSetting) []interface{} {
+	if !strings.EqualFold(optionSettingValue(apiObjects, optionSettingNamespaceEC2Instances, optionSettingNameEnableSpot), "true") {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"max_price": optionSettingValue(apiObjects, optionSettingNamespaceEC2Instances, optionSettingNameSpotMaxPrice),
+	}
+
+	if v := optionSettingValue(apiObjects, optionSettingNamespaceEC2Instances, optionSettingNameInstanceTypes); v != "" {
+		tfMap["instance_types"] = strings.Split(v, ",")
+	}
+
+	if v, err := strconv.Atoi(optionSettingValue(apiObjects, optionSettingNamespaceEC2Instances, optionSettingNameSpotFleetOnDemandAboveBase)); err == nil {
+		tfMap["on_demand_above_base_percentage"] = v
+	}
+
+	if v, err := strconv.Atoi(optionSettingValue(apiObjects, optionSettingNamespaceEC2Instances, optionSettingNameSpotFleetOnDemandBase)); err == nil {
+		tfMap["on_demand_base_capacity"] = v
+	}
+
+	return []interface{}{tfMap}
+}
+
+func optionSettingValue(apiObjects []*elasticbeanstalk.ConfigurationOptionSetting, namespace, name string) string {
+	for _, apiObject := range apiObjects {
+		if aws.StringValue(apiObject.Namespace) == namespace && aws.StringValue(apiObject.OptionName) == name {
+			return aws.StringValue(apiObject.Value)
+		}
+	}
+
+	return ""
+}
+
 func dropGeneratedSecurityGroup(ctx context.Context, conn *ec2.EC2, settingValue string) string {
 	input := &ec2.DescribeSecurityGroupsInput{
 		GroupIds: aws.StringSlice(strings.Split(settingValue, ",")),
@@ -32,7 +32,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-// @SDKResource("aws_alb_listener", name="Listener")
 // @SDKResource("aws_lb_listener", name="Listener")
 // @Tags(identifierAttribute="id")
 func ResourceListener() *schema.Resource {
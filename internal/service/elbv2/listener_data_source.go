@@ -19,7 +19,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
-// @SDKDataSource("aws_alb_listener")
 // @SDKDataSource("aws_lb_listener")
 func DataSourceListener() *schema.Resource {
 	return &schema.Resource{
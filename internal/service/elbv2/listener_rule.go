@@ -41,7 +41,6 @@ const (
 	listenerActionOrderMax = 50_000
 )
 
-// @SDKResource("aws_alb_listener_rule", name="Listener Rule")
 // @SDKResource("aws_lb_listener_rule", name="Listener Rule")
 // @Tags(identifierAttribute="id")
 func ResourceListenerRule() *schema.Resource {
@@ -21,7 +21,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
-// @SDKDataSource("aws_alb")
 // @SDKDataSource("aws_lb")
 func DataSourceLoadBalancer() *schema.Resource {
 	return &schema.Resource{
@@ -25,18 +25,6 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
-		{
-			Factory:  DataSourceLoadBalancer,
-			TypeName: "aws_alb",
-		},
-		{
-			Factory:  DataSourceListener,
-			TypeName: "aws_alb_listener",
-		},
-		{
-			Factory:  DataSourceTargetGroup,
-			TypeName: "aws_alb_target_group",
-		},
 		{
 			Factory:  DataSourceLoadBalancer,
 			TypeName: "aws_lb",
@@ -62,46 +50,6 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
-		{
-			Factory:  ResourceLoadBalancer,
-			TypeName: "aws_alb",
-			Name:     "Load Balancer",
-			Tags: &types.ServicePackageResourceTags{
-				IdentifierAttribute: "id",
-			},
-		},
-		{
-			Factory:  ResourceListener,
-			TypeName: "aws_alb_listener",
-			Name:     "Listener",
-			Tags: &types.ServicePackageResourceTags{
-				IdentifierAttribute: "id",
-			},
-		},
-		{
-			Factory:  ResourceListenerCertificate,
-			TypeName: "aws_alb_listener_certificate",
-		},
-		{
-			Factory:  ResourceListenerRule,
-			TypeName: "aws_alb_listener_rule",
-			Name:     "Listener Rule",
-			Tags: &types.ServicePackageResourceTags{
-				IdentifierAttribute: "id",
-			},
-		},
-		{
-			Factory:  ResourceTargetGroup,
-			TypeName: "aws_alb_target_group",
-			Name:     "Target Group",
-			Tags: &types.ServicePackageResourceTags{
-				IdentifierAttribute: "id",
-			},
-		},
-		{
-			Factory:  ResourceTargetGroupAttachment,
-			TypeName: "aws_alb_target_group_attachment",
-		},
 		{
 			Factory:  ResourceLoadBalancer,
 			TypeName: "aws_lb",
@@ -35,7 +35,6 @@ const (
 	propagationTimeout = 2 * time.Minute
 )
 
-// @SDKResource("aws_alb_target_group", name="Target Group")
 // @SDKResource("aws_lb_target_group", name="Target Group")
 // @Tags(identifierAttribute="id")
 func ResourceTargetGroup() *schema.Resource {
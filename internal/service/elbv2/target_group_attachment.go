@@ -21,7 +21,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
-// @SDKResource("aws_alb_target_group_attachment")
 // @SDKResource("aws_lb_target_group_attachment")
 func ResourceTargetGroupAttachment() *schema.Resource {
 	return &schema.Resource{
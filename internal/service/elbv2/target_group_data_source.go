@@ -20,7 +20,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
-// @SDKDataSource("aws_alb_target_group")
 // @SDKDataSource("aws_lb_target_group")
 func DataSourceTargetGroup() *schema.Resource {
 	return &schema.Resource{
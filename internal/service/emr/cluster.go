@@ -19,7 +19,9 @@ import (
 	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/service/emr"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	gversion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -48,7 +50,15 @@ func ResourceCluster() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			// Only the instance groups of a running cluster can be reconfigured, and only to a non-empty configuration.
+			customdiff.ForceNewIf("configurations_json", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.Get("configurations_json").(string) == "" ||
+					len(d.Get("master_instance_fleet").([]interface{})) > 0 ||
+					!releaseLabelSupportsReconfiguration(d.Get("release_label").(string))
+			}),
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"additional_info": {
@@ -128,7 +138,6 @@ func ResourceCluster() *schema.Resource {
 			"configurations_json": {
 				Type:             schema.TypeString,
 				Optional:         true,
-				ForceNew:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
 				StateFunc: func(v interface{}) string {
@@ -1125,7 +1134,14 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	if _, ok := d.GetOk("configurations_json"); ok {
-		configOut, err := flattenConfigurationJSON(cluster.Configurations)
+		configurations := cluster.Configurations
+
+		// Instance groups that were reconfigured after the cluster was created report the current configurations.
+		if masterGroup := findMasterGroup(instanceGroups); masterGroup != nil && aws.Int64Value(masterGroup.ConfigurationsVersion) > 0 {
+			configurations = masterGroup.Configurations
+		}
+
+		configOut, err := flattenConfigurationJSON(configurations)
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "reading EMR cluster configurations: %s", err)
 		}
@@ -1358,6 +1374,57 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("configurations_json") {
+		info, err := structure.NormalizeJsonString(d.Get("configurations_json"))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "configurations_json contains an invalid JSON: %s", err)
+		}
+
+		configurations, err := expandConfigurationJSON(info)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EMR configurations_json: %s", err)
+		}
+
+		instanceGroups, err := fetchAllInstanceGroups(ctx, conn, d.Id())
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EMR Cluster (%s): listing instance groups: %s", d.Id(), err)
+		}
+
+		// Reconfigure the master, core and any task instance groups.
+		var instanceGroupIDs []string
+		for _, v := range instanceGroups {
+			if v.Status != nil {
+				switch aws.StringValue(v.Status.State) {
+				case emr.InstanceGroupStateTerminating, emr.InstanceGroupStateTerminated, emr.InstanceGroupStateEnded:
+					continue
+				}
+			}
+
+			instanceGroupIDs = append(instanceGroupIDs, aws.StringValue(v.Id))
+		}
+
+		input := &emr.ModifyInstanceGroupsInput{
+			ClusterId: aws.String(d.Id()),
+		}
+
+		for _, v := range instanceGroupIDs {
+			input.InstanceGroups = append(input.InstanceGroups, &emr.InstanceGroupModifyConfig{
+				Configurations:  configurations,
+				InstanceGroupId: aws.String(v),
+			})
+		}
+
+		if _, err := conn.ModifyInstanceGroupsWithContext(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EMR Cluster (%s): reconfiguring instance groups: %s", d.Id(), err)
+		}
+
+		for _, v := range instanceGroupIDs {
+			if err := waitForInstanceGroupStateRunning(ctx, conn, d.Id(), v, instanceGroupUpdateTimeout); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for EMR Cluster (%s) Instance Group (%s) reconfiguration: %s", d.Id(), v, err)
+			}
+		}
+	}
+
 	if d.HasChange("instance_group") {
 		o, n := d.GetChange("instance_group")
 		oSet := o.(*schema.Set).List()
@@ -1921,6 +1988,17 @@ func readBodyJSON(body string, target interface{}) error {
 	return nil
 }
 
+// releaseLabelSupportsReconfiguration returns whether instance groups of a cluster with the specified release label can be reconfigured.
+// Reconfiguration is available from Amazon EMR release 5.21.0.
+func releaseLabelSupportsReconfiguration(releaseLabel string) bool {
+	v, err := gversion.NewVersion(strings.TrimPrefix(releaseLabel, "emr-"))
+	if err != nil {
+		return false
+	}
+
+	return v.GreaterThanOrEqual(gversion.Must(gversion.NewVersion("5.21.0")))
+}
+
 func findMasterGroup(instanceGroups []*emr.InstanceGroup) *emr.InstanceGroup {
 	for _, group := range instanceGroups {
 		if aws.StringValue(group.InstanceGroupType) == emr.InstanceRoleTypeMaster {
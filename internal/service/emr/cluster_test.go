@@ -238,6 +238,68 @@ func TestAccEMRCluster_sJSON(t *testing.T) {
 	})
 }
 
+func TestAccEMRCluster_configurationsJSONReconfigure(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster1, cluster2 emr.Cluster
+
+	resourceName := "aws_emr_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, emr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_configurationsJSONReconfigure(rName, "emr-6.10.0", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster1),
+					resource.TestMatchResourceAttr(resourceName, "configurations_json",
+						regexp.MustCompile(`"yarn.resourcemanager.am.max-attempts":"2"`)),
+				),
+			},
+			{
+				Config: testAccClusterConfig_configurationsJSONReconfigure(rName, "emr-6.10.0", "3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster2),
+					testAccCheckClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestMatchResourceAttr(resourceName, "configurations_json",
+						regexp.MustCompile(`"yarn.resourcemanager.am.max-attempts":"3"`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEMRCluster_configurationsJSONReconfigureUnsupportedRelease(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster1, cluster2 emr.Cluster
+
+	resourceName := "aws_emr_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, emr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_configurationsJSONReconfigure(rName, "emr-5.20.0", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster1),
+				),
+			},
+			{
+				Config: testAccClusterConfig_configurationsJSONReconfigure(rName, "emr-5.20.0", "3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster2),
+					testAccCheckClusterRecreated(&cluster1, &cluster2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEMRCluster_CoreInstanceGroup_autoScalingPolicy(t *testing.T) {
 	ctx := acctest.Context(t)
 	var cluster1, cluster2, cluster3 emr.Cluster
@@ -2433,6 +2495,56 @@ EOF
 `, rName))
 }
 
+func testAccClusterConfig_configurationsJSONReconfigure(rName, releaseLabel, maxAttempts string) string {
+	return acctest.ConfigCompose(
+		testAccClusterConfig_baseVPC(rName, false),
+		testAccClusterConfig_baseIAMServiceRole(rName),
+		testAccClusterConfig_baseIAMInstanceProfile(rName),
+		fmt.Sprintf(`
+resource "aws_emr_cluster" "test" {
+  name          = %[1]q
+  release_label = %[2]q
+  applications  = ["Hadoop"]
+
+  ec2_attributes {
+    subnet_id                         = aws_subnet.test.id
+    emr_managed_master_security_group = aws_security_group.test.id
+    emr_managed_slave_security_group  = aws_security_group.test.id
+    instance_profile                  = aws_iam_instance_profile.emr_instance_profile.arn
+  }
+
+  master_instance_group {
+    instance_type = "m5.xlarge"
+  }
+
+  core_instance_group {
+    instance_count = 1
+    instance_type  = "m5.xlarge"
+  }
+
+  keep_job_flow_alive_when_no_steps = true
+  termination_protection            = false
+
+  configurations_json = jsonencode([
+    {
+      Classification = "yarn-site"
+      Properties = {
+        "yarn.resourcemanager.am.max-attempts" = %[3]q
+      }
+    }
+  ])
+
+  depends_on = [
+    aws_route_table_association.test,
+    aws_iam_role_policy_attachment.emr_service,
+    aws_iam_role_policy_attachment.emr_instance_profile,
+  ]
+
+  service_role = aws_iam_role.emr_service.arn
+}
+`, rName, releaseLabel, maxAttempts))
+}
+
 func testAccClusterConfig_coreInstanceGroupAutoScalingPolicy(rName, autoscalingPolicy string) string {
 	return acctest.ConfigCompose(
 		testAccClusterConfig_baseVPC(rName, false),
@@ -5,12 +5,22 @@ package iam
 
 import (
 	"context"
+	"crypto/sha1" // nosemgrep:ci.avoid-crypto-sha1 -- IAM OIDC provider thumbprints are SHA-1 fingerprints
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"log"
+	"net/http"
+	"net/url"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -42,6 +52,11 @@ func ResourceOpenIDConnectProvider() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"automatic_thumbprint": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"thumbprint_list"},
+			},
 			"client_id_list": {
 				Type:     schema.TypeSet,
 				Required: true,
@@ -51,11 +66,16 @@ func ResourceOpenIDConnectProvider() *schema.Resource {
 					ValidateFunc: validation.StringLenBetween(1, 255),
 				},
 			},
+			"ignore_thumbprint_changes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"thumbprint_list": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validation.StringLenBetween(40, 40),
@@ -70,7 +90,10 @@ func ResourceOpenIDConnectProvider() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffOpenIDConnectProviderThumbprint,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -85,6 +108,16 @@ func resourceOpenIDConnectProviderCreate(ctx context.Context, d *schema.Resource
 		Url:            aws.String(d.Get("url").(string)),
 	}
 
+	if len(input.ThumbprintList) == 0 {
+		thumbprint, err := findOpenIDConnectProviderThumbprint(ctx, openIDConnectProviderHTTPClient(meta), d.Get("url").(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating IAM OIDC Provider: reading thumbprint, configure thumbprint_list to skip the lookup: %s", err)
+		}
+
+		input.ThumbprintList = aws.StringSlice([]string{thumbprint})
+	}
+
 	output, err := conn.CreateOpenIDConnectProviderWithContext(ctx, input)
 
 	// Some partitions (e.g. ISO) may not support tag-on-create.
@@ -221,3 +254,125 @@ func FindOpenIDConnectProviderByARN(ctx context.Context, conn *iam.IAM, arn stri
 
 	return output, nil
 }
+
+func customizeDiffOpenIDConnectProviderThumbprint(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && diff.Get("ignore_thumbprint_changes").(bool) {
+		if diff.HasChange("thumbprint_list") {
+			return diff.Clear("thumbprint_list")
+		}
+
+		return nil
+	}
+
+	if !diff.Get("automatic_thumbprint").(bool) {
+		return nil
+	}
+
+	if !diff.NewValueKnown("url") {
+		return diff.SetNewComputed("thumbprint_list")
+	}
+
+	thumbprint, err := findOpenIDConnectProviderThumbprint(ctx, openIDConnectProviderHTTPClient(meta), diff.Get("url").(string))
+
+	// SDKv2 CustomizeDiff cannot return warning diagnostics.
+	// Keep the existing thumbprints so that an unreachable issuer doesn't block the plan.
+	if err != nil {
+		tflog.Warn(ctx, "reading IAM OIDC Provider thumbprint, keeping existing thumbprint_list", map[string]interface{}{
+			"error": err.Error(),
+			"url":   diff.Get("url").(string),
+		})
+
+		return nil
+	}
+
+	for _, v := range diff.Get("thumbprint_list").([]interface{}) {
+		if strings.EqualFold(v.(string), thumbprint) {
+			return nil
+		}
+	}
+
+	return diff.SetNew("thumbprint_list", []interface{}{thumbprint})
+}
+
+// openIDConnectProviderHTTPClient returns the provider's HTTP client, which honors the
+// custom_ca_bundle, insecure and http_proxy provider arguments.
+func openIDConnectProviderHTTPClient(meta interface{}) *http.Client {
+	if client, ok := meta.(*conns.AWSClient); ok && client.HTTPClient() != nil {
+		return client.HTTPClient()
+	}
+
+	return cleanhttp.DefaultClient()
+}
+
+// findOpenIDConnectProviderThumbprint returns the thumbprint of the certificate of the top intermediate certificate authority
+// that signed the certificate of the server hosting the OIDC identity provider's keys.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/id_roles_providers_create_oidc_verify-thumbprint.html.
+func findOpenIDConnectProviderThumbprint(ctx context.Context, client *http.Client, issuerURL string) (string, error) {
+	if !strings.Contains(issuerURL, "://") {
+		issuerURL = "https://" + issuerURL
+	}
+
+	configurationURL := strings.TrimSuffix(issuerURL, "/") + "/.well-known/openid-configuration"
+	response, err := openIDConnectProviderGet(ctx, client, configurationURL)
+
+	if err != nil {
+		return "", err
+	}
+
+	var configuration struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+
+	err = json.NewDecoder(response.Body).Decode(&configuration)
+	response.Body.Close()
+
+	if err != nil {
+		return "", fmt.Errorf("decoding OIDC discovery document (%s): %w", configurationURL, err)
+	}
+
+	if configuration.JWKSURI == "" {
+		return "", fmt.Errorf("OIDC discovery document (%s) has no jwks_uri", configurationURL)
+	}
+
+	if u, err := url.Parse(configuration.JWKSURI); err != nil || u.Scheme != "https" {
+		return "", fmt.Errorf("OIDC discovery document (%s) jwks_uri (%s) is not an HTTPS URL", configurationURL, configuration.JWKSURI)
+	}
+
+	response, err = openIDConnectProviderGet(ctx, client, configuration.JWKSURI)
+
+	if err != nil {
+		return "", err
+	}
+
+	response.Body.Close()
+
+	if response.TLS == nil || len(response.TLS.PeerCertificates) == 0 {
+		return "", fmt.Errorf("reading TLS certificates for %s: no certificates presented", configuration.JWKSURI)
+	}
+
+	certificates := response.TLS.PeerCertificates
+	sum := sha1.Sum(certificates[len(certificates)-1].Raw) //nolint:gosec // thumbprints are SHA-1 fingerprints
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func openIDConnectProviderGet(ctx context.Context, client *http.Client, requestURL string) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("creating request (%s): %w", requestURL, err)
+	}
+
+	response, err := client.Do(request)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", requestURL, err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		response.Body.Close()
+		return nil, fmt.Errorf("reading %s: %s", requestURL, response.Status)
+	}
+
+	return response, nil
+}
@@ -6,6 +6,7 @@ package iam_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/iam"
@@ -164,6 +165,64 @@ func TestAccIAMOpenIDConnectProvider_clientIDListOrder(t *testing.T) {
 	})
 }
 
+func TestAccIAMOpenIDConnectProvider_automaticThumbprint(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_iam_openid_connect_provider.test"
+
+	// An account can have only one OIDC provider for a given URL, so this test cannot run in parallel with itself.
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpenIDConnectProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenIDConnectProviderConfig_automaticThumbprint(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpenIDConnectProviderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "automatic_thumbprint", "true"),
+					resource.TestCheckResourceAttr(resourceName, "thumbprint_list.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "thumbprint_list.0", regexp.MustCompile(`^[0-9a-f]{40}$`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"automatic_thumbprint"},
+			},
+		},
+	})
+}
+
+func TestAccIAMOpenIDConnectProvider_ignoreThumbprintChanges(t *testing.T) {
+	ctx := acctest.Context(t)
+	rString := sdkacctest.RandString(5)
+	resourceName := "aws_iam_openid_connect_provider.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpenIDConnectProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenIDConnectProviderConfig_ignoreThumbprintChanges(rString, "cf23df2207d99a74fbe169e3eba035e633b65d94"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpenIDConnectProviderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ignore_thumbprint_changes", "true"),
+					resource.TestCheckResourceAttr(resourceName, "thumbprint_list.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "thumbprint_list.0", "cf23df2207d99a74fbe169e3eba035e633b65d94"),
+				),
+			},
+			{
+				Config:   testAccOpenIDConnectProviderConfig_ignoreThumbprintChanges(rString, "c784713d6f9cb67b55dd84f4e4af7832d42b8f55"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckOpenIDConnectProviderDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn(ctx)
@@ -223,6 +282,35 @@ resource "aws_iam_openid_connect_provider" "test" {
 `, rName)
 }
 
+func testAccOpenIDConnectProviderConfig_automaticThumbprint() string {
+	return `
+resource "aws_iam_openid_connect_provider" "test" {
+  url = "https://accounts.google.com"
+
+  client_id_list = [
+    "266362248691-re108qaeld573ia0l6clj2i5ac7r7291.apps.googleusercontent.com",
+  ]
+
+  automatic_thumbprint = true
+}
+`
+}
+
+func testAccOpenIDConnectProviderConfig_ignoreThumbprintChanges(rName, thumbprint string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_openid_connect_provider" "test" {
+  url = "https://accounts.testle.com/%[1]s"
+
+  client_id_list = [
+    "266362248691-re108qaeld573ia0l6clj2i5ac7r7291.apps.testleusercontent.com",
+  ]
+
+  thumbprint_list           = [%[2]q]
+  ignore_thumbprint_changes = true
+}
+`, rName, thumbprint)
+}
+
 func testAccOpenIDConnectProviderConfig_modified(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_openid_connect_provider" "test" {
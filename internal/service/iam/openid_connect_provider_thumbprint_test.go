@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"crypto/sha1" // nosemgrep:ci.avoid-crypto-sha1
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFindOpenIDConnectProviderThumbprint(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	server := httptest.NewTLSServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"issuer": %[1]q, "jwks_uri": "%[1]s/keys"}`, server.URL)
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"keys": []}`)
+	})
+	mux.HandleFunc("/no-keys/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	sum := sha1.Sum(server.Certificate().Raw) //nolint:gosec
+	expected := hex.EncodeToString(sum[:])
+
+	ctx := context.Background()
+
+	got, err := findOpenIDConnectProviderThumbprint(ctx, server.Client(), server.URL+"/")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	// The URL of an IAM OIDC provider may omit the scheme.
+	got, err = findOpenIDConnectProviderThumbprint(ctx, server.Client(), strings.TrimPrefix(server.URL, "https://"))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	if _, err := findOpenIDConnectProviderThumbprint(ctx, server.Client(), server.URL+"/no-keys"); err == nil || !strings.Contains(err.Error(), "has no jwks_uri") {
+		t.Errorf("expected no jwks_uri error, got: %v", err)
+	}
+
+	if _, err := findOpenIDConnectProviderThumbprint(ctx, server.Client(), server.URL+"/missing"); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("expected not found error, got: %v", err)
+	}
+}
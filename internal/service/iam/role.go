@@ -39,6 +39,7 @@ const (
 )
 
 // @SDKResource("aws_iam_role", name="Role")
+// @EndpointDrift(arnAttribute="arn")
 // @Tags
 func ResourceRole() *schema.Resource {
 	return &schema.Resource{
@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_iam_role_policy_attachment")
+// @ARNAttributes("policy_arn")
 func ResourceRolePolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRolePolicyAttachmentCreate,
@@ -149,18 +149,20 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_iam_policy_attachment",
 		},
 		{
-			Factory:  ResourceRole,
-			TypeName: "aws_iam_role",
-			Name:     "Role",
-			Tags:     &types.ServicePackageResourceTags{},
+			Factory:                ResourceRole,
+			TypeName:               "aws_iam_role",
+			Name:                   "Role",
+			EndpointDriftAttribute: "arn",
+			Tags:                   &types.ServicePackageResourceTags{},
 		},
 		{
 			Factory:  ResourceRolePolicy,
 			TypeName: "aws_iam_role_policy",
 		},
 		{
-			Factory:  ResourceRolePolicyAttachment,
-			TypeName: "aws_iam_role_policy_attachment",
+			Factory:       ResourceRolePolicyAttachment,
+			TypeName:      "aws_iam_role_policy_attachment",
+			ARNAttributes: []string{"policy_arn"},
 		},
 		{
 			Factory:  ResourceSAMLProvider,
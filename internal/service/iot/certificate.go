@@ -16,6 +16,7 @@ import (
 )
 
 // @SDKResource("aws_iot_certificate")
+// @NotFound(operation="DescribeCertificate")
 func ResourceCertificate() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCertificateCreate,
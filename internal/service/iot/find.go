@@ -38,6 +38,57 @@ func FindAuthorizerByName(ctx context.Context, conn *iot.IoT, name string) (*iot
 	return output.AuthorizerDescription, nil
 }
 
+func FindSoftwarePackageByName(ctx context.Context, conn *iot.IoT, name string) (*iot.GetPackageOutput, error) {
+	input := &iot.GetPackageInput{
+		PackageName: aws.String(name),
+	}
+
+	output, err := conn.GetPackageWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindSoftwarePackageVersionByTwoPartKey(ctx context.Context, conn *iot.IoT, packageName, versionName string) (*iot.GetPackageVersionOutput, error) {
+	input := &iot.GetPackageVersionInput{
+		PackageName: aws.String(packageName),
+		VersionName: aws.String(versionName),
+	}
+
+	output, err := conn.GetPackageVersionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func FindThingByName(ctx context.Context, conn *iot.IoT, name string) (*iot.DescribeThingOutput, error) {
 	input := &iot.DescribeThingInput{
 		ThingName: aws.String(name),
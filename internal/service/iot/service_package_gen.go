@@ -39,8 +39,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_iot_authorizer",
 		},
 		{
-			Factory:  ResourceCertificate,
-			TypeName: "aws_iot_certificate",
+			Factory:           ResourceCertificate,
+			TypeName:          "aws_iot_certificate",
+			NotFoundOperation: "DescribeCertificate",
 		},
 		{
 			Factory:  ResourceIndexingConfiguration,
@@ -70,6 +71,22 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceRoleAlias,
 			TypeName: "aws_iot_role_alias",
 		},
+		{
+			Factory:  ResourceSoftwarePackage,
+			TypeName: "aws_iot_software_package",
+			Name:     "Software Package",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceSoftwarePackageVersion,
+			TypeName: "aws_iot_software_package_version",
+			Name:     "Software Package Version",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceThing,
 			TypeName: "aws_iot_thing",
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iot_software_package", name="Software Package")
+// @Tags(identifierAttribute="arn")
+func ResourceSoftwarePackage() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSoftwarePackageCreate,
+		ReadWithoutTimeout:   resourceSoftwarePackageRead,
+		UpdateWithoutTimeout: resourceSoftwarePackageUpdate,
+		DeleteWithoutTimeout: resourceSoftwarePackageDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_version_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-_.]+$`), "must contain only alphanumeric characters, hyphens, underscores and periods"),
+				),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceSoftwarePackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &iot.CreatePackageInput{
+		PackageName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if tags := KeyValueTags(ctx, getTagsIn(ctx)); len(tags) > 0 {
+		input.Tags = aws.StringMap(tags.Map())
+	}
+
+	output, err := conn.CreatePackageWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Software Package (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.PackageName))
+
+	return append(diags, resourceSoftwarePackageRead(ctx, d, meta)...)
+}
+
+func resourceSoftwarePackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	output, err := FindSoftwarePackageByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Software Package (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Software Package (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.PackageArn)
+	d.Set("default_version_name", output.DefaultVersionName)
+	d.Set("description", output.Description)
+	d.Set("name", output.PackageName)
+
+	return diags
+}
+
+func resourceSoftwarePackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	if d.HasChange("description") {
+		input := &iot.UpdatePackageInput{
+			Description: aws.String(d.Get("description").(string)),
+			PackageName: aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdatePackageWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Software Package (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceSoftwarePackageRead(ctx, d, meta)...)
+}
+
+func resourceSoftwarePackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT Software Package: %s", d.Id())
+	_, err := conn.DeletePackageWithContext(ctx, &iot.DeletePackageInput{
+		PackageName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Software Package (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -0,0 +1,319 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iot_software_package_version", name="Software Package Version")
+// @Tags(identifierAttribute="arn")
+func ResourceSoftwarePackageVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSoftwarePackageVersionCreate,
+		ReadWithoutTimeout:   resourceSoftwarePackageVersionRead,
+		UpdateWithoutTimeout: resourceSoftwarePackageVersionUpdate,
+		DeleteWithoutTimeout: resourceSoftwarePackageVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attributes": {
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"error_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"package_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-_.]+$`), "must contain only alphanumeric characters, hyphens, underscores and periods"),
+				),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					iot.PackageVersionStatusPublished,
+					iot.PackageVersionStatusDeprecated,
+				}, false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"version_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-_.]+$`), "must contain only alphanumeric characters, hyphens, underscores and periods"),
+				),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceSoftwarePackageVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	packageName, versionName := d.Get("package_name").(string), d.Get("version_name").(string)
+	id := SoftwarePackageVersionCreateResourceID(packageName, versionName)
+	input := &iot.CreatePackageVersionInput{
+		PackageName: aws.String(packageName),
+		VersionName: aws.String(versionName),
+	}
+
+	if v, ok := d.GetOk("attributes"); ok && len(v.(map[string]interface{})) > 0 {
+		input.Attributes = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if tags := KeyValueTags(ctx, getTagsIn(ctx)); len(tags) > 0 {
+		input.Tags = aws.StringMap(tags.Map())
+	}
+
+	_, err := conn.CreatePackageVersionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Software Package Version (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	// New package versions are created as drafts and only published versions can be deprecated.
+	if v, ok := d.GetOk("status"); ok {
+		if err := updateSoftwarePackageVersionStatus(ctx, conn, packageName, versionName, iot.PackageVersionActionPublish); err != nil {
+			return sdkdiag.AppendErrorf(diags, "publishing IoT Software Package Version (%s): %s", d.Id(), err)
+		}
+
+		if v.(string) == iot.PackageVersionStatusDeprecated {
+			if err := updateSoftwarePackageVersionStatus(ctx, conn, packageName, versionName, iot.PackageVersionActionDeprecate); err != nil {
+				return sdkdiag.AppendErrorf(diags, "deprecating IoT Software Package Version (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceSoftwarePackageVersionRead(ctx, d, meta)...)
+}
+
+func resourceSoftwarePackageVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	packageName, versionName, err := SoftwarePackageVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindSoftwarePackageVersionByTwoPartKey(ctx, conn, packageName, versionName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Software Package Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Software Package Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.PackageVersionArn)
+	d.Set("attributes", aws.StringValueMap(output.Attributes))
+	d.Set("description", output.Description)
+	d.Set("error_reason", output.ErrorReason)
+	d.Set("package_name", output.PackageName)
+	d.Set("status", output.Status)
+	d.Set("version_name", output.VersionName)
+
+	return diags
+}
+
+func resourceSoftwarePackageVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	packageName, versionName, err := SoftwarePackageVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChanges("attributes", "description") {
+		input := &iot.UpdatePackageVersionInput{
+			PackageName: aws.String(packageName),
+			VersionName: aws.String(versionName),
+		}
+
+		if d.HasChange("attributes") {
+			input.Attributes = flex.ExpandStringMap(d.Get("attributes").(map[string]interface{}))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		_, err := conn.UpdatePackageVersionWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Software Package Version (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("status") {
+		var action string
+
+		switch d.Get("status").(string) {
+		case iot.PackageVersionStatusPublished:
+			action = iot.PackageVersionActionPublish
+		case iot.PackageVersionStatusDeprecated:
+			action = iot.PackageVersionActionDeprecate
+		}
+
+		if action != "" {
+			if err := updateSoftwarePackageVersionStatus(ctx, conn, packageName, versionName, action); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating IoT Software Package Version (%s) status: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceSoftwarePackageVersionRead(ctx, d, meta)...)
+}
+
+func resourceSoftwarePackageVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	packageName, versionName, err := SoftwarePackageVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// A package's default version cannot be deleted.
+	pkg, err := FindSoftwarePackageByName(ctx, conn, packageName)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Software Package (%s): %s", packageName, err)
+	}
+
+	if aws.StringValue(pkg.DefaultVersionName) == versionName {
+		_, err := conn.UpdatePackageWithContext(ctx, &iot.UpdatePackageInput{
+			PackageName:         aws.String(packageName),
+			UnsetDefaultVersion: aws.Bool(true),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "unsetting IoT Software Package (%s) default version: %s", packageName, err)
+		}
+	}
+
+	// Published package versions must be deprecated before they can be deleted.
+	if d.Get("status").(string) == iot.PackageVersionStatusPublished {
+		err := updateSoftwarePackageVersionStatus(ctx, conn, packageName, versionName, iot.PackageVersionActionDeprecate)
+
+		if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deprecating IoT Software Package Version (%s): %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting IoT Software Package Version: %s", d.Id())
+	_, err = conn.DeletePackageVersionWithContext(ctx, &iot.DeletePackageVersionInput{
+		PackageName: aws.String(packageName),
+		VersionName: aws.String(versionName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Software Package Version (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func updateSoftwarePackageVersionStatus(ctx context.Context, conn *iot.IoT, packageName, versionName, action string) error {
+	input := &iot.UpdatePackageVersionInput{
+		Action:      aws.String(action),
+		PackageName: aws.String(packageName),
+		VersionName: aws.String(versionName),
+	}
+
+	_, err := conn.UpdatePackageVersionWithContext(ctx, input)
+
+	return err
+}
+
+const softwarePackageVersionResourceIDSeparator = ":"
+
+func SoftwarePackageVersionCreateResourceID(packageName, versionName string) string {
+	parts := []string{packageName, versionName}
+	id := strings.Join(parts, softwarePackageVersionResourceIDSeparator)
+
+	return id
+}
+
+func SoftwarePackageVersionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, softwarePackageVersionResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected package-name%[2]sversion-name", id, softwarePackageVersionResourceIDSeparator)
+}
@@ -0,0 +1,284 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iot"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiot "github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTSoftwarePackageVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iot.GetPackageVersionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_software_package_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSoftwarePackageVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSoftwarePackageVersionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSoftwarePackageVersionExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "iot", regexp.MustCompile(fmt.Sprintf("package/%s/version/1.0.0$", rName))),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "package_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version_name", "1.0.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTSoftwarePackageVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iot.GetPackageVersionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_software_package_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSoftwarePackageVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSoftwarePackageVersionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSoftwarePackageVersionExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiot.ResourceSoftwarePackageVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTSoftwarePackageVersion_status(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iot.GetPackageVersionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_software_package_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSoftwarePackageVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSoftwarePackageVersionConfig_status(rName, "PUBLISHED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSoftwarePackageVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", "PUBLISHED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSoftwarePackageVersionConfig_status(rName, "DEPRECATED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSoftwarePackageVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", "DEPRECATED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTSoftwarePackageVersion_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iot.GetPackageVersionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_software_package_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSoftwarePackageVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSoftwarePackageVersionConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSoftwarePackageVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSoftwarePackageVersionConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSoftwarePackageVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccSoftwarePackageVersionConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSoftwarePackageVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSoftwarePackageVersionExists(ctx context.Context, n string, v *iot.GetPackageVersionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Software Package Version ID is set")
+		}
+
+		packageName, versionName, err := tfiot.SoftwarePackageVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn(ctx)
+
+		output, err := tfiot.FindSoftwarePackageVersionByTwoPartKey(ctx, conn, packageName, versionName)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSoftwarePackageVersionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iot_software_package_version" {
+				continue
+			}
+
+			packageName, versionName, err := tfiot.SoftwarePackageVersionParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfiot.FindSoftwarePackageVersionByTwoPartKey(ctx, conn, packageName, versionName)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Software Package Version %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSoftwarePackageVersionConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_software_package" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccSoftwarePackageVersionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccSoftwarePackageVersionConfig_base(rName), `
+resource "aws_iot_software_package_version" "test" {
+  package_name = aws_iot_software_package.test.name
+  version_name = "1.0.0"
+}
+`)
+}
+
+func testAccSoftwarePackageVersionConfig_status(rName, status string) string {
+	return acctest.ConfigCompose(testAccSoftwarePackageVersionConfig_base(rName), fmt.Sprintf(`
+resource "aws_iot_software_package_version" "test" {
+  package_name = aws_iot_software_package.test.name
+  version_name = "1.0.0"
+  description  = %[1]q
+  status       = %[2]q
+
+  attributes = {
+    key1 = "value1"
+  }
+}
+`, rName, status))
+}
+
+func testAccSoftwarePackageVersionConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccSoftwarePackageVersionConfig_base(rName), fmt.Sprintf(`
+resource "aws_iot_software_package_version" "test" {
+  package_name = aws_iot_software_package.test.name
+  version_name = "1.0.0"
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccSoftwarePackageVersionConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccSoftwarePackageVersionConfig_base(rName), fmt.Sprintf(`
+resource "aws_iot_software_package_version" "test" {
+  package_name = aws_iot_software_package.test.name
+  version_name = "1.0.0"
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
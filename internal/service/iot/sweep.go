@@ -46,6 +46,17 @@ func init() {
 		F:    sweepRoleAliases,
 	})
 
+	resource.AddTestSweepers("aws_iot_software_package", &resource.Sweeper{
+		Name:         "aws_iot_software_package",
+		F:            sweepSoftwarePackages,
+		Dependencies: []string{"aws_iot_software_package_version"},
+	})
+
+	resource.AddTestSweepers("aws_iot_software_package_version", &resource.Sweeper{
+		Name: "aws_iot_software_package_version",
+		F:    sweepSoftwarePackageVersions,
+	})
+
 	resource.AddTestSweepers("aws_iot_thing_principal_attachment", &resource.Sweeper{
 		Name: "aws_iot_thing_principal_attachment",
 		F:    sweepThingPrincipalAttachments,
@@ -288,6 +299,116 @@ func sweepRoleAliases(region string) error {
 	return errs.ErrorOrNil()
 }
 
+func sweepSoftwarePackages(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.IoTConn(ctx)
+	input := &iot.ListPackagesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListPackagesPagesWithContext(ctx, input, func(page *iot.ListPackagesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PackageSummaries {
+			r := ResourceSoftwarePackage()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.PackageName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Software Package sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT Software Packages (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Software Packages (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepSoftwarePackageVersions(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.IoTConn(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	input := &iot.ListPackagesInput{}
+
+	err = conn.ListPackagesPagesWithContext(ctx, input, func(page *iot.ListPackagesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, pkg := range page.PackageSummaries {
+			input := &iot.ListPackageVersionsInput{
+				PackageName: pkg.PackageName,
+			}
+
+			err := conn.ListPackageVersionsPagesWithContext(ctx, input, func(page *iot.ListPackageVersionsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.PackageVersionSummaries {
+					r := ResourceSoftwarePackageVersion()
+					d := r.Data(nil)
+
+					d.SetId(SoftwarePackageVersionCreateResourceID(aws.StringValue(v.PackageName), aws.StringValue(v.VersionName)))
+					d.Set("status", v.Status)
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing IoT Software Package Versions for %s: %w", region, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing IoT Software Packages for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IoT Software Package Versions for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping IoT Software Package Version sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
 func sweepThingPrincipalAttachments(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
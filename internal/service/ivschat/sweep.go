@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build sweep
+// +build sweep
+
+package ivschat
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ivschat"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_ivschat_logging_configuration", &resource.Sweeper{
+		Name: "aws_ivschat_logging_configuration",
+		F:    sweepLoggingConfigurations,
+		Dependencies: []string{
+			"aws_ivschat_room",
+		},
+	})
+
+	resource.AddTestSweepers("aws_ivschat_room", &resource.Sweeper{
+		Name: "aws_ivschat_room",
+		F:    sweepRooms,
+	})
+}
+
+func sweepLoggingConfigurations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	input := &ivschat.ListLoggingConfigurationsInput{}
+	conn := client.IVSChatClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := ivschat.NewListLoggingConfigurationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping IVS Chat Logging Configuration sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing IVS Chat Logging Configurations (%s): %w", region, err)
+		}
+
+		for _, v := range page.LoggingConfigurations {
+			r := ResourceLoggingConfiguration()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.Arn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IVS Chat Logging Configurations (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepRooms(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	input := &ivschat.ListRoomsInput{}
+	conn := client.IVSChatClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := ivschat.NewListRoomsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping IVS Chat Room sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing IVS Chat Rooms (%s): %w", region, err)
+		}
+
+		for _, v := range page.Rooms {
+			r := ResourceRoom()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.Arn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IVS Chat Rooms (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -18,12 +18,142 @@ import (
 )
 
 func init() {
+	resource.AddTestSweepers("aws_kendra_data_source", &resource.Sweeper{
+		Name: "aws_kendra_data_source",
+		F:    sweepDataSources,
+	})
+
+	resource.AddTestSweepers("aws_kendra_faq", &resource.Sweeper{
+		Name: "aws_kendra_faq",
+		F:    sweepFaqs,
+	})
+
 	resource.AddTestSweepers("aws_kendra_index", &resource.Sweeper{
 		Name: "aws_kendra_index",
 		F:    sweepIndex,
+		Dependencies: []string{
+			"aws_kendra_data_source",
+			"aws_kendra_faq",
+		},
 	})
 }
 
+func sweepDataSources(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	conn := client.KendraClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+	in := &kendra.ListIndicesInput{}
+	var errs *multierror.Error
+
+	pages := kendra.NewListIndicesPaginator(conn, in)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Kendra Data Sources sweep for %s: %s", region, err)
+			return errs.ErrorOrNil()
+		}
+
+		if err != nil {
+			return multierror.Append(errs, fmt.Errorf("retrieving Kendra Indices: %w", err))
+		}
+
+		for _, index := range page.IndexConfigurationSummaryItems {
+			indexID := aws.ToString(index.Id)
+			pages := kendra.NewListDataSourcesPaginator(conn, &kendra.ListDataSourcesInput{
+				IndexId: aws.String(indexID),
+			})
+
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if err != nil {
+					errs = multierror.Append(errs, fmt.Errorf("retrieving Kendra Data Sources for Index (%s): %w", indexID, err))
+					break
+				}
+
+				for _, dataSource := range page.SummaryItems {
+					r := ResourceDataSource()
+					d := r.Data(nil)
+					d.SetId(fmt.Sprintf("%s/%s", aws.ToString(dataSource.Id), indexID))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+			}
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping Kendra Data Sources for %s: %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepFaqs(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	conn := client.KendraClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+	in := &kendra.ListIndicesInput{}
+	var errs *multierror.Error
+
+	pages := kendra.NewListIndicesPaginator(conn, in)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Kendra FAQs sweep for %s: %s", region, err)
+			return errs.ErrorOrNil()
+		}
+
+		if err != nil {
+			return multierror.Append(errs, fmt.Errorf("retrieving Kendra Indices: %w", err))
+		}
+
+		for _, index := range page.IndexConfigurationSummaryItems {
+			indexID := aws.ToString(index.Id)
+			pages := kendra.NewListFaqsPaginator(conn, &kendra.ListFaqsInput{
+				IndexId: aws.String(indexID),
+			})
+
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if err != nil {
+					errs = multierror.Append(errs, fmt.Errorf("retrieving Kendra FAQs for Index (%s): %w", indexID, err))
+					break
+				}
+
+				for _, faq := range page.FaqSummaryItems {
+					r := ResourceFaq()
+					d := r.Data(nil)
+					d.SetId(fmt.Sprintf("%s/%s", aws.ToString(faq.Id), indexID))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+			}
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping Kendra FAQs for %s: %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
 func sweepIndex(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
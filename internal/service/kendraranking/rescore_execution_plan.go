@@ -0,0 +1,348 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kendraranking
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kendraranking"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_kendra_rescore_execution_plan", name="Rescore Execution Plan")
+// @Tags(identifierAttribute="arn")
+func ResourceRescoreExecutionPlan() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRescoreExecutionPlanCreate,
+		ReadWithoutTimeout:   resourceRescoreExecutionPlanRead,
+		UpdateWithoutTimeout: resourceRescoreExecutionPlanUpdate,
+		DeleteWithoutTimeout: resourceRescoreExecutionPlanDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capacity_units": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rescore_capacity_units": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"rescore_execution_plan_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceRescoreExecutionPlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KendraRankingConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &kendraranking.CreateRescoreExecutionPlanInput{
+		Name: aws.String(name),
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("capacity_units"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CapacityUnits = expandCapacityUnitsConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateRescoreExecutionPlanWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Kendra Rescore Execution Plan (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	if _, err := waitRescoreExecutionPlanCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Kendra Rescore Execution Plan (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceRescoreExecutionPlanRead(ctx, d, meta)...)
+}
+
+func resourceRescoreExecutionPlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KendraRankingConn(ctx)
+
+	output, err := FindRescoreExecutionPlanByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kendra Rescore Execution Plan (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Kendra Rescore Execution Plan (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	if output.CapacityUnits != nil {
+		if err := d.Set("capacity_units", []interface{}{flattenCapacityUnitsConfiguration(output.CapacityUnits)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting capacity_units: %s", err)
+		}
+	} else {
+		d.Set("capacity_units", nil)
+	}
+	d.Set("description", output.Description)
+	d.Set("name", output.Name)
+	d.Set("rescore_execution_plan_id", output.Id)
+	d.Set("status", output.Status)
+
+	return diags
+}
+
+func resourceRescoreExecutionPlanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KendraRankingConn(ctx)
+
+	if d.HasChanges("capacity_units", "description", "name") {
+		input := &kendraranking.UpdateRescoreExecutionPlanInput{
+			Id: aws.String(d.Id()),
+		}
+
+		if d.HasChange("capacity_units") {
+			if v, ok := d.GetOk("capacity_units"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.CapacityUnits = expandCapacityUnitsConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		_, err := conn.UpdateRescoreExecutionPlanWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Kendra Rescore Execution Plan (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitRescoreExecutionPlanUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Kendra Rescore Execution Plan (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceRescoreExecutionPlanRead(ctx, d, meta)...)
+}
+
+func resourceRescoreExecutionPlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KendraRankingConn(ctx)
+
+	log.Printf("[INFO] Deleting Kendra Rescore Execution Plan: %s", d.Id())
+	_, err := conn.DeleteRescoreExecutionPlanWithContext(ctx, &kendraranking.DeleteRescoreExecutionPlanInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kendraranking.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Kendra Rescore Execution Plan (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitRescoreExecutionPlanDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Kendra Rescore Execution Plan (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindRescoreExecutionPlanByID(ctx context.Context, conn *kendraranking.KendraRanking, id string) (*kendraranking.DescribeRescoreExecutionPlanOutput, error) {
+	input := &kendraranking.DescribeRescoreExecutionPlanInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.DescribeRescoreExecutionPlanWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, kendraranking.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusRescoreExecutionPlan(ctx context.Context, conn *kendraranking.KendraRanking, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindRescoreExecutionPlanByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitRescoreExecutionPlanCreated(ctx context.Context, conn *kendraranking.KendraRanking, id string, timeout time.Duration) (*kendraranking.DescribeRescoreExecutionPlanOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{kendraranking.RescoreExecutionPlanStatusCreating},
+		Target:  []string{kendraranking.RescoreExecutionPlanStatusActive},
+		Refresh: statusRescoreExecutionPlan(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kendraranking.DescribeRescoreExecutionPlanOutput); ok {
+		if v := aws.StringValue(output.ErrorMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitRescoreExecutionPlanUpdated(ctx context.Context, conn *kendraranking.KendraRanking, id string, timeout time.Duration) (*kendraranking.DescribeRescoreExecutionPlanOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{kendraranking.RescoreExecutionPlanStatusUpdating},
+		Target:  []string{kendraranking.RescoreExecutionPlanStatusActive},
+		Refresh: statusRescoreExecutionPlan(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kendraranking.DescribeRescoreExecutionPlanOutput); ok {
+		if v := aws.StringValue(output.ErrorMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitRescoreExecutionPlanDeleted(ctx context.Context, conn *kendraranking.KendraRanking, id string, timeout time.Duration) (*kendraranking.DescribeRescoreExecutionPlanOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{kendraranking.RescoreExecutionPlanStatusDeleting},
+		Target:  []string{},
+		Refresh: statusRescoreExecutionPlan(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kendraranking.DescribeRescoreExecutionPlanOutput); ok {
+		if v := aws.StringValue(output.ErrorMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandCapacityUnitsConfiguration(tfMap map[string]interface{}) *kendraranking.CapacityUnitsConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kendraranking.CapacityUnitsConfiguration{}
+
+	if v, ok := tfMap["rescore_capacity_units"].(int); ok {
+		apiObject.RescoreCapacityUnits = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenCapacityUnitsConfiguration(apiObject *kendraranking.CapacityUnitsConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.RescoreCapacityUnits; v != nil {
+		tfMap["rescore_capacity_units"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kendraranking_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kendraranking"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkendraranking "github.com/hashicorp/terraform-provider-aws/internal/service/kendraranking"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccKendraRankingRescoreExecutionPlan_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v kendraranking.DescribeRescoreExecutionPlanOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_rescore_execution_plan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kendraranking.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRescoreExecutionPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRescoreExecutionPlanConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRescoreExecutionPlanExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "kendra-ranking", regexp.MustCompile(`rescore-execution-plan/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "capacity_units.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_units.0.rescore_capacity_units", "0"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "rescore_execution_plan_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", kendraranking.RescoreExecutionPlanStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKendraRankingRescoreExecutionPlan_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v kendraranking.DescribeRescoreExecutionPlanOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_rescore_execution_plan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kendraranking.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRescoreExecutionPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRescoreExecutionPlanConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRescoreExecutionPlanExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfkendraranking.ResourceRescoreExecutionPlan(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccKendraRankingRescoreExecutionPlan_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v kendraranking.DescribeRescoreExecutionPlanOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_rescore_execution_plan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kendraranking.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRescoreExecutionPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRescoreExecutionPlanConfig_full(rName, "description1", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRescoreExecutionPlanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "capacity_units.0.rescore_capacity_units", "1"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRescoreExecutionPlanConfig_full(rNameUpdated, "description2", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRescoreExecutionPlanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "capacity_units.0.rescore_capacity_units", "2"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKendraRankingRescoreExecutionPlan_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v kendraranking.DescribeRescoreExecutionPlanOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_rescore_execution_plan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kendraranking.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRescoreExecutionPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRescoreExecutionPlanConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRescoreExecutionPlanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRescoreExecutionPlanConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRescoreExecutionPlanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccRescoreExecutionPlanConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRescoreExecutionPlanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRescoreExecutionPlanDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KendraRankingConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_kendra_rescore_execution_plan" {
+				continue
+			}
+
+			_, err := tfkendraranking.FindRescoreExecutionPlanByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Kendra Rescore Execution Plan %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRescoreExecutionPlanExists(ctx context.Context, n string, v *kendraranking.DescribeRescoreExecutionPlanOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kendra Rescore Execution Plan ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KendraRankingConn(ctx)
+
+		output, err := tfkendraranking.FindRescoreExecutionPlanByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KendraRankingConn(ctx)
+
+	input := &kendraranking.ListRescoreExecutionPlansInput{}
+
+	_, err := conn.ListRescoreExecutionPlansWithContext(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccRescoreExecutionPlanConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kendra_rescore_execution_plan" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccRescoreExecutionPlanConfig_full(rName, description string, capacityUnits int) string {
+	return fmt.Sprintf(`
+resource "aws_kendra_rescore_execution_plan" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  capacity_units {
+    rescore_capacity_units = %[3]d
+  }
+}
+`, rName, description, capacityUnits)
+}
+
+func testAccRescoreExecutionPlanConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_kendra_rescore_execution_plan" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccRescoreExecutionPlanConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_kendra_rescore_execution_plan" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
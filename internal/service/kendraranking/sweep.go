@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build sweep
+// +build sweep
+
+package kendraranking
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kendraranking"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_kendra_rescore_execution_plan", &resource.Sweeper{
+		Name: "aws_kendra_rescore_execution_plan",
+		F:    sweepRescoreExecutionPlans,
+	})
+}
+
+func sweepRescoreExecutionPlans(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.KendraRankingConn(ctx)
+	input := &kendraranking.ListRescoreExecutionPlansInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListRescoreExecutionPlansPagesWithContext(ctx, input, func(page *kendraranking.ListRescoreExecutionPlansOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SummaryItems {
+			r := ResourceRescoreExecutionPlan()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Kendra Rescore Execution Plan sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Kendra Rescore Execution Plans (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Kendra Rescore Execution Plans (%s): %w", region, err)
+	}
+
+	return nil
+}
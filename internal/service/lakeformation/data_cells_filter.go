@@ -0,0 +1,363 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_lakeformation_data_cells_filter")
+func ResourceDataCellsFilter() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataCellsFilterCreate,
+		ReadWithoutTimeout:   resourceDataCellsFilterRead,
+		UpdateWithoutTimeout: resourceDataCellsFilterUpdate,
+		DeleteWithoutTimeout: resourceDataCellsFilterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"column_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				ExactlyOneOf: []string{"column_names", "column_wildcard"},
+			},
+			"column_wildcard": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"excluded_column_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+					},
+				},
+				ExactlyOneOf: []string{"column_names", "column_wildcard"},
+			},
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"row_filter": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"all_rows_wildcard": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{},
+							},
+							ExactlyOneOf: []string{"row_filter.0.all_rows_wildcard", "row_filter.0.filter_expression"},
+						},
+						"filter_expression": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2048),
+							ExactlyOneOf: []string{"row_filter.0.all_rows_wildcard", "row_filter.0.filter_expression"},
+						},
+					},
+				},
+			},
+			"table_catalog_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"table_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDataCellsFilterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	catalogID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("table_catalog_id"); ok {
+		catalogID = v.(string)
+	}
+
+	id := DataCellsFilterCreateResourceID(catalogID, d.Get("database_name").(string), d.Get("table_name").(string), d.Get("name").(string))
+	input := &lakeformation.CreateDataCellsFilterInput{
+		TableData: expandDataCellsFilter(d, catalogID),
+	}
+
+	_, err := conn.CreateDataCellsFilterWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lake Formation Data Cells Filter (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceDataCellsFilterRead(ctx, d, meta)...)
+}
+
+func resourceDataCellsFilterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	catalogID, databaseName, tableName, name, err := DataCellsFilterParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	filter, err := FindDataCellsFilterByFourPartKey(ctx, conn, catalogID, databaseName, tableName, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lake Formation Data Cells Filter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lake Formation Data Cells Filter (%s): %s", d.Id(), err)
+	}
+
+	d.Set("column_names", aws.StringValueSlice(filter.ColumnNames))
+	if err := d.Set("column_wildcard", flattenColumnWildcard(filter.ColumnWildcard)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting column_wildcard: %s", err)
+	}
+	d.Set("database_name", filter.DatabaseName)
+	d.Set("name", filter.Name)
+	if err := d.Set("row_filter", flattenRowFilter(filter.RowFilter)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting row_filter: %s", err)
+	}
+	d.Set("table_catalog_id", filter.TableCatalogId)
+	d.Set("table_name", filter.TableName)
+	d.Set("version_id", filter.VersionId)
+
+	return diags
+}
+
+func resourceDataCellsFilterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	input := &lakeformation.UpdateDataCellsFilterInput{
+		TableData: expandDataCellsFilter(d, d.Get("table_catalog_id").(string)),
+	}
+
+	_, err := conn.UpdateDataCellsFilterWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Lake Formation Data Cells Filter (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDataCellsFilterRead(ctx, d, meta)...)
+}
+
+func resourceDataCellsFilterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	catalogID, databaseName, tableName, name, err := DataCellsFilterParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Lake Formation Data Cells Filter: %s", d.Id())
+	_, err = conn.DeleteDataCellsFilterWithContext(ctx, &lakeformation.DeleteDataCellsFilterInput{
+		DatabaseName:   aws.String(databaseName),
+		Name:           aws.String(name),
+		TableCatalogId: aws.String(catalogID),
+		TableName:      aws.String(tableName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lake Formation Data Cells Filter (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const dataCellsFilterResourceIDSeparator = ","
+
+func DataCellsFilterCreateResourceID(catalogID, databaseName, tableName, name string) string {
+	parts := []string{catalogID, databaseName, tableName, name}
+	id := strings.Join(parts, dataCellsFilterResourceIDSeparator)
+
+	return id
+}
+
+func DataCellsFilterParseResourceID(id string) (string, string, string, string, error) {
+	parts := strings.Split(id, dataCellsFilterResourceIDSeparator)
+
+	if len(parts) == 4 && parts[0] != "" && parts[1] != "" && parts[2] != "" && parts[3] != "" {
+		return parts[0], parts[1], parts[2], parts[3], nil
+	}
+
+	return "", "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected TABLE-CATALOG-ID%[2]sDATABASE-NAME%[2]sTABLE-NAME%[2]sNAME", id, dataCellsFilterResourceIDSeparator)
+}
+
+func FindDataCellsFilterByFourPartKey(ctx context.Context, conn *lakeformation.LakeFormation, catalogID, databaseName, tableName, name string) (*lakeformation.DataCellsFilter, error) {
+	input := &lakeformation.GetDataCellsFilterInput{
+		DatabaseName:   aws.String(databaseName),
+		Name:           aws.String(name),
+		TableCatalogId: aws.String(catalogID),
+		TableName:      aws.String(tableName),
+	}
+
+	output, err := conn.GetDataCellsFilterWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DataCellsFilter == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DataCellsFilter, nil
+}
+
+func expandDataCellsFilter(d *schema.ResourceData, catalogID string) *lakeformation.DataCellsFilter {
+	apiObject := &lakeformation.DataCellsFilter{
+		DatabaseName:   aws.String(d.Get("database_name").(string)),
+		Name:           aws.String(d.Get("name").(string)),
+		TableCatalogId: aws.String(catalogID),
+		TableName:      aws.String(d.Get("table_name").(string)),
+	}
+
+	if v, ok := d.GetOk("column_names"); ok && v.(*schema.Set).Len() > 0 {
+		apiObject.ColumnNames = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("column_wildcard"); ok && len(v.([]interface{})) > 0 {
+		apiObject.ColumnWildcard = expandColumnWildcard(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("row_filter"); ok && len(v.([]interface{})) > 0 {
+		apiObject.RowFilter = expandRowFilter(v.([]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandColumnWildcard(tfList []interface{}) *lakeformation.ColumnWildcard {
+	apiObject := &lakeformation.ColumnWildcard{}
+
+	// An empty column_wildcard block is a nil list element.
+	if tfMap, ok := tfList[0].(map[string]interface{}); ok {
+		if v, ok := tfMap["excluded_column_names"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ExcludedColumnNames = flex.ExpandStringSet(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandRowFilter(tfList []interface{}) *lakeformation.RowFilter {
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	apiObject := &lakeformation.RowFilter{}
+
+	if v, ok := tfMap["all_rows_wildcard"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AllRowsWildcard = &lakeformation.AllRowsWildcard{}
+	}
+
+	if v, ok := tfMap["filter_expression"].(string); ok && v != "" {
+		apiObject.FilterExpression = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenColumnWildcard(apiObject *lakeformation.ColumnWildcard) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"excluded_column_names": aws.StringValueSlice(apiObject.ExcludedColumnNames),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRowFilter(apiObject *lakeformation.RowFilter) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if apiObject.AllRowsWildcard != nil {
+		tfMap["all_rows_wildcard"] = []interface{}{map[string]interface{}{}}
+	}
+
+	if v := apiObject.FilterExpression; v != nil {
+		tfMap["filter_expression"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflakeformation "github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccDataCellsFilter_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var filter lakeformation.DataCellsFilter
+	resourceName := "aws_lakeformation_data_cells_filter.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, lakeformation.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataCellsFilterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataCellsFilterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataCellsFilterExists(ctx, resourceName, &filter),
+					resource.TestCheckResourceAttr(resourceName, "column_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "column_names.*", "event"),
+					resource.TestCheckResourceAttr(resourceName, "column_wildcard.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "database_name", "aws_glue_catalog_table.test", "database_name"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "row_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "row_filter.0.all_rows_wildcard.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "row_filter.0.filter_expression", "value > 10"),
+					acctest.CheckResourceAttrAccountID(resourceName, "table_catalog_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "table_name", "aws_glue_catalog_table.test", "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "version_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDataCellsFilter_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var filter lakeformation.DataCellsFilter
+	resourceName := "aws_lakeformation_data_cells_filter.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, lakeformation.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataCellsFilterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataCellsFilterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataCellsFilterExists(ctx, resourceName, &filter),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflakeformation.ResourceDataCellsFilter(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccDataCellsFilter_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var filter1, filter2 lakeformation.DataCellsFilter
+	resourceName := "aws_lakeformation_data_cells_filter.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, lakeformation.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataCellsFilterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataCellsFilterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataCellsFilterExists(ctx, resourceName, &filter1),
+				),
+			},
+			{
+				Config: testAccDataCellsFilterConfig_wildcards(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataCellsFilterExists(ctx, resourceName, &filter2),
+					testAccCheckDataCellsFilterNotRecreated(&filter1, &filter2),
+					resource.TestCheckResourceAttr(resourceName, "column_names.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "column_wildcard.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "column_wildcard.0.excluded_column_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "column_wildcard.0.excluded_column_names.*", "value"),
+					resource.TestCheckResourceAttr(resourceName, "row_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "row_filter.0.all_rows_wildcard.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "row_filter.0.filter_expression", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDataCellsFilterDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lakeformation_data_cells_filter" {
+				continue
+			}
+
+			catalogID, databaseName, tableName, name, err := tflakeformation.DataCellsFilterParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tflakeformation.FindDataCellsFilterByFourPartKey(ctx, conn, catalogID, databaseName, tableName, name)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			// If the lake formation admin has been revoked, there will be access denied instead of entity not found
+			if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeAccessDeniedException) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lake Formation Data Cells Filter %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDataCellsFilterExists(ctx context.Context, n string, v *lakeformation.DataCellsFilter) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lake Formation Data Cells Filter ID is set")
+		}
+
+		catalogID, databaseName, tableName, name, err := tflakeformation.DataCellsFilterParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn(ctx)
+
+		output, err := tflakeformation.FindDataCellsFilterByFourPartKey(ctx, conn, catalogID, databaseName, tableName, name)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDataCellsFilterNotRecreated(before, after *lakeformation.DataCellsFilter) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := before.VersionId, after.VersionId; before == nil || after == nil || *before == *after {
+			return fmt.Errorf("Lake Formation Data Cells Filter was not updated in place")
+		}
+
+		return nil
+	}
+}
+
+func testAccDataCellsFilterConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = aws_glue_catalog_database.test.name
+
+  storage_descriptor {
+    columns {
+      name = "event"
+      type = "string"
+    }
+
+    columns {
+      name = "timestamp"
+      type = "date"
+    }
+
+    columns {
+      name = "value"
+      type = "double"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccDataCellsFilterConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDataCellsFilterConfig_base(rName), fmt.Sprintf(`
+resource "aws_lakeformation_data_cells_filter" "test" {
+  database_name = aws_glue_catalog_table.test.database_name
+  name          = %[1]q
+  table_name    = aws_glue_catalog_table.test.name
+
+  column_names = ["event"]
+
+  row_filter {
+    filter_expression = "value > 10"
+  }
+
+  # for consistency, ensure that admins are set up before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName))
+}
+
+func testAccDataCellsFilterConfig_wildcards(rName string) string {
+	return acctest.ConfigCompose(testAccDataCellsFilterConfig_base(rName), fmt.Sprintf(`
+resource "aws_lakeformation_data_cells_filter" "test" {
+  database_name = aws_glue_catalog_table.test.database_name
+  name          = %[1]q
+  table_name    = aws_glue_catalog_table.test.name
+
+  column_wildcard {
+    excluded_column_names = ["value"]
+  }
+
+  row_filter {
+    all_rows_wildcard {}
+  }
+
+  # for consistency, ensure that admins are set up before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName))
+}
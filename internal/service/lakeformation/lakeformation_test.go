@@ -13,6 +13,11 @@ func TestAccLakeFormation_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"DataCellsFilter": {
+			"basic":      testAccDataCellsFilter_basic,
+			"disappears": testAccDataCellsFilter_disappears,
+			"update":     testAccDataCellsFilter_update,
+		},
 		"DataLakeSettings": {
 			"basic":            testAccDataLakeSettings_basic,
 			"dataSource":       testAccDataLakeSettingsDataSource_basic,
@@ -42,6 +42,7 @@ const (
 )
 
 // @SDKResource("aws_lambda_function", name="Function")
+// @ARNAttributes("layers", "role")
 // @Tags(identifierAttribute="arn")
 func ResourceFunction() *schema.Resource {
 	return &schema.Resource{
@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfappautoscaling "github.com/hashicorp/terraform-provider-aws/internal/service/appautoscaling"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	provisionedConcurrencyScalableDimension   = "lambda:function:ProvisionedConcurrency"
+	provisionedConcurrencyScheduleIDPartCount = 3
+)
+
+// @SDKResource("aws_lambda_provisioned_concurrency_schedule")
+func ResourceProvisionedConcurrencySchedule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProvisionedConcurrencyScheduleCreate,
+		ReadWithoutTimeout:   resourceProvisionedConcurrencyScheduleRead,
+		UpdateWithoutTimeout: resourceProvisionedConcurrencyScheduleUpdate,
+		DeleteWithoutTimeout: resourceProvisionedConcurrencyScheduleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"function_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"max_capacity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				AtLeastOneOf: []string{"max_capacity", "min_capacity"},
+			},
+			"min_capacity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				AtLeastOneOf: []string{"max_capacity", "min_capacity"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"qualifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"schedule": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"timezone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "UTC",
+			},
+		},
+	}
+}
+
+func resourceProvisionedConcurrencyScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppAutoScalingConn(ctx)
+
+	functionName := d.Get("function_name").(string)
+	qualifier := d.Get("qualifier").(string)
+	name := d.Get("name").(string)
+	id, err := flex.FlattenResourceId([]string{functionName, qualifier, name}, provisionedConcurrencyScheduleIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lambda Provisioned Concurrency Schedule (%s): %s", name, err)
+	}
+
+	if err := putProvisionedConcurrencySchedule(ctx, conn, d, functionName, qualifier, name); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lambda Provisioned Concurrency Schedule (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceProvisionedConcurrencyScheduleRead(ctx, d, meta)...)
+}
+
+func resourceProvisionedConcurrencyScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppAutoScalingConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), provisionedConcurrencyScheduleIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Provisioned Concurrency Schedule (%s): %s", d.Id(), err)
+	}
+
+	functionName, qualifier, name := parts[0], parts[1], parts[2]
+
+	scheduledAction, err := tfappautoscaling.FindScheduledAction(ctx, conn, name, applicationautoscaling.ServiceNamespaceLambda, provisionedConcurrencyResourceID(functionName, qualifier))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lambda Provisioned Concurrency Schedule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Provisioned Concurrency Schedule (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", scheduledAction.ScheduledActionARN)
+	if v := scheduledAction.EndTime; v != nil {
+		d.Set("end_time", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("end_time", nil)
+	}
+	d.Set("function_name", functionName)
+	if v := scheduledAction.ScalableTargetAction; v != nil {
+		d.Set("max_capacity", aws.Int64Value(v.MaxCapacity))
+		d.Set("min_capacity", aws.Int64Value(v.MinCapacity))
+	}
+	d.Set("name", scheduledAction.ScheduledActionName)
+	d.Set("qualifier", qualifier)
+	d.Set("schedule", scheduledAction.Schedule)
+	if v := scheduledAction.StartTime; v != nil {
+		d.Set("start_time", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("start_time", nil)
+	}
+	d.Set("timezone", scheduledAction.Timezone)
+
+	return diags
+}
+
+func resourceProvisionedConcurrencyScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppAutoScalingConn(ctx)
+
+	if err := putProvisionedConcurrencySchedule(ctx, conn, d, d.Get("function_name").(string), d.Get("qualifier").(string), d.Get("name").(string)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Lambda Provisioned Concurrency Schedule (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceProvisionedConcurrencyScheduleRead(ctx, d, meta)...)
+}
+
+func resourceProvisionedConcurrencyScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppAutoScalingConn(ctx)
+
+	log.Printf("[INFO] Deleting Lambda Provisioned Concurrency Schedule: %s", d.Id())
+	_, err := conn.DeleteScheduledActionWithContext(ctx, &applicationautoscaling.DeleteScheduledActionInput{
+		ResourceId:          aws.String(provisionedConcurrencyResourceID(d.Get("function_name").(string), d.Get("qualifier").(string))),
+		ScalableDimension:   aws.String(provisionedConcurrencyScalableDimension),
+		ScheduledActionName: aws.String(d.Get("name").(string)),
+		ServiceNamespace:    aws.String(applicationautoscaling.ServiceNamespaceLambda),
+	})
+
+	if tfawserr.ErrCodeEquals(err, applicationautoscaling.ErrCodeObjectNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lambda Provisioned Concurrency Schedule (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func putProvisionedConcurrencySchedule(ctx context.Context, conn *applicationautoscaling.ApplicationAutoScaling, d *schema.ResourceData, functionName, qualifier, name string) error {
+	input := &applicationautoscaling.PutScheduledActionInput{
+		ResourceId:           aws.String(provisionedConcurrencyResourceID(functionName, qualifier)),
+		ScalableDimension:    aws.String(provisionedConcurrencyScalableDimension),
+		ScalableTargetAction: &applicationautoscaling.ScalableTargetAction{},
+		Schedule:             aws.String(d.Get("schedule").(string)),
+		ScheduledActionName:  aws.String(name),
+		ServiceNamespace:     aws.String(applicationautoscaling.ServiceNamespaceLambda),
+		Timezone:             aws.String(d.Get("timezone").(string)),
+	}
+
+	if v, ok := d.GetOk("end_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.EndTime = aws.Time(v)
+	}
+
+	// Scaling provisioned concurrency down to zero is valid, so check the raw configuration rather than using GetOk.
+	if !d.GetRawConfig().GetAttr("max_capacity").IsNull() {
+		input.ScalableTargetAction.MaxCapacity = aws.Int64(int64(d.Get("max_capacity").(int)))
+	}
+
+	if !d.GetRawConfig().GetAttr("min_capacity").IsNull() {
+		input.ScalableTargetAction.MinCapacity = aws.Int64(int64(d.Get("min_capacity").(int)))
+	}
+
+	if v, ok := d.GetOk("start_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.StartTime = aws.Time(v)
+	}
+
+	// The alias must first be registered as a scalable target, which may not yet be visible.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.PutScheduledActionWithContext(ctx, input)
+	}, applicationautoscaling.ErrCodeObjectNotFoundException)
+
+	return err
+}
+
+// provisionedConcurrencyResourceID returns the Application Auto Scaling resource ID of a Lambda function alias or version.
+func provisionedConcurrencyResourceID(functionName, qualifier string) string {
+	return fmt.Sprintf("function:%s:%s", functionName, qualifier)
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappautoscaling "github.com/hashicorp/terraform-provider-aws/internal/service/appautoscaling"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaProvisionedConcurrencySchedule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_provisioned_concurrency_schedule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisionedConcurrencyScheduleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedConcurrencyScheduleConfig_basic(rName, "cron(0 8 * * ? *)", 1, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedConcurrencyScheduleExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "autoscaling", fmt.Sprintf("scheduledAction:*:resource/lambda/function:%s:test:scheduledActionName/%s", rName, rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "function_name", "aws_lambda_function.test", "function_name"),
+					resource.TestCheckResourceAttr(resourceName, "max_capacity", "5"),
+					resource.TestCheckResourceAttr(resourceName, "min_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "qualifier", "aws_lambda_alias.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "schedule", "cron(0 8 * * ? *)"),
+					resource.TestCheckResourceAttr(resourceName, "timezone", "UTC"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProvisionedConcurrencyScheduleConfig_basic(rName, "cron(0 18 * * ? *)", 0, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedConcurrencyScheduleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "max_capacity", "0"),
+					resource.TestCheckResourceAttr(resourceName, "min_capacity", "0"),
+					resource.TestCheckResourceAttr(resourceName, "schedule", "cron(0 18 * * ? *)"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaProvisionedConcurrencySchedule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_provisioned_concurrency_schedule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisionedConcurrencyScheduleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedConcurrencyScheduleConfig_basic(rName, "cron(0 8 * * ? *)", 1, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedConcurrencyScheduleExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflambda.ResourceProvisionedConcurrencySchedule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckProvisionedConcurrencyScheduleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppAutoScalingConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lambda_provisioned_concurrency_schedule" {
+				continue
+			}
+
+			resourceID := fmt.Sprintf("function:%s:%s", rs.Primary.Attributes["function_name"], rs.Primary.Attributes["qualifier"])
+			_, err := tfappautoscaling.FindScheduledAction(ctx, conn, rs.Primary.Attributes["name"], applicationautoscaling.ServiceNamespaceLambda, resourceID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lambda Provisioned Concurrency Schedule %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckProvisionedConcurrencyScheduleExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lambda Provisioned Concurrency Schedule ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppAutoScalingConn(ctx)
+
+		resourceID := fmt.Sprintf("function:%s:%s", rs.Primary.Attributes["function_name"], rs.Primary.Attributes["qualifier"])
+		_, err := tfappautoscaling.FindScheduledAction(ctx, conn, rs.Primary.Attributes["name"], applicationautoscaling.ServiceNamespaceLambda, resourceID)
+
+		return err
+	}
+}
+
+func testAccProvisionedConcurrencyScheduleConfig_basic(rName, schedule string, minCapacity, maxCapacity int) string {
+	return acctest.ConfigCompose(
+		testAccProvisionedConcurrencyConfigConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_lambda_alias" "test" {
+  function_name    = aws_lambda_function.test.function_name
+  function_version = aws_lambda_function.test.version
+  name             = "test"
+}
+
+resource "aws_appautoscaling_target" "test" {
+  max_capacity       = 10
+  min_capacity       = 0
+  resource_id        = "function:${aws_lambda_function.test.function_name}:${aws_lambda_alias.test.name}"
+  scalable_dimension = "lambda:function:ProvisionedConcurrency"
+  service_namespace  = "lambda"
+}
+
+resource "aws_lambda_provisioned_concurrency_schedule" "test" {
+  function_name = aws_lambda_function.test.function_name
+  qualifier     = aws_lambda_alias.test.name
+  name          = %[1]q
+  schedule      = %[2]q
+  min_capacity  = %[3]d
+  max_capacity  = %[4]d
+
+  depends_on = [aws_appautoscaling_target.test]
+}
+`, rName, schedule, minCapacity, maxCapacity),
+	)
+}
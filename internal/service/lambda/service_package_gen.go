@@ -73,9 +73,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_lambda_event_source_mapping",
 		},
 		{
-			Factory:  ResourceFunction,
-			TypeName: "aws_lambda_function",
-			Name:     "Function",
+			Factory:       ResourceFunction,
+			TypeName:      "aws_lambda_function",
+			Name:          "Function",
+			ARNAttributes: []string{"layers", "role"},
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
@@ -108,6 +109,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceProvisionedConcurrencyConfig,
 			TypeName: "aws_lambda_provisioned_concurrency_config",
 		},
+		{
+			Factory:  ResourceProvisionedConcurrencySchedule,
+			TypeName: "aws_lambda_provisioned_concurrency_schedule",
+		},
 	}
 }
 
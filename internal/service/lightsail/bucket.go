@@ -24,6 +24,7 @@ import (
 
 // @SDKResource("aws_lightsail_bucket", name="Bucket")
 // @Tags(identifierAttribute="id")
+// @Partitions("aws")
 func ResourceBucket() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBucketCreate,
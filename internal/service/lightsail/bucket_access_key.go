@@ -28,6 +28,7 @@ const (
 )
 
 // @SDKResource("aws_lightsail_bucket_access_key")
+// @Partitions("aws")
 func ResourceBucketAccessKey() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBucketAccessKeyCreate,
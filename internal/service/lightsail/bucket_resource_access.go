@@ -27,6 +27,7 @@ const (
 )
 
 // @SDKResource("aws_lightsail_bucket_resource_access")
+// @Partitions("aws")
 func ResourceBucketResourceAccess() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBucketResourceAccessCreate,
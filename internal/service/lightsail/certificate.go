@@ -28,6 +28,7 @@ import (
 
 // @SDKResource("aws_lightsail_certificate", name="Certificate")
 // @Tags(identifierAttribute="id")
+// @Partitions("aws")
 func ResourceCertificate() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCertificateCreate,
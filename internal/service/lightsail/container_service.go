@@ -26,6 +26,7 @@ import (
 
 // @SDKResource("aws_lightsail_container_service", name="Container Service")
 // @Tags(identifierAttribute="id")
+// @Partitions("aws")
 func ResourceContainerService() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceContainerServiceCreate,
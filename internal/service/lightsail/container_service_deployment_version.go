@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_lightsail_container_service_deployment_version")
+// @Partitions("aws")
 func ResourceContainerServiceDeploymentVersion() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceContainerServiceDeploymentVersionCreate,
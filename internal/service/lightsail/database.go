@@ -27,6 +27,7 @@ const (
 
 // @SDKResource("aws_lightsail_database", name="Database")
 // @Tags(identifierAttribute="id")
+// @Partitions("aws")
 func ResourceDatabase() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDatabaseCreate,
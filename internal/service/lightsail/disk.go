@@ -25,6 +25,7 @@ import (
 
 // @SDKResource("aws_lightsail_disk", name="Disk")
 // @Tags(identifierAttribute="id")
+// @Partitions("aws")
 func ResourceDisk() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDiskCreate,
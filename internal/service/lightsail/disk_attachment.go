@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKResource("aws_lightsail_disk_attachment")
+// @Partitions("aws")
 func ResourceDiskAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDiskAttachmentCreate,
@@ -29,6 +29,7 @@ import (
 
 // @SDKResource("aws_lightsail_distribution", name="Distribution")
 // @Tags(identifierAttribute="id")
+// @Partitions("aws")
 func ResourceDistribution() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDistributionCreate,
@@ -16,6 +16,7 @@ import (
 )
 
 // @SDKResource("aws_lightsail_domain")
+// @Partitions("aws")
 func ResourceDomain() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDomainCreate,
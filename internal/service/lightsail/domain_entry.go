@@ -29,6 +29,7 @@ const (
 )
 
 // @SDKResource("aws_lightsail_domain_entry")
+// @Partitions("aws")
 func ResourceDomainEntry() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDomainEntryCreate,
@@ -29,6 +29,7 @@ import (
 
 // @SDKResource("aws_lightsail_instance", name="Instance")
 // @Tags(identifierAttribute="id")
+// @Partitions("aws")
 func ResourceInstance() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceInstanceCreate,
@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_lightsail_instance_public_ports")
+// @Partitions("aws")
 func ResourceInstancePublicPorts() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceInstancePublicPortsCreate,
@@ -26,6 +26,7 @@ const (
 )
 
 // @SDKResource("aws_lightsail_key_pair")
+// @Partitions("aws")
 func ResourceKeyPair() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceKeyPairCreate,
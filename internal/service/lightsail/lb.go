@@ -25,6 +25,7 @@ import (
 
 // @SDKResource("aws_lightsail_lb", name="LB")
 // @Tags(identifierAttribute="id")
+// @Partitions("aws")
 func ResourceLoadBalancer() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLoadBalancerCreate,
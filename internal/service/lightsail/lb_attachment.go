@@ -23,6 +23,7 @@ import (
 )
 
 // @SDKResource("aws_lightsail_lb_attachment")
+// @Partitions("aws")
 func ResourceLoadBalancerAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLoadBalancerAttachmentCreate,
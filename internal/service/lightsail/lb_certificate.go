@@ -26,6 +26,7 @@ import (
 )
 
 // @SDKResource("aws_lightsail_lb_certificate")
+// @Partitions("aws")
 func ResourceLoadBalancerCertificate() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLoadBalancerCertificateCreate,
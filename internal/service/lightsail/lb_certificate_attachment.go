@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_lightsail_lb_certificate_attachment")
+// @Partitions("aws")
 func ResourceLoadBalancerCertificateAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLoadBalancerCertificateAttachmentCreate,
@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_lightsail_lb_https_redirection_policy")
+// @Partitions("aws")
 func ResourceLoadBalancerHTTPSRedirectionPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLoadBalancerHTTPSRedirectionPolicyCreate,
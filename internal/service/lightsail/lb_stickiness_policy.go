@@ -23,6 +23,7 @@ import (
 )
 
 // @SDKResource("aws_lightsail_lb_stickiness_policy")
+// @Partitions("aws")
 func ResourceLoadBalancerStickinessPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLoadBalancerStickinessPolicyCreate,
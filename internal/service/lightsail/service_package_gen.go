@@ -27,128 +27,151 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
 		{
-			Factory:  ResourceBucket,
-			TypeName: "aws_lightsail_bucket",
-			Name:     "Bucket",
+			Factory:    ResourceBucket,
+			TypeName:   "aws_lightsail_bucket",
+			Name:       "Bucket",
+			Partitions: []string{"aws"},
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
 		},
 		{
-			Factory:  ResourceBucketAccessKey,
-			TypeName: "aws_lightsail_bucket_access_key",
+			Factory:    ResourceBucketAccessKey,
+			TypeName:   "aws_lightsail_bucket_access_key",
+			Partitions: []string{"aws"},
 		},
 		{
-			Factory:  ResourceBucketResourceAccess,
-			TypeName: "aws_lightsail_bucket_resource_access",
+			Factory:    ResourceBucketResourceAccess,
+			TypeName:   "aws_lightsail_bucket_resource_access",
+			Partitions: []string{"aws"},
 		},
 		{
-			Factory:  ResourceCertificate,
-			TypeName: "aws_lightsail_certificate",
-			Name:     "Certificate",
+			Factory:    ResourceCertificate,
+			TypeName:   "aws_lightsail_certificate",
+			Name:       "Certificate",
+			Partitions: []string{"aws"},
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
 		},
 		{
-			Factory:  ResourceContainerService,
-			TypeName: "aws_lightsail_container_service",
-			Name:     "Container Service",
+			Factory:    ResourceContainerService,
+			TypeName:   "aws_lightsail_container_service",
+			Name:       "Container Service",
+			Partitions: []string{"aws"},
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
 		},
 		{
-			Factory:  ResourceContainerServiceDeploymentVersion,
-			TypeName: "aws_lightsail_container_service_deployment_version",
+			Factory:    ResourceContainerServiceDeploymentVersion,
+			TypeName:   "aws_lightsail_container_service_deployment_version",
+			Partitions: []string{"aws"},
 		},
 		{
-			Factory:  ResourceDatabase,
-			TypeName: "aws_lightsail_database",
-			Name:     "Database",
+			Factory:    ResourceDatabase,
+			TypeName:   "aws_lightsail_database",
+			Name:       "Database",
+			Partitions: []string{"aws"},
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
 		},
 		{
-			Factory:  ResourceDisk,
-			TypeName: "aws_lightsail_disk",
-			Name:     "Disk",
+			Factory:    ResourceDisk,
+			TypeName:   "aws_lightsail_disk",
+			Name:       "Disk",
+			Partitions: []string{"aws"},
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
 		},
 		{
-			Factory:  ResourceDiskAttachment,
-			TypeName: "aws_lightsail_disk_attachment",
+			Factory:    ResourceDiskAttachment,
+			TypeName:   "aws_lightsail_disk_attachment",
+			Partitions: []string{"aws"},
 		},
 		{
-			Factory:  ResourceDistribution,
-			TypeName: "aws_lightsail_distribution",
-			Name:     "Distribution",
+			Factory:    ResourceDistribution,
+			TypeName:   "aws_lightsail_distribution",
+			Name:       "Distribution",
+			Partitions: []string{"aws"},
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
 		},
 		{
-			Factory:  ResourceDomain,
-			TypeName: "aws_lightsail_domain",
+			Factory:    ResourceDomain,
+			TypeName:   "aws_lightsail_domain",
+			Partitions: []string{"aws"},
 		},
 		{
-			Factory:  ResourceDomainEntry,
-			TypeName: "aws_lightsail_domain_entry",
+			Factory:    ResourceDomainEntry,
+			TypeName:   "aws_lightsail_domain_entry",
+			Partitions: []string{"aws"},
 		},
 		{
-			Factory:  ResourceInstance,
-			TypeName: "aws_lightsail_instance",
-			Name:     "Instance",
+			Factory:    ResourceInstance,
+			TypeName:   "aws_lightsail_instance",
+			Name:       "Instance",
+			Partitions: []string{"aws"},
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
 		},
 		{
-			Factory:  ResourceInstancePublicPorts,
-			TypeName: "aws_lightsail_instance_public_ports",
+			Factory:    ResourceInstancePublicPorts,
+			TypeName:   "aws_lightsail_instance_public_ports",
+			Partitions: []string{"aws"},
 		},
 		{
-			Factory:  ResourceKeyPair,
-			TypeName: "aws_lightsail_key_pair",
+			Factory:    ResourceKeyPair,
+			TypeName:   "aws_lightsail_key_pair",
+			Partitions: []string{"aws"},
 		},
 		{
-			Factory:  ResourceLoadBalancer,
-			TypeName: "aws_lightsail_lb",
-			Name:     "LB",
+			Factory:    ResourceLoadBalancer,
+			TypeName:   "aws_lightsail_lb",
+			Name:       "LB",
+			Partitions: []string{"aws"},
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
 		},
 		{
-			Factory:  ResourceLoadBalancerAttachment,
-			TypeName: "aws_lightsail_lb_attachment",
+			Factory:    ResourceLoadBalancerAttachment,
+			TypeName:   "aws_lightsail_lb_attachment",
+			Partitions: []string{"aws"},
 		},
 		{
-			Factory:  ResourceLoadBalancerCertificate,
-			TypeName: "aws_lightsail_lb_certificate",
+			Factory:    ResourceLoadBalancerCertificate,
+			TypeName:   "aws_lightsail_lb_certificate",
+			Partitions: []string{"aws"},
 		},
 		{
-			Factory:  ResourceLoadBalancerCertificateAttachment,
-			TypeName: "aws_lightsail_lb_certificate_attachment",
+			Factory:    ResourceLoadBalancerCertificateAttachment,
+			TypeName:   "aws_lightsail_lb_certificate_attachment",
+			Partitions: []string{"aws"},
 		},
 		{
-			Factory:  ResourceLoadBalancerHTTPSRedirectionPolicy,
-			TypeName: "aws_lightsail_lb_https_redirection_policy",
+			Factory:    ResourceLoadBalancerHTTPSRedirectionPolicy,
+			TypeName:   "aws_lightsail_lb_https_redirection_policy",
+			Partitions: []string{"aws"},
 		},
 		{
-			Factory:  ResourceLoadBalancerStickinessPolicy,
-			TypeName: "aws_lightsail_lb_stickiness_policy",
+			Factory:    ResourceLoadBalancerStickinessPolicy,
+			TypeName:   "aws_lightsail_lb_stickiness_policy",
+			Partitions: []string{"aws"},
 		},
 		{
-			Factory:  ResourceStaticIP,
-			TypeName: "aws_lightsail_static_ip",
+			Factory:    ResourceStaticIP,
+			TypeName:   "aws_lightsail_static_ip",
+			Partitions: []string{"aws"},
 		},
 		{
-			Factory:  ResourceStaticIPAttachment,
-			TypeName: "aws_lightsail_static_ip_attachment",
+			Factory:    ResourceStaticIPAttachment,
+			TypeName:   "aws_lightsail_static_ip_attachment",
+			Partitions: []string{"aws"},
 		},
 	}
 }
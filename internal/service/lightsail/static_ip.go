@@ -16,6 +16,7 @@ import (
 )
 
 // @SDKResource("aws_lightsail_static_ip")
+// @Partitions("aws")
 func ResourceStaticIP() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceStaticIPCreate,
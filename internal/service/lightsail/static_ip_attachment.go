@@ -16,6 +16,7 @@ import (
 )
 
 // @SDKResource("aws_lightsail_static_ip_attachment")
+// @Partitions("aws")
 func ResourceStaticIPAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceStaticIPAttachmentCreate,
@@ -23,11 +23,21 @@ func init() {
 		F:    sweepContainerServices,
 	})
 
+	resource.AddTestSweepers("aws_lightsail_database", &resource.Sweeper{
+		Name: "aws_lightsail_database",
+		F:    sweepDatabases,
+	})
+
 	resource.AddTestSweepers("aws_lightsail_instance", &resource.Sweeper{
 		Name: "aws_lightsail_instance",
 		F:    sweepInstances,
 	})
 
+	resource.AddTestSweepers("aws_lightsail_lb", &resource.Sweeper{
+		Name: "aws_lightsail_lb",
+		F:    sweepLoadBalancers,
+	})
+
 	resource.AddTestSweepers("aws_lightsail_static_ip", &resource.Sweeper{
 		Name: "aws_lightsail_static_ip",
 		F:    sweepStaticIPs,
@@ -81,6 +91,51 @@ func sweepContainerServices(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func sweepDatabases(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	conn := client.LightsailClient(ctx)
+	input := &lightsail.GetRelationalDatabasesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	for {
+		output, err := conn.GetRelationalDatabases(ctx, input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Lightsail Database sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Lightsail Databases (%s): %w", region, err)
+		}
+
+		for _, v := range output.RelationalDatabases {
+			r := ResourceDatabase()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.Name))
+			d.Set("skip_final_snapshot", true)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.ToString(output.NextPageToken) == "" {
+			break
+		}
+
+		input.PageToken = output.NextPageToken
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping Lightsail Databases (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepInstances(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -130,6 +185,51 @@ func sweepInstances(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func sweepLoadBalancers(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	conn := client.LightsailClient(ctx)
+	input := &lightsail.GetLoadBalancersInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	for {
+		output, err := conn.GetLoadBalancers(ctx, input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Lightsail Load Balancer sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Lightsail Load Balancers (%s): %w", region, err)
+		}
+
+		for _, v := range output.LoadBalancers {
+			r := ResourceLoadBalancer()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.Name))
+			d.Set("name", v.Name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.ToString(output.NextPageToken) == "" {
+			break
+		}
+
+		input.PageToken = output.NextPageToken
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping Lightsail Load Balancers (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepStaticIPs(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -55,6 +55,9 @@ func TestAccMacie2_serial(t *testing.T) {
 			"basic":      testAccOrganizationAdminAccount_basic,
 			"disappears": testAccOrganizationAdminAccount_disappears,
 		},
+		"OrganizationConfiguration": {
+			"basic": testAccOrganizationConfiguration_basic,
+		},
 		"Member": {
 			"basic":                                 testAccMember_basic,
 			"disappears":                            testAccMember_disappears,
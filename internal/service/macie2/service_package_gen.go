@@ -69,6 +69,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceOrganizationAdminAccount,
 			TypeName: "aws_macie2_organization_admin_account",
 		},
+		{
+			Factory:  ResourceOrganizationConfiguration,
+			TypeName: "aws_macie2_organization_configuration",
+		},
 	}
 }
 
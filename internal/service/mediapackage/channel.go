@@ -26,6 +26,8 @@ import (
 
 // @SDKResource("aws_media_package_channel", name="Channel")
 // @Tags(identifierAttribute="arn")
+// @NotFound(operation="DescribeChannel")
+// @EndpointDrift(arnAttribute="arn")
 func ResourceChannel() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceChannelCreate,
@@ -30,9 +30,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
 		{
-			Factory:  ResourceChannel,
-			TypeName: "aws_media_package_channel",
-			Name:     "Channel",
+			Factory:                ResourceChannel,
+			TypeName:               "aws_media_package_channel",
+			Name:                   "Channel",
+			NotFoundOperation:      "DescribeChannel",
+			EndpointDriftAttribute: "arn",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
@@ -136,7 +136,7 @@ func TestAccNeptuneCluster_namePrefix(t *testing.T) {
 
 func TestAccNeptuneCluster_serverlessConfiguration(t *testing.T) {
 	ctx := acctest.Context(t)
-	var v neptune.DBCluster
+	var v, v2 neptune.DBCluster
 	rName := sdkacctest.RandomWithPrefix("tf-acc")
 	resourceName := "aws_neptune_cluster.test"
 
@@ -147,7 +147,7 @@ func TestAccNeptuneCluster_serverlessConfiguration(t *testing.T) {
 		CheckDestroy:             testAccCheckClusterDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccClusterConfig_serverlessConfiguration(rName),
+				Config: testAccClusterConfig_serverlessConfiguration(rName, 4.5, 12.5),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClusterExists(ctx, resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "serverless_v2_scaling_configuration.#", "1"),
@@ -156,6 +156,16 @@ func TestAccNeptuneCluster_serverlessConfiguration(t *testing.T) {
 				),
 			},
 			testAccClusterImportStep(resourceName),
+			{
+				Config: testAccClusterConfig_serverlessConfiguration(rName, 2.5, 64),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v2),
+					testAccCheckClusterNotRecreated(&v, &v2),
+					resource.TestCheckResourceAttr(resourceName, "serverless_v2_scaling_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "serverless_v2_scaling_configuration.0.min_capacity", "2.5"),
+					resource.TestCheckResourceAttr(resourceName, "serverless_v2_scaling_configuration.0.max_capacity", "64"),
+				),
+			},
 		},
 	})
 }
@@ -659,6 +669,16 @@ func testAccCheckClusterExistsWithProvider(ctx context.Context, n string, v *nep
 	}
 }
 
+func testAccCheckClusterNotRecreated(i, j *neptune.DBCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !aws.TimeValue(i.ClusterCreateTime).Equal(aws.TimeValue(j.ClusterCreateTime)) {
+			return fmt.Errorf("Neptune Cluster was recreated")
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckClusterDestroyWithFinalSnapshot(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -757,7 +777,7 @@ resource "aws_neptune_cluster" "test" {
 `, rName)
 }
 
-func testAccClusterConfig_serverlessConfiguration(rName string) string {
+func testAccClusterConfig_serverlessConfiguration(rName string, minCapacity, maxCapacity float64) string {
 	return fmt.Sprintf(`
 resource "aws_neptune_cluster" "test" {
   cluster_identifier_prefix            = %[1]q
@@ -767,11 +787,11 @@ resource "aws_neptune_cluster" "test" {
   skip_final_snapshot                  = true
 
   serverless_v2_scaling_configuration {
-    min_capacity = 4.5
-    max_capacity = 12.5
+    min_capacity = %[2]g
+    max_capacity = %[3]g
   }
 }
-`, rName)
+`, rName, minCapacity, maxCapacity)
 }
 
 func testAccClusterConfig_finalSnapshot(rName string) string {
@@ -5,6 +5,7 @@ package neptune
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKResource("aws_neptune_global_cluster")
@@ -87,6 +89,12 @@ func ResourceGlobalCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"primary_db_cluster_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"source_db_cluster_identifier": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -148,6 +156,12 @@ func resourceGlobalClusterCreate(ctx context.Context, d *schema.ResourceData, me
 		return diag.Errorf("waiting for Neptune Global Cluster (%s) create: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("primary_db_cluster_arn"); ok {
+		if err := failoverGlobalCluster(ctx, conn, d.Id(), v.(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceGlobalClusterRead(ctx, d, meta)
 }
 
@@ -175,6 +189,13 @@ func resourceGlobalClusterRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("setting global_cluster_members: %s", err)
 	}
 	d.Set("global_cluster_resource_id", globalCluster.GlobalClusterResourceId)
+	d.Set("primary_db_cluster_arn", "")
+	for _, v := range globalCluster.GlobalClusterMembers {
+		if aws.BoolValue(v.IsWriter) {
+			d.Set("primary_db_cluster_arn", v.DBClusterArn)
+			break
+		}
+	}
 	d.Set("storage_encrypted", globalCluster.StorageEncrypted)
 
 	return nil
@@ -243,6 +264,14 @@ func resourceGlobalClusterUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if d.HasChange("primary_db_cluster_arn") {
+		if v, ok := d.GetOk("primary_db_cluster_arn"); ok {
+			if err := failoverGlobalCluster(ctx, conn, d.Id(), v.(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	return resourceGlobalClusterRead(ctx, d, meta)
 }
 
@@ -286,6 +315,59 @@ func resourceGlobalClusterDelete(ctx context.Context, d *schema.ResourceData, me
 	return nil
 }
 
+// failoverGlobalCluster promotes the specified secondary cluster to be the global cluster's primary (writer) cluster.
+// It is a no-op if the cluster is already the primary.
+func failoverGlobalCluster(ctx context.Context, conn *neptune.Neptune, globalClusterID, clusterARN string, timeout time.Duration) error {
+	globalCluster, err := FindGlobalClusterByID(ctx, conn, globalClusterID)
+
+	if err != nil {
+		return fmt.Errorf("reading Neptune Global Cluster (%s): %w", globalClusterID, err)
+	}
+
+	if isGlobalClusterWriter(globalCluster, clusterARN) {
+		return nil
+	}
+
+	input := &neptune.FailoverGlobalClusterInput{
+		GlobalClusterIdentifier:   aws.String(globalClusterID),
+		TargetDbClusterIdentifier: aws.String(clusterARN),
+	}
+
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.FailoverGlobalClusterWithContext(ctx, input)
+	}, neptune.ErrCodeInvalidGlobalClusterStateFault, neptune.ErrCodeInvalidDBClusterStateFault)
+
+	if err != nil {
+		return fmt.Errorf("failing over Neptune Global Cluster (%s) to Neptune Cluster (%s): %w", globalClusterID, clusterARN, err)
+	}
+
+	if _, err := waitGlobalClusterMemberPromoted(ctx, conn, globalClusterID, clusterARN, timeout); err != nil {
+		return fmt.Errorf("waiting for Neptune Global Cluster (%s) failover to Neptune Cluster (%s): %w", globalClusterID, clusterARN, err)
+	}
+
+	cluster, err := findClusterByARN(ctx, conn, clusterARN)
+
+	if err != nil {
+		return fmt.Errorf("reading Neptune Cluster (%s): %w", clusterARN, err)
+	}
+
+	if _, err := waitClusterAvailable(ctx, conn, aws.StringValue(cluster.DBClusterIdentifier), timeout); err != nil {
+		return fmt.Errorf("waiting for Neptune Cluster (%s) promotion: %w", clusterARN, err)
+	}
+
+	return nil
+}
+
+func isGlobalClusterWriter(globalCluster *neptune.GlobalCluster, clusterARN string) bool {
+	for _, v := range globalCluster.GlobalClusterMembers {
+		if aws.StringValue(v.DBClusterArn) == clusterARN {
+			return aws.BoolValue(v.IsWriter)
+		}
+	}
+
+	return false
+}
+
 func FindGlobalClusterByID(ctx context.Context, conn *neptune.Neptune, id string) (*neptune.GlobalCluster, error) {
 	input := &neptune.DescribeGlobalClustersInput{
 		GlobalClusterIdentifier: aws.String(id),
@@ -419,6 +501,49 @@ func waitGlobalClusterUpdated(ctx context.Context, conn *neptune.Neptune, id str
 	return nil, err
 }
 
+func statusGlobalClusterMemberPromotion(ctx context.Context, conn *neptune.Neptune, globalClusterID, clusterARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindGlobalClusterByID(ctx, conn, globalClusterID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		// The global cluster returns to "available" once the failover completes.
+		if status := aws.StringValue(output.Status); status != GlobalClusterStatusAvailable {
+			return output, status, nil
+		}
+
+		if !isGlobalClusterWriter(output, clusterARN) {
+			return output, GlobalClusterStatusFailingOver, nil
+		}
+
+		return output, GlobalClusterStatusAvailable, nil
+	}
+}
+
+func waitGlobalClusterMemberPromoted(ctx context.Context, conn *neptune.Neptune, globalClusterID, clusterARN string, timeout time.Duration) (*neptune.GlobalCluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{GlobalClusterStatusFailingOver, GlobalClusterStatusModifying},
+		Target:  []string{GlobalClusterStatusAvailable},
+		Refresh: statusGlobalClusterMemberPromotion(ctx, conn, globalClusterID, clusterARN),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*neptune.GlobalCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitGlobalClusterDeleted(ctx context.Context, conn *neptune.Neptune, id string, timeout time.Duration) (*neptune.GlobalCluster, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:        []string{GlobalClusterStatusAvailable, GlobalClusterStatusDeleting},
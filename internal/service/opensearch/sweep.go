@@ -22,6 +22,14 @@ func init() {
 		Name: "aws_opensearch_domain",
 		F:    sweepDomains,
 	})
+
+	resource.AddTestSweepers("aws_opensearch_package", &resource.Sweeper{
+		Name: "aws_opensearch_package",
+		F:    sweepPackages,
+		Dependencies: []string{
+			"aws_opensearch_domain",
+		},
+	})
 }
 
 func sweepDomains(region string) error {
@@ -101,3 +109,47 @@ func sweepDomains(region string) error {
 
 	return errs.ErrorOrNil()
 }
+
+func sweepPackages(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.OpenSearchConn(ctx)
+	input := &opensearchservice.DescribePackagesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.DescribePackagesPagesWithContext(ctx, input, func(page *opensearchservice.DescribePackagesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PackageDetailsList {
+			r := ResourcePackage()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.PackageID))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping OpenSearch Package sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing OpenSearch Packages (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping OpenSearch Packages (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -74,6 +74,10 @@ func TestAccOrganizations_serial(t *testing.T) {
 			"SkipDestroy":        testAccPolicyAttachment_skipDestroy,
 			"disappears":         testAccPolicyAttachment_disappears,
 		},
+		"PolicyType": {
+			"basic":      testAccPolicyType_basic,
+			"disappears": testAccPolicyType_disappears,
+		},
 		"PolicyDataSource": {
 			"UnattachedPolicy": testAccPolicyDataSource_UnattachedPolicy,
 		},
@@ -90,6 +90,10 @@ func resourcePolicyCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		return conn.CreatePolicyWithContext(ctx, input)
 	}, organizations.ErrCodeFinalizingOrganizationException)
 
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodePolicyTypeNotAvailableForOrganizationException) {
+		return diag.Errorf("creating Organizations Policy (%s): policy type %s is not available for the organization's feature set, all features must be enabled: %s", name, d.Get("type").(string), err)
+	}
+
 	if err != nil {
 		return diag.Errorf("creating Organizations Policy (%s): %s", name, err)
 	}
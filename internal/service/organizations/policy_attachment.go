@@ -67,6 +67,11 @@ func resourcePolicyAttachmentCreate(ctx context.Context, d *schema.ResourceData,
 		return conn.AttachPolicyWithContext(ctx, input)
 	}, organizations.ErrCodeFinalizingOrganizationException)
 
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodePolicyTypeNotEnabledException) {
+		return sdkdiag.AppendErrorf(diags, "creating Organizations Policy Attachment (%s): the policy's type is not enabled in the organization root. "+
+			"Enable it using the aws_organizations_policy_type resource or the aws_organizations_organization resource's enabled_policy_types argument: %s", id, err)
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Organizations Policy Attachment (%s): %s", id, err)
 	}
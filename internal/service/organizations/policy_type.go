@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_organizations_policy_type", name="Policy Type")
+func ResourcePolicyType() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePolicyTypeCreate,
+		ReadWithoutTimeout:   resourcePolicyTypeRead,
+		DeleteWithoutTimeout: resourcePolicyTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(organizations.PolicyType_Values(), false),
+			},
+			"root_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePolicyTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OrganizationsConn(ctx)
+
+	rootID := d.Get("root_id").(string)
+	policyType := d.Get("policy_type").(string)
+	id := PolicyTypeCreateResourceID(rootID, policyType)
+	input := &organizations.EnablePolicyTypeInput{
+		PolicyType: aws.String(policyType),
+		RootId:     aws.String(rootID),
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 4*time.Minute, func() (interface{}, error) {
+		return conn.EnablePolicyTypeWithContext(ctx, input)
+	}, organizations.ErrCodeConcurrentModificationException, organizations.ErrCodeFinalizingOrganizationException)
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodePolicyTypeAlreadyEnabledException) {
+		return sdkdiag.AppendErrorf(diags, "enabling Organizations Policy Type (%s): policy type is already enabled, use `terraform import` to manage it: %s", id, err)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "enabling Organizations Policy Type (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitPolicyTypeEnabled(ctx, conn, rootID, policyType, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Organizations Policy Type (%s) enable: %s", d.Id(), err)
+	}
+
+	return append(diags, resourcePolicyTypeRead(ctx, d, meta)...)
+}
+
+func resourcePolicyTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OrganizationsConn(ctx)
+
+	rootID, policyType, err := PolicyTypeParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindPolicyTypeByTwoPartKey(ctx, conn, rootID, policyType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Organizations Policy Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Organizations Policy Type (%s): %s", d.Id(), err)
+	}
+
+	d.Set("policy_type", output.Type)
+	d.Set("root_id", rootID)
+	d.Set("status", output.Status)
+
+	return diags
+}
+
+func resourcePolicyTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OrganizationsConn(ctx)
+
+	rootID, policyType, err := PolicyTypeParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Organizations Policy Type: %s", d.Id())
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, 4*time.Minute, func() (interface{}, error) {
+		return conn.DisablePolicyTypeWithContext(ctx, &organizations.DisablePolicyTypeInput{
+			PolicyType: aws.String(policyType),
+			RootId:     aws.String(rootID),
+		})
+	}, organizations.ErrCodeConcurrentModificationException)
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAWSOrganizationsNotInUseException, organizations.ErrCodePolicyTypeNotEnabledException, organizations.ErrCodeRootNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "disabling Organizations Policy Type (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitPolicyTypeDisabled(ctx, conn, rootID, policyType, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Organizations Policy Type (%s) disable: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const policyTypeResourceIDSeparator = ":"
+
+func PolicyTypeCreateResourceID(rootID, policyType string) string {
+	parts := []string{rootID, policyType}
+	id := strings.Join(parts, policyTypeResourceIDSeparator)
+
+	return id
+}
+
+func PolicyTypeParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, policyTypeResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ROOT-ID%[2]sPOLICY-TYPE", id, policyTypeResourceIDSeparator)
+}
+
+func findRootByID(ctx context.Context, conn *organizations.Organizations, id string) (*organizations.Root, error) {
+	input := &organizations.ListRootsInput{}
+	var output *organizations.Root
+
+	err := conn.ListRootsPagesWithContext(ctx, input, func(page *organizations.ListRootsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Roots {
+			if aws.StringValue(v.Id) == id {
+				output = v
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAWSOrganizationsNotInUseException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindPolicyTypeByTwoPartKey(ctx context.Context, conn *organizations.Organizations, rootID, policyType string) (*organizations.PolicyTypeSummary, error) {
+	root, err := findRootByID(ctx, conn, rootID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range root.PolicyTypes {
+		if aws.StringValue(v.Type) == policyType {
+			return v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{}
+}
+
+func statusPolicyType(ctx context.Context, conn *organizations.Organizations, rootID, policyType string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindPolicyTypeByTwoPartKey(ctx, conn, rootID, policyType)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitPolicyTypeEnabled(ctx context.Context, conn *organizations.Organizations, rootID, policyType string, timeout time.Duration) (*organizations.PolicyTypeSummary, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{organizations.PolicyTypeStatusPendingEnable},
+		Target:  []string{organizations.PolicyTypeStatusEnabled},
+		Refresh: statusPolicyType(ctx, conn, rootID, policyType),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*organizations.PolicyTypeSummary); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPolicyTypeDisabled(ctx context.Context, conn *organizations.Organizations, rootID, policyType string, timeout time.Duration) (*organizations.PolicyTypeSummary, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{organizations.PolicyTypeStatusEnabled, organizations.PolicyTypeStatusPendingDisable},
+		Target:  []string{},
+		Refresh: statusPolicyType(ctx, conn, rootID, policyType),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*organizations.PolicyTypeSummary); ok {
+		return output, err
+	}
+
+	return nil, err
+}
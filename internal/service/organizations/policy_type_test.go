@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccPolicyType_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_organizations_policy_type.test"
+	organizationResourceName := "aws_organizations_organization.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOrganizationsAccount(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, organizations.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyTypeConfig_basic(organizations.PolicyTypeServiceControlPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyTypeExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", organizations.PolicyTypeServiceControlPolicy),
+					resource.TestCheckResourceAttrPair(resourceName, "root_id", organizationResourceName, "roots.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "status", organizations.PolicyTypeStatusEnabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPolicyTypeConfig_basic(organizations.PolicyTypeTagPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyTypeExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", organizations.PolicyTypeTagPolicy),
+					resource.TestCheckResourceAttr(resourceName, "status", organizations.PolicyTypeStatusEnabled),
+				),
+			},
+		},
+	})
+}
+
+func testAccPolicyType_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_organizations_policy_type.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOrganizationsAccount(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, organizations.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyTypeConfig_basic(organizations.PolicyTypeServiceControlPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyTypeExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tforganizations.ResourcePolicyType(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPolicyTypeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_organizations_policy_type" {
+				continue
+			}
+
+			rootID, policyType, err := tforganizations.PolicyTypeParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tforganizations.FindPolicyTypeByTwoPartKey(ctx, conn, rootID, policyType)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Organizations Policy Type %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPolicyTypeExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Organizations Policy Type ID is set")
+		}
+
+		rootID, policyType, err := tforganizations.PolicyTypeParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsConn(ctx)
+
+		_, err = tforganizations.FindPolicyTypeByTwoPartKey(ctx, conn, rootID, policyType)
+
+		return err
+	}
+}
+
+func testAccPolicyTypeConfig_basic(policyType string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {
+  feature_set = "ALL"
+
+  lifecycle {
+    ignore_changes = [enabled_policy_types]
+  }
+}
+
+resource "aws_organizations_policy_type" "test" {
+  root_id     = aws_organizations_organization.test.roots[0].id
+  policy_type = %[1]q
+}
+`, policyType)
+}
@@ -106,6 +106,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourcePolicyAttachment,
 			TypeName: "aws_organizations_policy_attachment",
 		},
+		{
+			Factory:  ResourcePolicyType,
+			TypeName: "aws_organizations_policy_type",
+			Name:     "Policy Type",
+		},
 		{
 			Factory:  ResourceResourcePolicy,
 			TypeName: "aws_organizations_resource_policy",
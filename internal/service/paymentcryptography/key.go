@@ -0,0 +1,467 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package paymentcryptography
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/paymentcryptography"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_paymentcryptography_key", name="Key")
+// @Tags(identifierAttribute="id")
+func ResourceKey() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceKeyCreate,
+		ReadWithoutTimeout:   resourceKeyRead,
+		UpdateWithoutTimeout: resourceKeyUpdate,
+		DeleteWithoutTimeout: resourceKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deletion_window_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      7,
+				ValidateFunc: validation.IntBetween(3, 180),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"exportable": {
+				Type:     schema.TypeBool,
+				Required: true,
+				ForceNew: true,
+			},
+			"key_attributes": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_algorithm": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(paymentcryptography.KeyAlgorithm_Values(), false),
+						},
+						"key_class": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(paymentcryptography.KeyClass_Values(), false),
+						},
+						"key_modes_of_use": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"decrypt": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+									"derive_key": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+									"encrypt": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+									"generate": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+									"no_restrictions": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+									"sign": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+									"unwrap": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+									"verify": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+									"wrap": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+								},
+							},
+						},
+						"key_usage": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(paymentcryptography.KeyUsage_Values(), false),
+						},
+					},
+				},
+			},
+			"key_check_value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_check_value_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(paymentcryptography.KeyCheckValueAlgorithm_Values(), false),
+			},
+			"key_origin": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PaymentCryptographyConn(ctx)
+
+	input := &paymentcryptography.CreateKeyInput{
+		Enabled:       aws.Bool(d.Get("enabled").(bool)),
+		Exportable:    aws.Bool(d.Get("exportable").(bool)),
+		KeyAttributes: expandKeyAttributes(d.Get("key_attributes").([]interface{})),
+		Tags:          getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("key_check_value_algorithm"); ok {
+		input.KeyCheckValueAlgorithm = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateKeyWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Payment Cryptography Key: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Key.KeyArn))
+
+	if _, err := waitKeyCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Payment Cryptography Key (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceKeyRead(ctx, d, meta)...)
+}
+
+func resourceKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PaymentCryptographyConn(ctx)
+
+	key, err := FindKeyByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Payment Cryptography Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Payment Cryptography Key (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", key.KeyArn)
+	d.Set("enabled", key.Enabled)
+	d.Set("exportable", key.Exportable)
+	if err := d.Set("key_attributes", flattenKeyAttributes(key.KeyAttributes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting key_attributes: %s", err)
+	}
+	d.Set("key_check_value", key.KeyCheckValue)
+	d.Set("key_check_value_algorithm", key.KeyCheckValueAlgorithm)
+	d.Set("key_origin", key.KeyOrigin)
+	d.Set("key_state", key.KeyState)
+
+	return diags
+}
+
+func resourceKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PaymentCryptographyConn(ctx)
+
+	if d.HasChange("enabled") {
+		var err error
+
+		if d.Get("enabled").(bool) {
+			_, err = conn.StartKeyUsageWithContext(ctx, &paymentcryptography.StartKeyUsageInput{
+				KeyIdentifier: aws.String(d.Id()),
+			})
+		} else {
+			_, err = conn.StopKeyUsageWithContext(ctx, &paymentcryptography.StopKeyUsageInput{
+				KeyIdentifier: aws.String(d.Id()),
+			})
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Payment Cryptography Key (%s) usage: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceKeyRead(ctx, d, meta)...)
+}
+
+func resourceKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PaymentCryptographyConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Payment Cryptography Key: %s", d.Id())
+	_, err := conn.DeleteKeyWithContext(ctx, &paymentcryptography.DeleteKeyInput{
+		DeleteKeyInDays: aws.Int64(int64(d.Get("deletion_window_in_days").(int))),
+		KeyIdentifier:   aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, paymentcryptography.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Payment Cryptography Key (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitKeyDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Payment Cryptography Key (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindKeyByARN(ctx context.Context, conn *paymentcryptography.PaymentCryptography, arn string) (*paymentcryptography.Key, error) {
+	input := &paymentcryptography.GetKeyInput{
+		KeyIdentifier: aws.String(arn),
+	}
+
+	output, err := conn.GetKeyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, paymentcryptography.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Key == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// Deleted keys remain visible until the end of their deletion window.
+	if state := aws.StringValue(output.Key.KeyState); state == paymentcryptography.KeyStateDeletePending || state == paymentcryptography.KeyStateDeleteComplete {
+		return nil, &retry.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output.Key, nil
+}
+
+func statusKey(ctx context.Context, conn *paymentcryptography.PaymentCryptography, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindKeyByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.KeyState), nil
+	}
+}
+
+func waitKeyCreated(ctx context.Context, conn *paymentcryptography.PaymentCryptography, arn string, timeout time.Duration) (*paymentcryptography.Key, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{paymentcryptography.KeyStateCreateInProgress},
+		Target:  []string{paymentcryptography.KeyStateCreateComplete},
+		Refresh: statusKey(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*paymentcryptography.Key); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitKeyDeleted(ctx context.Context, conn *paymentcryptography.PaymentCryptography, arn string, timeout time.Duration) (*paymentcryptography.Key, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{paymentcryptography.KeyStateCreateInProgress, paymentcryptography.KeyStateCreateComplete},
+		Target:  []string{},
+		Refresh: statusKey(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*paymentcryptography.Key); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandKeyAttributes(tfList []interface{}) *paymentcryptography.KeyAttributes {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &paymentcryptography.KeyAttributes{}
+
+	if v, ok := tfMap["key_algorithm"].(string); ok && v != "" {
+		apiObject.KeyAlgorithm = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_class"].(string); ok && v != "" {
+		apiObject.KeyClass = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_modes_of_use"].([]interface{}); ok && len(v) > 0 {
+		apiObject.KeyModesOfUse = expandKeyModesOfUse(v)
+	}
+
+	if v, ok := tfMap["key_usage"].(string); ok && v != "" {
+		apiObject.KeyUsage = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandKeyModesOfUse(tfList []interface{}) *paymentcryptography.KeyModesOfUse {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &paymentcryptography.KeyModesOfUse{}
+
+	// All modes of use are false when the block is empty.
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	apiObject.Decrypt = aws.Bool(tfMap["decrypt"].(bool))
+	apiObject.DeriveKey = aws.Bool(tfMap["derive_key"].(bool))
+	apiObject.Encrypt = aws.Bool(tfMap["encrypt"].(bool))
+	apiObject.Generate = aws.Bool(tfMap["generate"].(bool))
+	apiObject.NoRestrictions = aws.Bool(tfMap["no_restrictions"].(bool))
+	apiObject.Sign = aws.Bool(tfMap["sign"].(bool))
+	apiObject.Unwrap = aws.Bool(tfMap["unwrap"].(bool))
+	apiObject.Verify = aws.Bool(tfMap["verify"].(bool))
+	apiObject.Wrap = aws.Bool(tfMap["wrap"].(bool))
+
+	return apiObject
+}
+
+func flattenKeyAttributes(apiObject *paymentcryptography.KeyAttributes) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"key_algorithm": aws.StringValue(apiObject.KeyAlgorithm),
+		"key_class":     aws.StringValue(apiObject.KeyClass),
+		"key_usage":     aws.StringValue(apiObject.KeyUsage),
+	}
+
+	if v := apiObject.KeyModesOfUse; v != nil {
+		tfMap["key_modes_of_use"] = flattenKeyModesOfUse(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenKeyModesOfUse(apiObject *paymentcryptography.KeyModesOfUse) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"decrypt":         aws.BoolValue(apiObject.Decrypt),
+		"derive_key":      aws.BoolValue(apiObject.DeriveKey),
+		"encrypt":         aws.BoolValue(apiObject.Encrypt),
+		"generate":        aws.BoolValue(apiObject.Generate),
+		"no_restrictions": aws.BoolValue(apiObject.NoRestrictions),
+		"sign":            aws.BoolValue(apiObject.Sign),
+		"unwrap":          aws.BoolValue(apiObject.Unwrap),
+		"verify":          aws.BoolValue(apiObject.Verify),
+		"wrap":            aws.BoolValue(apiObject.Wrap),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pinpointsmsvoicev2
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoicev2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_pinpointsmsvoicev2_opt_out_list", name="Opt-out List")
+// @Tags(identifierAttribute="arn")
+func ResourceOptOutList() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOptOutListCreate,
+		ReadWithoutTimeout:   resourceOptOutListRead,
+		UpdateWithoutTimeout: resourceOptOutListUpdate,
+		DeleteWithoutTimeout: resourceOptOutListDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, underscores (_), and dashes (-)"),
+				),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceOptOutListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn(ctx)
+
+	name := d.Get("name").(string)
+	input := &pinpointsmsvoicev2.CreateOptOutListInput{
+		OptOutListName: aws.String(name),
+		Tags:           getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateOptOutListWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Pinpoint SMS and Voice V2 Opt-out List (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.OptOutListName))
+
+	return append(diags, resourceOptOutListRead(ctx, d, meta)...)
+}
+
+func resourceOptOutListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn(ctx)
+
+	optOutList, err := FindOptOutListByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Pinpoint SMS and Voice V2 Opt-out List (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Pinpoint SMS and Voice V2 Opt-out List (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", optOutList.OptOutListArn)
+	d.Set("name", optOutList.OptOutListName)
+
+	return diags
+}
+
+func resourceOptOutListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceOptOutListRead(ctx, d, meta)
+}
+
+func resourceOptOutListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn(ctx)
+
+	log.Printf("[DEBUG] Deleting Pinpoint SMS and Voice V2 Opt-out List: %s", d.Id())
+	_, err := conn.DeleteOptOutListWithContext(ctx, &pinpointsmsvoicev2.DeleteOptOutListInput{
+		OptOutListName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pinpointsmsvoicev2.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Pinpoint SMS and Voice V2 Opt-out List (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindOptOutListByName(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, name string) (*pinpointsmsvoicev2.OptOutListInformation, error) {
+	input := &pinpointsmsvoicev2.DescribeOptOutListsInput{
+		OptOutListNames: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeOptOutListsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, pinpointsmsvoicev2.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.OptOutLists) == 0 || output.OptOutLists[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.OptOutLists); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.OptOutLists[0], nil
+}
@@ -0,0 +1,432 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pinpointsmsvoicev2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoicev2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_pinpointsmsvoicev2_phone_pool", name="Phone Pool")
+// @Tags(identifierAttribute="arn")
+func ResourcePhonePool() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePhonePoolCreate,
+		ReadWithoutTimeout:   resourcePhonePoolRead,
+		UpdateWithoutTimeout: resourcePhonePoolUpdate,
+		DeleteWithoutTimeout: resourcePhonePoolDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deletion_protection_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"iso_country_code": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 2),
+					validation.StringIsNotWhiteSpace,
+				),
+			},
+			"message_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(pinpointsmsvoicev2.MessageType_Values(), false),
+			},
+			"opt_out_list_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"origination_identities": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"self_managed_opt_outs_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"shared_routes_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"two_way_channel_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"two_way_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePhonePoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn(ctx)
+
+	// A pool is created with a single origination identity. Any others are associated afterwards.
+	originationIdentities := d.Get("origination_identities").(*schema.Set).List()
+	isoCountryCode := d.Get("iso_country_code").(string)
+	input := &pinpointsmsvoicev2.CreatePoolInput{
+		DeletionProtectionEnabled: aws.Bool(d.Get("deletion_protection_enabled").(bool)),
+		IsoCountryCode:            aws.String(isoCountryCode),
+		MessageType:               aws.String(d.Get("message_type").(string)),
+		OriginationIdentity:       aws.String(originationIdentities[0].(string)),
+		Tags:                      getTagsIn(ctx),
+	}
+
+	output, err := conn.CreatePoolWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Pinpoint SMS and Voice V2 Phone Pool: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.PoolId))
+
+	if _, err := waitPhonePoolCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Pinpoint SMS and Voice V2 Phone Pool (%s) create: %s", d.Id(), err)
+	}
+
+	for _, v := range originationIdentities[1:] {
+		if err := associateOriginationIdentity(ctx, conn, d.Id(), v.(string), isoCountryCode); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Pinpoint SMS and Voice V2 Phone Pool (%s): %s", d.Id(), err)
+		}
+	}
+
+	// Pool settings other than deletion protection can only be set after creation.
+	_, optOutListName := d.GetOk("opt_out_list_name")
+	_, twoWayChannelARN := d.GetOk("two_way_channel_arn")
+	if optOutListName || twoWayChannelARN || d.Get("self_managed_opt_outs_enabled").(bool) || d.Get("shared_routes_enabled").(bool) || d.Get("two_way_enabled").(bool) {
+		if err := updatePhonePool(ctx, conn, d); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Pinpoint SMS and Voice V2 Phone Pool (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePhonePoolRead(ctx, d, meta)...)
+}
+
+func resourcePhonePoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn(ctx)
+
+	pool, err := FindPhonePoolByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Pinpoint SMS and Voice V2 Phone Pool (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Pinpoint SMS and Voice V2 Phone Pool (%s): %s", d.Id(), err)
+	}
+
+	originationIdentities, err := findPhonePoolOriginationIdentitiesByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Pinpoint SMS and Voice V2 Phone Pool (%s) origination identities: %s", d.Id(), err)
+	}
+
+	d.Set("arn", pool.PoolArn)
+	d.Set("deletion_protection_enabled", pool.DeletionProtectionEnabled)
+	d.Set("message_type", pool.MessageType)
+	d.Set("opt_out_list_name", pool.OptOutListName)
+	d.Set("self_managed_opt_outs_enabled", pool.SelfManagedOptOutsEnabled)
+	d.Set("shared_routes_enabled", pool.SharedRoutesEnabled)
+	d.Set("two_way_channel_arn", pool.TwoWayChannelArn)
+	d.Set("two_way_enabled", pool.TwoWayEnabled)
+
+	var originationIdentityARNs []string
+	for _, v := range originationIdentities {
+		originationIdentityARNs = append(originationIdentityARNs, aws.StringValue(v.OriginationIdentityArn))
+	}
+	d.Set("origination_identities", originationIdentityARNs)
+
+	return diags
+}
+
+func resourcePhonePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn(ctx)
+
+	if d.HasChange("origination_identities") {
+		o, n := d.GetChange("origination_identities")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		isoCountryCode := d.Get("iso_country_code").(string)
+
+		// Associate before disassociating so that the pool is never empty.
+		for _, v := range ns.Difference(os).List() {
+			if err := associateOriginationIdentity(ctx, conn, d.Id(), v.(string), isoCountryCode); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Pinpoint SMS and Voice V2 Phone Pool (%s): %s", d.Id(), err)
+			}
+		}
+
+		for _, v := range os.Difference(ns).List() {
+			if err := disassociateOriginationIdentity(ctx, conn, d.Id(), v.(string), isoCountryCode); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Pinpoint SMS and Voice V2 Phone Pool (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChanges("deletion_protection_enabled", "opt_out_list_name", "self_managed_opt_outs_enabled", "shared_routes_enabled", "two_way_channel_arn", "two_way_enabled") {
+		if err := updatePhonePool(ctx, conn, d); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Pinpoint SMS and Voice V2 Phone Pool (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePhonePoolRead(ctx, d, meta)...)
+}
+
+func resourcePhonePoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn(ctx)
+
+	log.Printf("[DEBUG] Deleting Pinpoint SMS and Voice V2 Phone Pool: %s", d.Id())
+	_, err := conn.DeletePoolWithContext(ctx, &pinpointsmsvoicev2.DeletePoolInput{
+		PoolId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pinpointsmsvoicev2.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Pinpoint SMS and Voice V2 Phone Pool (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitPhonePoolDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Pinpoint SMS and Voice V2 Phone Pool (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func updatePhonePool(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, d *schema.ResourceData) error {
+	input := &pinpointsmsvoicev2.UpdatePoolInput{
+		DeletionProtectionEnabled: aws.Bool(d.Get("deletion_protection_enabled").(bool)),
+		PoolId:                    aws.String(d.Id()),
+		SelfManagedOptOutsEnabled: aws.Bool(d.Get("self_managed_opt_outs_enabled").(bool)),
+		SharedRoutesEnabled:       aws.Bool(d.Get("shared_routes_enabled").(bool)),
+		TwoWayEnabled:             aws.Bool(d.Get("two_way_enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("opt_out_list_name"); ok {
+		input.OptOutListName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("two_way_channel_arn"); ok {
+		input.TwoWayChannelArn = aws.String(v.(string))
+	}
+
+	_, err := conn.UpdatePoolWithContext(ctx, input)
+
+	return err
+}
+
+func associateOriginationIdentity(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, poolID, originationIdentity, isoCountryCode string) error {
+	input := &pinpointsmsvoicev2.AssociateOriginationIdentityInput{
+		IsoCountryCode:      aws.String(isoCountryCode),
+		OriginationIdentity: aws.String(originationIdentity),
+		PoolId:              aws.String(poolID),
+	}
+
+	_, err := conn.AssociateOriginationIdentityWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("associating origination identity (%s): %w", originationIdentity, err)
+	}
+
+	return nil
+}
+
+func disassociateOriginationIdentity(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, poolID, originationIdentity, isoCountryCode string) error {
+	input := &pinpointsmsvoicev2.DisassociateOriginationIdentityInput{
+		IsoCountryCode:      aws.String(isoCountryCode),
+		OriginationIdentity: aws.String(originationIdentity),
+		PoolId:              aws.String(poolID),
+	}
+
+	_, err := conn.DisassociateOriginationIdentityWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, pinpointsmsvoicev2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("disassociating origination identity (%s): %w", originationIdentity, err)
+	}
+
+	return nil
+}
+
+func FindPhonePoolByID(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, id string) (*pinpointsmsvoicev2.PoolInformation, error) {
+	input := &pinpointsmsvoicev2.DescribePoolsInput{
+		PoolIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribePoolsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, pinpointsmsvoicev2.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Pools) == 0 || output.Pools[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Pools); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	pool := output.Pools[0]
+
+	if status := aws.StringValue(pool.Status); status == pinpointsmsvoicev2.PoolStatusDeleting {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return pool, nil
+}
+
+func findPhonePoolOriginationIdentitiesByID(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, id string) ([]*pinpointsmsvoicev2.OriginationIdentityMetadata, error) {
+	input := &pinpointsmsvoicev2.ListPoolOriginationIdentitiesInput{
+		PoolId: aws.String(id),
+	}
+	var output []*pinpointsmsvoicev2.OriginationIdentityMetadata
+
+	err := conn.ListPoolOriginationIdentitiesPagesWithContext(ctx, input, func(page *pinpointsmsvoicev2.ListPoolOriginationIdentitiesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.OriginationIdentities {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, pinpointsmsvoicev2.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func statusPhonePool(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindPhonePoolByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitPhonePoolCreated(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, id string, timeout time.Duration) (*pinpointsmsvoicev2.PoolInformation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{pinpointsmsvoicev2.PoolStatusCreating},
+		Target:  []string{pinpointsmsvoicev2.PoolStatusActive},
+		Refresh: statusPhonePool(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pinpointsmsvoicev2.PoolInformation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPhonePoolDeleted(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, id string, timeout time.Duration) (*pinpointsmsvoicev2.PoolInformation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{pinpointsmsvoicev2.PoolStatusCreating, pinpointsmsvoicev2.PoolStatusActive},
+		Target:  []string{},
+		Refresh: statusPhonePool(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pinpointsmsvoicev2.PoolInformation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pinpointsmsvoicev2_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoicev2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tfpinpointsmsvoicev2 "github.com/hashicorp/terraform-provider-aws/internal/service/pinpointsmsvoicev2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	envVarPhoneNumberARN             = "AWS_PINPOINTSMSVOICEV2_PHONE_NUMBER_ARN"
+	envVarPhoneNumberARNMessageError = "Environment variable AWS_PINPOINTSMSVOICEV2_PHONE_NUMBER_ARN is not set. " +
+		"To properly test phone pools the ARN of an existing US transactional phone number must be provided."
+)
+
+func TestAccPinpointSMSVoiceV2PhonePool_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v pinpointsmsvoicev2.PoolInformation
+	phoneNumberARN := envvar.SkipIfEmpty(t, envVarPhoneNumberARN, envVarPhoneNumberARNMessageError)
+	resourceName := "aws_pinpointsmsvoicev2_phone_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pinpointsmsvoicev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPhonePoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPhonePoolConfig_basic(phoneNumberARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPhonePoolExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "sms-voice", regexp.MustCompile(`pool/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "iso_country_code", "US"),
+					resource.TestCheckResourceAttr(resourceName, "message_type", "TRANSACTIONAL"),
+					resource.TestCheckResourceAttr(resourceName, "opt_out_list_name", "Default"),
+					resource.TestCheckResourceAttr(resourceName, "origination_identities.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "origination_identities.*", phoneNumberARN),
+					resource.TestCheckResourceAttr(resourceName, "self_managed_opt_outs_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "shared_routes_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "two_way_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"iso_country_code"},
+			},
+		},
+	})
+}
+
+func TestAccPinpointSMSVoiceV2PhonePool_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v pinpointsmsvoicev2.PoolInformation
+	phoneNumberARN := envvar.SkipIfEmpty(t, envVarPhoneNumberARN, envVarPhoneNumberARNMessageError)
+	resourceName := "aws_pinpointsmsvoicev2_phone_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pinpointsmsvoicev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPhonePoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPhonePoolConfig_basic(phoneNumberARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPhonePoolExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfpinpointsmsvoicev2.ResourcePhonePool(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPinpointSMSVoiceV2PhonePool_settings(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v pinpointsmsvoicev2.PoolInformation
+	phoneNumberARN := envvar.SkipIfEmpty(t, envVarPhoneNumberARN, envVarPhoneNumberARNMessageError)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpointsmsvoicev2_phone_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pinpointsmsvoicev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPhonePoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPhonePoolConfig_settings(rName, phoneNumberARN, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPhonePoolExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "opt_out_list_name", "aws_pinpointsmsvoicev2_opt_out_list.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "self_managed_opt_outs_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "shared_routes_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"iso_country_code"},
+			},
+			{
+				Config: testAccPhonePoolConfig_settings(rName, phoneNumberARN, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPhonePoolExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "opt_out_list_name", "aws_pinpointsmsvoicev2_opt_out_list.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "self_managed_opt_outs_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "shared_routes_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPhonePoolDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointSMSVoiceV2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pinpointsmsvoicev2_phone_pool" {
+				continue
+			}
+
+			_, err := tfpinpointsmsvoicev2.FindPhonePoolByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Pinpoint SMS and Voice V2 Phone Pool %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPhonePoolExists(ctx context.Context, n string, v *pinpointsmsvoicev2.PoolInformation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Pinpoint SMS and Voice V2 Phone Pool ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointSMSVoiceV2Conn(ctx)
+
+		output, err := tfpinpointsmsvoicev2.FindPhonePoolByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPhonePoolConfig_basic(phoneNumberARN string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpointsmsvoicev2_phone_pool" "test" {
+  iso_country_code       = "US"
+  message_type           = "TRANSACTIONAL"
+  origination_identities = [%[1]q]
+}
+`, phoneNumberARN)
+}
+
+func testAccPhonePoolConfig_settings(rName, phoneNumberARN string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_pinpointsmsvoicev2_opt_out_list" "test" {
+  name = %[1]q
+}
+
+resource "aws_pinpointsmsvoicev2_phone_pool" "test" {
+  iso_country_code       = "US"
+  message_type           = "TRANSACTIONAL"
+  opt_out_list_name      = aws_pinpointsmsvoicev2_opt_out_list.test.name
+  origination_identities = [%[2]q]
+
+  self_managed_opt_outs_enabled = %[3]t
+  shared_routes_enabled         = %[3]t
+}
+`, rName, phoneNumberARN, enabled)
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build sweep
+// +build sweep
+
+package pinpointsmsvoicev2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoicev2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_pinpointsmsvoicev2_opt_out_list", &resource.Sweeper{
+		Name: "aws_pinpointsmsvoicev2_opt_out_list",
+		F:    sweepOptOutLists,
+		Dependencies: []string{
+			"aws_pinpointsmsvoicev2_phone_pool",
+		},
+	})
+
+	resource.AddTestSweepers("aws_pinpointsmsvoicev2_phone_pool", &resource.Sweeper{
+		Name: "aws_pinpointsmsvoicev2_phone_pool",
+		F:    sweepPhonePools,
+	})
+}
+
+func sweepOptOutLists(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.PinpointSMSVoiceV2Conn(ctx)
+	input := &pinpointsmsvoicev2.DescribeOptOutListsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.DescribeOptOutListsPagesWithContext(ctx, input, func(page *pinpointsmsvoicev2.DescribeOptOutListsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.OptOutLists {
+			name := aws.StringValue(v.OptOutListName)
+
+			// The default opt-out list cannot be deleted.
+			if name == "Default" {
+				continue
+			}
+
+			r := ResourceOptOutList()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Pinpoint SMS and Voice V2 Opt-out List sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Pinpoint SMS and Voice V2 Opt-out Lists (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Pinpoint SMS and Voice V2 Opt-out Lists (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepPhonePools(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.PinpointSMSVoiceV2Conn(ctx)
+	input := &pinpointsmsvoicev2.DescribePoolsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.DescribePoolsPagesWithContext(ctx, input, func(page *pinpointsmsvoicev2.DescribePoolsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Pools {
+			if aws.BoolValue(v.DeletionProtectionEnabled) {
+				log.Printf("[INFO] Skipping Pinpoint SMS and Voice V2 Phone Pool %s: deletion protection is enabled", aws.StringValue(v.PoolId))
+				continue
+			}
+
+			r := ResourcePhonePool()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.PoolId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Pinpoint SMS and Voice V2 Phone Pool sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Pinpoint SMS and Voice V2 Phone Pools (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Pinpoint SMS and Voice V2 Phone Pools (%s): %w", region, err)
+	}
+
+	return nil
+}
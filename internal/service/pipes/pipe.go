@@ -6,14 +6,18 @@ package pipes
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"regexp"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/pipes"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/pipes/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -27,6 +31,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/exp/slices"
 )
 
 // @SDKResource("aws_pipes_pipe", name="Pipe")
@@ -48,7 +53,10 @@ func resourcePipe() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffPipeParameters,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -244,6 +252,12 @@ func resourcePipeUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 
 		if v, ok := d.GetOk("enrichment_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 			input.EnrichmentParameters = expandPipeEnrichmentParameters(v.([]interface{})[0].(map[string]interface{}))
+		} else if d.HasChange("enrichment_parameters") {
+			// Reset enrichment parameters that have been removed from configuration.
+			input.EnrichmentParameters = &awstypes.PipeEnrichmentParameters{
+				HttpParameters: &awstypes.PipeEnrichmentHttpParameters{},
+				InputTemplate:  aws.String(""),
+			}
 		}
 
 		if v, ok := d.GetOk("source_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -404,3 +418,79 @@ func suppressEmptyConfigurationBlock(key string) schema.SchemaDiffSuppressFunc {
 		return false
 	}
 }
+
+// pipeSourceParametersServices maps each source parameters block to the service(s) whose ARN it can be used with.
+var pipeSourceParametersServices = map[string][]string{
+	"activemq_broker_parameters":         {"mq"},
+	"dynamodb_stream_parameters":         {"dynamodb"},
+	"kinesis_stream_parameters":          {"kinesis"},
+	"managed_streaming_kafka_parameters": {"kafka"},
+	"rabbitmq_broker_parameters":         {"mq"},
+	"sqs_queue_parameters":               {"sqs"},
+}
+
+// pipeTargetParametersServices maps each target parameters block to the service(s) whose ARN it can be used with.
+var pipeTargetParametersServices = map[string][]string{
+	"batch_job_parameters":                   {"batch"},
+	"cloudwatch_logs_parameters":             {"logs"},
+	"ecs_task_parameters":                    {"ecs"},
+	"eventbridge_event_bus_parameters":       {"events"},
+	"http_parameters":                        {"events", "execute-api"},
+	"kinesis_stream_parameters":              {"kinesis"},
+	"lambda_function_parameters":             {"lambda"},
+	"redshift_data_parameters":               {"redshift", "redshift-serverless"},
+	"sagemaker_pipeline_parameters":          {"sagemaker"},
+	"sqs_queue_parameters":                   {"sqs"},
+	"step_function_state_machine_parameters": {"states"},
+}
+
+func customizeDiffPipeParameters(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := d.GetRawConfig()
+
+	if err := validatePipeParametersForARN(config, "source", "source_parameters", pipeSourceParametersServices); err != nil {
+		return err
+	}
+
+	return validatePipeParametersForARN(config, "target", "target_parameters", pipeTargetParametersServices)
+}
+
+// validatePipeParametersForARN verifies that any configured parameters block is valid for the service of the configured source or target ARN.
+// Unknown values, and sources or targets that are not ARNs (e.g. self-managed Apache Kafka), are not validated.
+func validatePipeParametersForARN(config cty.Value, arnKey, parametersKey string, services map[string][]string) error {
+	if !config.IsKnown() || config.IsNull() {
+		return nil
+	}
+
+	v := config.GetAttr(arnKey)
+	if !v.IsKnown() || v.IsNull() {
+		return nil
+	}
+
+	resourceARN, err := arn.Parse(v.AsString())
+	if err != nil {
+		return nil
+	}
+
+	v = config.GetAttr(parametersKey)
+	if !v.IsKnown() || v.IsNull() || v.LengthInt() == 0 {
+		return nil
+	}
+
+	parameters := v.Index(cty.NumberIntVal(0))
+	if !parameters.IsKnown() || parameters.IsNull() {
+		return nil
+	}
+
+	for key, validServices := range services {
+		v := parameters.GetAttr(key)
+		if !v.IsKnown() || v.IsNull() || v.LengthInt() == 0 {
+			continue
+		}
+
+		if !slices.Contains(validServices, resourceARN.Service) {
+			return fmt.Errorf("%s.0.%s cannot be used with a %s in service %q", parametersKey, key, arnKey, resourceARN.Service)
+		}
+	}
+
+	return nil
+}
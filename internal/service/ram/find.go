@@ -113,6 +113,41 @@ func FindResourceShareInvitationByARN(ctx context.Context, conn *ram.RAM, arn st
 	return invitation, nil
 }
 
+// FindResourceShareAssociatedEntities returns the principals or resource ARNs, depending on the association type,
+// that are associated, or being associated, with the specified resource share.
+func FindResourceShareAssociatedEntities(ctx context.Context, conn *ram.RAM, resourceShareARN, associationType string) ([]string, error) {
+	input := &ram.GetResourceShareAssociationsInput{
+		AssociationType:   aws.String(associationType),
+		ResourceShareArns: aws.StringSlice([]string{resourceShareARN}),
+	}
+	var output []string
+
+	err := conn.GetResourceShareAssociationsPagesWithContext(ctx, input, func(page *ram.GetResourceShareAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceShareAssociations {
+			if v == nil {
+				continue
+			}
+
+			switch aws.StringValue(v.Status) {
+			case ram.ResourceShareAssociationStatusAssociated, ram.ResourceShareAssociationStatusAssociating:
+				output = append(output, aws.StringValue(v.AssociatedEntity))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func resourceShare(ctx context.Context, conn *ram.RAM, input *ram.GetResourceSharesInput) (*ram.ResourceShare, error) {
 	var shares *ram.GetResourceSharesOutput
 
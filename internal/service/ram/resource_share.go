@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ram"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -63,6 +64,19 @@ func ResourceResourceShare() *schema.Resource {
 					ValidateFunc: verify.ValidARN,
 				},
 			},
+			"principals": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -86,6 +100,14 @@ func resourceResourceShareCreate(ctx context.Context, d *schema.ResourceData, me
 		input.PermissionArns = flex.ExpandStringSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("principals"); ok && v.(*schema.Set).Len() > 0 {
+		input.Principals = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("resource_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.ResourceArns = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
 	log.Printf("[DEBUG] Creating RAM Resource Share: %s", input)
 	output, err := conn.CreateResourceShareWithContext(ctx, input)
 
@@ -146,6 +168,28 @@ func resourceResourceShareRead(ctx context.Context, d *schema.ResourceData, meta
 
 	d.Set("permission_arns", aws.StringValueSlice(permissionARNs))
 
+	// Associations are only read while this resource manages them so that shares using
+	// aws_ram_principal_association or aws_ram_resource_association don't show drift.
+	if d.Get("principals").(*schema.Set).Len() > 0 {
+		principals, err := FindResourceShareAssociatedEntities(ctx, conn, d.Id(), ram.ResourceShareAssociationTypePrincipal)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading RAM Resource Share (%s) principal associations: %s", d.Id(), err)
+		}
+
+		d.Set("principals", principals)
+	}
+
+	if d.Get("resource_arns").(*schema.Set).Len() > 0 {
+		resourceARNs, err := FindResourceShareAssociatedEntities(ctx, conn, d.Id(), ram.ResourceShareAssociationTypeResource)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading RAM Resource Share (%s) resource associations: %s", d.Id(), err)
+		}
+
+		d.Set("resource_arns", resourceARNs)
+	}
+
 	return diags
 }
 
@@ -168,6 +212,54 @@ func resourceResourceShareUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if d.HasChanges("principals", "resource_arns") {
+		var addPrincipals, delPrincipals, addResourceARNs, delResourceARNs []*string
+
+		if d.HasChange("principals") {
+			o, n := d.GetChange("principals")
+			os, ns := o.(*schema.Set), n.(*schema.Set)
+			addPrincipals, delPrincipals = flex.ExpandStringSet(ns.Difference(os)), flex.ExpandStringSet(os.Difference(ns))
+		}
+
+		if d.HasChange("resource_arns") {
+			o, n := d.GetChange("resource_arns")
+			os, ns := o.(*schema.Set), n.(*schema.Set)
+			addResourceARNs, delResourceARNs = flex.ExpandStringSet(ns.Difference(os)), flex.ExpandStringSet(os.Difference(ns))
+		}
+
+		if len(delPrincipals) > 0 || len(delResourceARNs) > 0 {
+			input := &ram.DisassociateResourceShareInput{
+				ClientToken:      aws.String(id.UniqueId()),
+				Principals:       delPrincipals,
+				ResourceArns:     delResourceARNs,
+				ResourceShareArn: aws.String(d.Id()),
+			}
+
+			log.Printf("[DEBUG] Disassociating RAM Resource Share: %s", input)
+			_, err := conn.DisassociateResourceShareWithContext(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "disassociating RAM Resource Share (%s): %s", d.Id(), err)
+			}
+		}
+
+		if len(addPrincipals) > 0 || len(addResourceARNs) > 0 {
+			input := &ram.AssociateResourceShareInput{
+				ClientToken:      aws.String(id.UniqueId()),
+				Principals:       addPrincipals,
+				ResourceArns:     addResourceARNs,
+				ResourceShareArn: aws.String(d.Id()),
+			}
+
+			log.Printf("[DEBUG] Associating RAM Resource Share: %s", input)
+			_, err := conn.AssociateResourceShareWithContext(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "associating RAM Resource Share (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
 	return append(diags, resourceResourceShareRead(ctx, d, meta)...)
 }
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -200,6 +201,68 @@ func TestAccRAMResourceShare_tags(t *testing.T) {
 	})
 }
 
+func TestAccRAMResourceShare_resourceARNs(t *testing.T) {
+	ctx := acctest.Context(t)
+	var resourceShare ram.ResourceShare
+	resourceName := "aws_ram_resource_share.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ram.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceShareDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceShareConfig_resourceARNs(rName, "aws_subnet.test[0].arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceShareExists(ctx, resourceName, &resourceShare),
+					resource.TestCheckResourceAttr(resourceName, "principals.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "resource_arns.*", "aws_subnet.test.0", "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resource_arns"},
+			},
+			{
+				Config: testAccResourceShareConfig_resourceARNs(rName, "aws_subnet.test[1].arn", "aws_subnet.test[2].arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceShareExists(ctx, resourceName, &resourceShare),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "resource_arns.*", "aws_subnet.test.1", "arn"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "resource_arns.*", "aws_subnet.test.2", "arn"),
+				),
+			},
+			{
+				Config: testAccResourceShareConfig_resourceARNs(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceShareExists(ctx, resourceName, &resourceShare),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "0"),
+				),
+			},
+			{
+				Config: testAccResourceShareConfig_resourceARNs(rName, "aws_subnet.test[0].arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceShareExists(ctx, resourceName, &resourceShare),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "1"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 3), testAccResourceShareConfig_name(rName)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceShareExists(ctx, resourceName, &resourceShare),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "0"),
+					testAccCheckResourceShareResourceAssociationCount(ctx, resourceName, 0),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRAMResourceShare_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var resourceShare ram.ResourceShare
@@ -224,6 +287,29 @@ func TestAccRAMResourceShare_disappears(t *testing.T) {
 	})
 }
 
+func testAccCheckResourceShareResourceAssociationCount(ctx context.Context, resourceName string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RAMConn(ctx)
+
+		output, err := tfram.FindResourceShareAssociatedEntities(ctx, conn, rs.Primary.ID, ram.ResourceShareAssociationTypeResource)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("RAM Resource Share (%s) has %d resource associations, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckResourceShareExists(ctx context.Context, resourceName string, v *ram.ResourceShare) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).RAMConn(ctx)
@@ -319,6 +405,15 @@ resource "aws_ram_resource_share" "test" {
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2)
 }
 
+func testAccResourceShareConfig_resourceARNs(rName string, resourceARNs ...string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 3), fmt.Sprintf(`
+resource "aws_ram_resource_share" "test" {
+  name          = %[1]q
+  resource_arns = [%[2]s]
+}
+`, rName, strings.Join(resourceARNs, ", ")))
+}
+
 func testAccResourceShareConfig_namePermission(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
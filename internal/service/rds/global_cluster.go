@@ -641,7 +641,7 @@ func globalClusterUpgradeMajorEngineVersion(ctx context.Context, meta interface{
 		useConn := conn // clusters may not all be in the same region
 
 		if clusterRegion != meta.(*conns.AWSClient).Region {
-			useConn = rds.New(meta.(*conns.AWSClient).Session, aws.NewConfig().WithRegion(clusterRegion))
+			useConn = rds.New(meta.(*conns.AWSClient).APISession(ctx), aws.NewConfig().WithRegion(clusterRegion))
 		}
 
 		if err := WaitForClusterUpdate(ctx, useConn, dbi, timeout); err != nil {
@@ -705,7 +705,7 @@ func globalClusterUpgradeMinorEngineVersion(ctx context.Context, meta interface{
 		useConn := conn
 
 		if clusterRegion != meta.(*conns.AWSClient).Region {
-			useConn = rds.New(meta.(*conns.AWSClient).Session, aws.NewConfig().WithRegion(clusterRegion))
+			useConn = rds.New(meta.(*conns.AWSClient).APISession(ctx), aws.NewConfig().WithRegion(clusterRegion))
 		}
 
 		modInput := &rds.ModifyDBClusterInput{
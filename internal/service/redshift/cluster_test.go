@@ -22,6 +22,7 @@ import (
 )
 
 func TestAccRedshiftCluster_basic(t *testing.T) {
+	acctest.Expensive(t, acctest.CostRedshiftCluster)
 	ctx := acctest.Context(t)
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
@@ -67,6 +68,7 @@ func TestAccRedshiftCluster_basic(t *testing.T) {
 }
 
 func TestAccRedshiftCluster_aqua(t *testing.T) {
+	acctest.Expensive(t, acctest.CostRedshiftCluster)
 	ctx := acctest.Context(t)
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
@@ -115,6 +117,7 @@ func TestAccRedshiftCluster_aqua(t *testing.T) {
 }
 
 func TestAccRedshiftCluster_disappears(t *testing.T) {
+	acctest.Expensive(t, acctest.CostRedshiftCluster)
 	ctx := acctest.Context(t)
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
@@ -139,6 +142,7 @@ func TestAccRedshiftCluster_disappears(t *testing.T) {
 }
 
 func TestAccRedshiftCluster_withFinalSnapshot(t *testing.T) {
+	acctest.Expensive(t, acctest.CostRedshiftCluster)
 	ctx := acctest.Context(t)
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
@@ -172,6 +176,7 @@ func TestAccRedshiftCluster_withFinalSnapshot(t *testing.T) {
 }
 
 func TestAccRedshiftCluster_kmsKey(t *testing.T) {
+	acctest.Expensive(t, acctest.CostRedshiftCluster)
 	ctx := acctest.Context(t)
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
@@ -209,6 +214,7 @@ func TestAccRedshiftCluster_kmsKey(t *testing.T) {
 }
 
 func TestAccRedshiftCluster_enhancedVPCRoutingEnabled(t *testing.T) {
+	acctest.Expensive(t, acctest.CostRedshiftCluster)
 	ctx := acctest.Context(t)
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
@@ -250,6 +256,7 @@ func TestAccRedshiftCluster_enhancedVPCRoutingEnabled(t *testing.T) {
 }
 
 func TestAccRedshiftCluster_loggingEnabled(t *testing.T) {
+	acctest.Expensive(t, acctest.CostRedshiftCluster)
 	ctx := acctest.Context(t)
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
@@ -301,6 +308,7 @@ func TestAccRedshiftCluster_loggingEnabled(t *testing.T) {
 }
 
 func TestAccRedshiftCluster_snapshotCopy(t *testing.T) {
+	acctest.Expensive(t, acctest.CostRedshiftCluster)
 	ctx := acctest.Context(t)
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
@@ -335,6 +343,7 @@ func TestAccRedshiftCluster_snapshotCopy(t *testing.T) {
 }
 
 func TestAccRedshiftCluster_iamRoles(t *testing.T) {
+	acctest.Expensive(t, acctest.CostRedshiftCluster)
 	ctx := acctest.Context(t)
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
@@ -365,6 +374,7 @@ func TestAccRedshiftCluster_iamRoles(t *testing.T) {
 }
 
 func TestAccRedshiftCluster_publiclyAccessible(t *testing.T) {
+	acctest.Expensive(t, acctest.CostRedshiftCluster)
 	ctx := acctest.Context(t)
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
@@ -396,6 +406,7 @@ func TestAccRedshiftCluster_publiclyAccessible(t *testing.T) {
 }
 
 func TestAccRedshiftCluster_updateNodeCount(t *testing.T) {
+	acctest.Expensive(t, acctest.CostRedshiftCluster)
 	ctx := acctest.Context(t)
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
@@ -428,6 +439,7 @@ func TestAccRedshiftCluster_updateNodeCount(t *testing.T) {
 }
 
 func TestAccRedshiftCluster_updateNodeType(t *testing.T) {
+	acctest.Expensive(t, acctest.CostRedshiftCluster)
 	ctx := acctest.Context(t)
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
@@ -458,6 +470,7 @@ func TestAccRedshiftCluster_updateNodeType(t *testing.T) {
 }
 
 func TestAccRedshiftCluster_tags(t *testing.T) {
+	acctest.Expensive(t, acctest.CostRedshiftCluster)
 	ctx := acctest.Context(t)
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
@@ -510,6 +523,7 @@ func TestAccRedshiftCluster_tags(t *testing.T) {
 }
 
 func TestAccRedshiftCluster_forceNewUsername(t *testing.T) {
+	acctest.Expensive(t, acctest.CostRedshiftCluster)
 	ctx := acctest.Context(t)
 	var v1, v2 redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
@@ -543,6 +557,7 @@ func TestAccRedshiftCluster_forceNewUsername(t *testing.T) {
 }
 
 func TestAccRedshiftCluster_changeAvailabilityZone(t *testing.T) {
+	acctest.Expensive(t, acctest.CostRedshiftCluster)
 	ctx := acctest.Context(t)
 	var v1, v2 redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
@@ -576,6 +591,7 @@ func TestAccRedshiftCluster_changeAvailabilityZone(t *testing.T) {
 }
 
 func TestAccRedshiftCluster_changeAvailabilityZoneAndSetAvailabilityZoneRelocation(t *testing.T) {
+	acctest.Expensive(t, acctest.CostRedshiftCluster)
 	ctx := acctest.Context(t)
 	var v1, v2 redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
@@ -610,6 +626,7 @@ func TestAccRedshiftCluster_changeAvailabilityZoneAndSetAvailabilityZoneRelocati
 }
 
 func TestAccRedshiftCluster_changeAvailabilityZone_availabilityZoneRelocationNotSet(t *testing.T) {
+	acctest.Expensive(t, acctest.CostRedshiftCluster)
 	ctx := acctest.Context(t)
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
@@ -639,6 +656,7 @@ func TestAccRedshiftCluster_changeAvailabilityZone_availabilityZoneRelocationNot
 }
 
 func TestAccRedshiftCluster_changeEncryption1(t *testing.T) {
+	acctest.Expensive(t, acctest.CostRedshiftCluster)
 	ctx := acctest.Context(t)
 	var cluster1, cluster2 redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
@@ -670,6 +688,7 @@ func TestAccRedshiftCluster_changeEncryption1(t *testing.T) {
 }
 
 func TestAccRedshiftCluster_changeEncryption2(t *testing.T) {
+	acctest.Expensive(t, acctest.CostRedshiftCluster)
 	ctx := acctest.Context(t)
 	var cluster1, cluster2 redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
@@ -701,6 +720,7 @@ func TestAccRedshiftCluster_changeEncryption2(t *testing.T) {
 }
 
 func TestAccRedshiftCluster_availabilityZoneRelocation(t *testing.T) {
+	acctest.Expensive(t, acctest.CostRedshiftCluster)
 	ctx := acctest.Context(t)
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
@@ -742,6 +762,7 @@ func TestAccRedshiftCluster_availabilityZoneRelocation(t *testing.T) {
 }
 
 func TestAccRedshiftCluster_availabilityZoneRelocation_publiclyAccessible(t *testing.T) {
+	acctest.Expensive(t, acctest.CostRedshiftCluster)
 	ctx := acctest.Context(t)
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
@@ -766,6 +787,7 @@ func TestAccRedshiftCluster_availabilityZoneRelocation_publiclyAccessible(t *tes
 }
 
 func TestAccRedshiftCluster_restoreFromSnapshot(t *testing.T) {
+	acctest.Expensive(t, acctest.CostRedshiftCluster)
 	ctx := acctest.Context(t)
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
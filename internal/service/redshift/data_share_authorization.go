@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshift
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	dataShareAuthorizationIDPartCount = 2
+)
+
+// @SDKResource("aws_redshift_data_share_authorization", name="Data Share Authorization")
+func ResourceDataShareAuthorization() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataShareAuthorizationCreate,
+		ReadWithoutTimeout:   resourceDataShareAuthorizationRead,
+		DeleteWithoutTimeout: resourceDataShareAuthorizationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"consumer_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.Any(
+					verify.ValidAccountID,
+					verify.ValidARN,
+				),
+			},
+			"data_share_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDataShareAuthorizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn(ctx)
+
+	dataShareARN := d.Get("data_share_arn").(string)
+	consumerIdentifier := d.Get("consumer_identifier").(string)
+	id, err := flex.FlattenResourceId([]string{dataShareARN, consumerIdentifier}, dataShareAuthorizationIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &redshift.AuthorizeDataShareInput{
+		ConsumerIdentifier: aws.String(consumerIdentifier),
+		DataShareArn:       aws.String(dataShareARN),
+	}
+
+	_, err = conn.AuthorizeDataShareWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Redshift Data Share Authorization (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitDataShareAuthorized(ctx, conn, dataShareARN, consumerIdentifier, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Redshift Data Share Authorization (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDataShareAuthorizationRead(ctx, d, meta)...)
+}
+
+func resourceDataShareAuthorizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), dataShareAuthorizationIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	dataShareARN, consumerIdentifier := parts[0], parts[1]
+	association, err := FindDataShareAuthorizationByTwoPartKey(ctx, conn, dataShareARN, consumerIdentifier)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift Data Share Authorization (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Redshift Data Share Authorization (%s): %s", d.Id(), err)
+	}
+
+	d.Set("consumer_identifier", consumerIdentifier)
+	d.Set("data_share_arn", dataShareARN)
+	d.Set("status", association.Status)
+
+	return diags
+}
+
+func resourceDataShareAuthorizationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Redshift Data Share Authorization: %s", d.Id())
+	_, err := conn.DeauthorizeDataShareWithContext(ctx, &redshift.DeauthorizeDataShareInput{
+		ConsumerIdentifier: aws.String(d.Get("consumer_identifier").(string)),
+		DataShareArn:       aws.String(d.Get("data_share_arn").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeResourceNotFoundFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Redshift Data Share Authorization (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshift
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	dataShareConsumerAssociationIDPartCount = 4
+)
+
+// @SDKResource("aws_redshift_data_share_consumer_association", name="Data Share Consumer Association")
+func ResourceDataShareConsumerAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataShareConsumerAssociationCreate,
+		ReadWithoutTimeout:   resourceDataShareConsumerAssociationRead,
+		DeleteWithoutTimeout: resourceDataShareConsumerAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"associate_entire_account": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"associate_entire_account", "consumer_arn", "consumer_region"},
+			},
+			"consumer_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"associate_entire_account", "consumer_arn", "consumer_region"},
+			},
+			"consumer_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
+				ExactlyOneOf: []string{"associate_entire_account", "consumer_arn", "consumer_region"},
+			},
+			"data_share_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"managed_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"producer_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDataShareConsumerAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn(ctx)
+
+	dataShareARN := d.Get("data_share_arn").(string)
+	associateEntireAccount := d.Get("associate_entire_account").(bool)
+	consumerARN := d.Get("consumer_arn").(string)
+	consumerRegion := d.Get("consumer_region").(string)
+	id, err := flex.FlattenResourceId([]string{dataShareARN, strconv.FormatBool(associateEntireAccount), consumerARN, consumerRegion}, dataShareConsumerAssociationIDPartCount, true)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &redshift.AssociateDataShareConsumerInput{
+		DataShareArn: aws.String(dataShareARN),
+	}
+
+	if associateEntireAccount {
+		input.AssociateEntireAccount = aws.Bool(true)
+	}
+
+	if consumerARN != "" {
+		input.ConsumerArn = aws.String(consumerARN)
+	}
+
+	if consumerRegion != "" {
+		input.ConsumerRegion = aws.String(consumerRegion)
+	}
+
+	_, err = conn.AssociateDataShareConsumerWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Redshift Data Share Consumer Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitDataShareConsumerAssociationActive(ctx, conn, dataShareARN, associateEntireAccount, consumerARN, consumerRegion, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Redshift Data Share Consumer Association (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDataShareConsumerAssociationRead(ctx, d, meta)...)
+}
+
+func resourceDataShareConsumerAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), dataShareConsumerAssociationIDPartCount, true)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	dataShareARN, consumerARN, consumerRegion := parts[0], parts[2], parts[3]
+	associateEntireAccount, err := strconv.ParseBool(parts[1])
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Redshift Data Share Consumer Association (%s): %s", d.Id(), err)
+	}
+
+	association, err := FindDataShareConsumerAssociationByFourPartKey(ctx, conn, dataShareARN, associateEntireAccount, consumerARN, consumerRegion)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift Data Share Consumer Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Redshift Data Share Consumer Association (%s): %s", d.Id(), err)
+	}
+
+	dataShare, err := FindDataShareByARN(ctx, conn, dataShareARN)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Redshift Data Share (%s): %s", dataShareARN, err)
+	}
+
+	if associateEntireAccount {
+		d.Set("associate_entire_account", true)
+	} else {
+		d.Set("associate_entire_account", nil)
+	}
+	d.Set("consumer_arn", consumerARN)
+	d.Set("consumer_region", consumerRegion)
+	d.Set("data_share_arn", dataShareARN)
+	d.Set("managed_by", dataShare.ManagedBy)
+	d.Set("producer_arn", dataShare.ProducerArn)
+	d.Set("status", association.Status)
+
+	return diags
+}
+
+func resourceDataShareConsumerAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn(ctx)
+
+	input := &redshift.DisassociateDataShareConsumerInput{
+		DataShareArn: aws.String(d.Get("data_share_arn").(string)),
+	}
+
+	if d.Get("associate_entire_account").(bool) {
+		input.DisassociateEntireAccount = aws.Bool(true)
+	}
+
+	if v, ok := d.GetOk("consumer_arn"); ok {
+		input.ConsumerArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("consumer_region"); ok {
+		input.ConsumerRegion = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Deleting Redshift Data Share Consumer Association: %s", d.Id())
+	_, err := conn.DisassociateDataShareConsumerWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeResourceNotFoundFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Redshift Data Share Consumer Association (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -468,3 +469,101 @@ func FindClusterSnapshotByID(ctx context.Context, conn *redshift.Redshift, id st
 
 	return output.Snapshots[0], nil
 }
+
+func findDataShares(ctx context.Context, conn *redshift.Redshift, input *redshift.DescribeDataSharesInput) ([]*redshift.DataShare, error) {
+	var output []*redshift.DataShare
+
+	err := conn.DescribeDataSharesPagesWithContext(ctx, input, func(page *redshift.DescribeDataSharesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DataShares {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeResourceNotFoundFault) || tfawserr.ErrCodeEquals(err, redshift.ErrCodeInvalidDataShareFault) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindDataShareByARN(ctx context.Context, conn *redshift.Redshift, arn string) (*redshift.DataShare, error) {
+	input := &redshift.DescribeDataSharesInput{
+		DataShareArn: aws.String(arn),
+	}
+
+	output, err := findDataShares(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func findDataShareAssociation(ctx context.Context, conn *redshift.Redshift, dataShareARN string, filter func(*redshift.DataShareAssociation) bool) (*redshift.DataShareAssociation, error) {
+	dataShare, err := FindDataShareByARN(ctx, conn, dataShareARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range dataShare.DataShareAssociations {
+		if v == nil || !filter(v) {
+			continue
+		}
+
+		switch status := aws.StringValue(v.Status); status {
+		case redshift.DataShareStatusDeauthorized, redshift.DataShareStatusRejected:
+			return nil, &retry.NotFoundError{
+				Message: status,
+			}
+		}
+
+		return v, nil
+	}
+
+	return nil, tfresource.NewEmptyResultError(dataShareARN)
+}
+
+func FindDataShareAuthorizationByTwoPartKey(ctx context.Context, conn *redshift.Redshift, dataShareARN, consumerIdentifier string) (*redshift.DataShareAssociation, error) {
+	return findDataShareAssociation(ctx, conn, dataShareARN, func(v *redshift.DataShareAssociation) bool {
+		return aws.StringValue(v.ConsumerIdentifier) == consumerIdentifier
+	})
+}
+
+func FindDataShareConsumerAssociationByFourPartKey(ctx context.Context, conn *redshift.Redshift, dataShareARN string, associateEntireAccount bool, consumerARN, consumerRegion string) (*redshift.DataShareAssociation, error) {
+	return findDataShareAssociation(ctx, conn, dataShareARN, func(v *redshift.DataShareAssociation) bool {
+		switch {
+		case consumerARN != "":
+			return aws.StringValue(v.ConsumerIdentifier) == consumerARN
+		case consumerRegion != "":
+			return aws.StringValue(v.ConsumerRegion) == consumerRegion
+		default:
+			// Associations with the entire account are identified by account ID rather than namespace ARN.
+			return associateEntireAccount && !arn.IsARN(aws.StringValue(v.ConsumerIdentifier))
+		}
+	})
+}
@@ -74,6 +74,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceDataShareAuthorization,
+			TypeName: "aws_redshift_data_share_authorization",
+			Name:     "Data Share Authorization",
+		},
+		{
+			Factory:  ResourceDataShareConsumerAssociation,
+			TypeName: "aws_redshift_data_share_consumer_association",
+			Name:     "Data Share Consumer Association",
+		},
 		{
 			Factory:  ResourceEndpointAccess,
 			TypeName: "aws_redshift_endpoint_access",
@@ -123,3 +123,35 @@ func statusClusterSnapshot(ctx context.Context, conn *redshift.Redshift, id stri
 		return output, aws.StringValue(output.Status), nil
 	}
 }
+
+func statusDataShareAuthorization(ctx context.Context, conn *redshift.Redshift, dataShareARN, consumerIdentifier string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDataShareAuthorizationByTwoPartKey(ctx, conn, dataShareARN, consumerIdentifier)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusDataShareConsumerAssociation(ctx context.Context, conn *redshift.Redshift, dataShareARN string, associateEntireAccount bool, consumerARN, consumerRegion string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDataShareConsumerAssociationByFourPartKey(ctx, conn, dataShareARN, associateEntireAccount, consumerARN, consumerRegion)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -261,3 +261,39 @@ func waitClusterSnapshotDeleted(ctx context.Context, conn *redshift.Redshift, id
 
 	return nil, err
 }
+
+func waitDataShareAuthorized(ctx context.Context, conn *redshift.Redshift, dataShareARN, consumerIdentifier string, timeout time.Duration) (*redshift.DataShareAssociation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{redshift.DataShareStatusPendingAuthorization},
+		Target:     []string{redshift.DataShareStatusAuthorized, redshift.DataShareStatusActive},
+		Refresh:    statusDataShareAuthorization(ctx, conn, dataShareARN, consumerIdentifier),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*redshift.DataShareAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataShareConsumerAssociationActive(ctx context.Context, conn *redshift.Redshift, dataShareARN string, associateEntireAccount bool, consumerARN, consumerRegion string, timeout time.Duration) (*redshift.DataShareAssociation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{redshift.DataShareStatusAuthorized, redshift.DataShareStatusAvailable},
+		Target:     []string{redshift.DataShareStatusActive},
+		Refresh:    statusDataShareConsumerAssociation(ctx, conn, dataShareARN, associateEntireAccount, consumerARN, consumerRegion),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*redshift.DataShareAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,596 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resiliencehub
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	appVersionDraft   = "draft"
+	appVersionRelease = "release"
+)
+
+// @SDKResource("aws_resiliencehub_app", name="App")
+// @Tags(identifierAttribute="arn")
+func resourceApp() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAppCreate,
+		ReadWithoutTimeout:   resourceAppRead,
+		UpdateWithoutTimeout: resourceAppUpdate,
+		DeleteWithoutTimeout: resourceAppDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assessment_schedule": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(resiliencehub.AppAssessmentScheduleType_Values(), false),
+			},
+			"compliance_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"last_assessment_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 60),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_\-]+$`), "must start with an alphanumeric character and contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"resiliency_policy_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resiliency_score": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"source_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"start_assessment": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"terraform_source": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_state_file_url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(https|s3)://`), "must be an S3 URL"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &resiliencehub.CreateAppInput{
+		Name: aws.String(name),
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("assessment_schedule"); ok {
+		input.AssessmentSchedule = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resiliency_policy_arn"); ok {
+		input.PolicyArn = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateAppWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Resilience Hub App (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.App.AppArn))
+
+	sourceARNs := flex.ExpandStringSet(d.Get("source_arns").(*schema.Set))
+	terraformSources := expandTerraformSources(d.Get("terraform_source").(*schema.Set).List())
+
+	if len(sourceARNs) > 0 || len(terraformSources) > 0 {
+		if err := importAppResources(ctx, conn, d.Id(), sourceARNs, terraformSources, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "importing Resilience Hub App (%s) resources: %s", d.Id(), err)
+		}
+
+		if err := publishAppVersion(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "publishing Resilience Hub App (%s) version: %s", d.Id(), err)
+		}
+
+		if d.Get("start_assessment").(bool) {
+			assessmentARN, err := startAppAssessment(ctx, conn, d.Id())
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "starting Resilience Hub App (%s) assessment: %s", d.Id(), err)
+			}
+
+			d.Set("last_assessment_arn", assessmentARN)
+		}
+	}
+
+	return append(diags, resourceAppRead(ctx, d, meta)...)
+}
+
+func resourceAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubConn(ctx)
+
+	app, err := findAppByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Resilience Hub App (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resilience Hub App (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", app.AppArn)
+	d.Set("assessment_schedule", app.AssessmentSchedule)
+	d.Set("compliance_status", app.ComplianceStatus)
+	d.Set("description", app.Description)
+	d.Set("name", app.Name)
+	d.Set("resiliency_policy_arn", app.PolicyArn)
+	d.Set("resiliency_score", app.ResiliencyScore)
+	d.Set("status", app.Status)
+
+	inputSources, err := findAppInputSources(ctx, conn, d.Id(), appVersionDraft)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resilience Hub App (%s) input sources: %s", d.Id(), err)
+	}
+
+	var sourceARNs []string
+	var terraformSources []*resiliencehub.TerraformSource
+
+	for _, v := range inputSources {
+		switch {
+		case v.TerraformSource != nil:
+			terraformSources = append(terraformSources, v.TerraformSource)
+		case v.SourceArn != nil:
+			sourceARNs = append(sourceARNs, aws.StringValue(v.SourceArn))
+		}
+	}
+
+	d.Set("source_arns", sourceARNs)
+	if err := d.Set("terraform_source", flattenTerraformSources(terraformSources)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting terraform_source: %s", err)
+	}
+
+	setTagsOut(ctx, app.Tags)
+
+	return diags
+}
+
+func resourceAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubConn(ctx)
+
+	if d.HasChanges("assessment_schedule", "description", "resiliency_policy_arn") {
+		input := &resiliencehub.UpdateAppInput{
+			AppArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("assessment_schedule") {
+			input.AssessmentSchedule = aws.String(d.Get("assessment_schedule").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("resiliency_policy_arn") {
+			if v, ok := d.GetOk("resiliency_policy_arn"); ok {
+				input.PolicyArn = aws.String(v.(string))
+			} else {
+				input.ClearResiliencyPolicyArn = aws.Bool(true)
+			}
+		}
+
+		_, err := conn.UpdateAppWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Resilience Hub App (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("source_arns", "terraform_source") {
+		o, n := d.GetChange("source_arns")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		addSourceARNs, delSourceARNs := flex.ExpandStringSet(ns.Difference(os)), flex.ExpandStringSet(os.Difference(ns))
+
+		o, n = d.GetChange("terraform_source")
+		os, ns = o.(*schema.Set), n.(*schema.Set)
+		addTerraformSources, delTerraformSources := expandTerraformSources(ns.Difference(os).List()), expandTerraformSources(os.Difference(ns).List())
+
+		for _, v := range delSourceARNs {
+			input := &resiliencehub.DeleteAppInputSourceInput{
+				AppArn:    aws.String(d.Id()),
+				SourceArn: v,
+			}
+
+			if err := deleteAppInputSource(ctx, conn, input); err != nil {
+				return sdkdiag.AppendErrorf(diags, "deleting Resilience Hub App (%s) input source (%s): %s", d.Id(), aws.StringValue(v), err)
+			}
+		}
+
+		for _, v := range delTerraformSources {
+			input := &resiliencehub.DeleteAppInputSourceInput{
+				AppArn:          aws.String(d.Id()),
+				TerraformSource: v,
+			}
+
+			if err := deleteAppInputSource(ctx, conn, input); err != nil {
+				return sdkdiag.AppendErrorf(diags, "deleting Resilience Hub App (%s) input source (%s): %s", d.Id(), aws.StringValue(v.S3StateFileUrl), err)
+			}
+		}
+
+		if len(addSourceARNs) > 0 || len(addTerraformSources) > 0 {
+			if err := importAppResources(ctx, conn, d.Id(), addSourceARNs, addTerraformSources, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "importing Resilience Hub App (%s) resources: %s", d.Id(), err)
+			}
+		}
+
+		if d.Get("source_arns").(*schema.Set).Len() > 0 || d.Get("terraform_source").(*schema.Set).Len() > 0 {
+			if err := publishAppVersion(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "publishing Resilience Hub App (%s) version: %s", d.Id(), err)
+			}
+
+			if d.Get("start_assessment").(bool) {
+				assessmentARN, err := startAppAssessment(ctx, conn, d.Id())
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "starting Resilience Hub App (%s) assessment: %s", d.Id(), err)
+				}
+
+				d.Set("last_assessment_arn", assessmentARN)
+			}
+		}
+	}
+
+	return append(diags, resourceAppRead(ctx, d, meta)...)
+}
+
+func resourceAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Resilience Hub App: %s", d.Id())
+	_, err := conn.DeleteAppWithContext(ctx, &resiliencehub.DeleteAppInput{
+		AppArn:      aws.String(d.Id()),
+		ForceDelete: aws.Bool(true),
+	})
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Resilience Hub App (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAppDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Resilience Hub App (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func importAppResources(ctx context.Context, conn *resiliencehub.ResilienceHub, appARN string, sourceARNs []*string, terraformSources []*resiliencehub.TerraformSource, timeout time.Duration) error {
+	input := &resiliencehub.ImportResourcesToDraftAppVersionInput{
+		AppArn:         aws.String(appARN),
+		ImportStrategy: aws.String(resiliencehub.ResourceImportStrategyTypeAddOnly),
+	}
+
+	if len(sourceARNs) > 0 {
+		input.SourceArns = sourceARNs
+	}
+
+	if len(terraformSources) > 0 {
+		input.TerraformSources = terraformSources
+	}
+
+	if _, err := conn.ImportResourcesToDraftAppVersionWithContext(ctx, input); err != nil {
+		return err
+	}
+
+	if _, err := waitAppResourcesImported(ctx, conn, appARN, timeout); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func deleteAppInputSource(ctx context.Context, conn *resiliencehub.ResilienceHub, input *resiliencehub.DeleteAppInputSourceInput) error {
+	_, err := conn.DeleteAppInputSourceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	return err
+}
+
+func publishAppVersion(ctx context.Context, conn *resiliencehub.ResilienceHub, appARN string) error {
+	_, err := conn.PublishAppVersionWithContext(ctx, &resiliencehub.PublishAppVersionInput{
+		AppArn: aws.String(appARN),
+	})
+
+	return err
+}
+
+func startAppAssessment(ctx context.Context, conn *resiliencehub.ResilienceHub, appARN string) (string, error) {
+	output, err := conn.StartAppAssessmentWithContext(ctx, &resiliencehub.StartAppAssessmentInput{
+		AppArn:         aws.String(appARN),
+		AppVersion:     aws.String(appVersionRelease),
+		AssessmentName: aws.String(id.PrefixedUniqueId("terraform-")),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(output.Assessment.AssessmentArn), nil
+}
+
+func findAppByARN(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string) (*resiliencehub.App, error) {
+	input := &resiliencehub.DescribeAppInput{
+		AppArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeAppWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.App == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.App, nil
+}
+
+func findAppInputSources(ctx context.Context, conn *resiliencehub.ResilienceHub, appARN, appVersion string) ([]*resiliencehub.AppInputSource, error) {
+	input := &resiliencehub.ListAppInputSourcesInput{
+		AppArn:     aws.String(appARN),
+		AppVersion: aws.String(appVersion),
+	}
+	var output []*resiliencehub.AppInputSource
+
+	err := conn.ListAppInputSourcesPagesWithContext(ctx, input, func(page *resiliencehub.ListAppInputSourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AppInputSources {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findDraftAppVersionResourcesImportStatusByARN(ctx context.Context, conn *resiliencehub.ResilienceHub, appARN string) (*resiliencehub.DescribeDraftAppVersionResourcesImportStatusOutput, error) {
+	input := &resiliencehub.DescribeDraftAppVersionResourcesImportStatusInput{
+		AppArn: aws.String(appARN),
+	}
+
+	output, err := conn.DescribeDraftAppVersionResourcesImportStatusWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Status == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusApp(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findAppByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusAppResourcesImport(ctx context.Context, conn *resiliencehub.ResilienceHub, appARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDraftAppVersionResourcesImportStatusByARN(ctx, conn, appARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitAppDeleted(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string, timeout time.Duration) (*resiliencehub.App, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{resiliencehub.AppStatusTypeActive, resiliencehub.AppStatusTypeDeleting},
+		Target:  []string{},
+		Refresh: statusApp(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*resiliencehub.App); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAppResourcesImported(ctx context.Context, conn *resiliencehub.ResilienceHub, appARN string, timeout time.Duration) (*resiliencehub.DescribeDraftAppVersionResourcesImportStatusOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{resiliencehub.ResourceImportStatusTypePending, resiliencehub.ResourceImportStatusTypeInProgress},
+		Target:  []string{resiliencehub.ResourceImportStatusTypeSuccess},
+		Refresh: statusAppResourcesImport(ctx, conn, appARN),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*resiliencehub.DescribeDraftAppVersionResourcesImportStatusOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandTerraformSources(tfList []interface{}) []*resiliencehub.TerraformSource {
+	var apiObjects []*resiliencehub.TerraformSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &resiliencehub.TerraformSource{
+			S3StateFileUrl: aws.String(tfMap["s3_state_file_url"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenTerraformSources(apiObjects []*resiliencehub.TerraformSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"s3_state_file_url": aws.StringValue(apiObject.S3StateFileUrl),
+		})
+	}
+
+	return tfList
+}
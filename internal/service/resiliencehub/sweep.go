@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build sweep
+// +build sweep
+
+package resiliencehub
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_resiliencehub_app", &resource.Sweeper{
+		Name: "aws_resiliencehub_app",
+		F:    sweepApps,
+	})
+
+	resource.AddTestSweepers("aws_resiliencehub_resiliency_policy", &resource.Sweeper{
+		Name: "aws_resiliencehub_resiliency_policy",
+		F:    sweepResiliencyPolicies,
+		Dependencies: []string{
+			"aws_resiliencehub_app",
+		},
+	})
+}
+
+func sweepApps(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	conn := client.ResilienceHubConn(ctx)
+	input := &resiliencehub.ListAppsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListAppsPagesWithContext(ctx, input, func(page *resiliencehub.ListAppsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AppSummaries {
+			r := resourceApp()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.AppArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Resilience Hub App sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Resilience Hub Apps (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Resilience Hub Apps (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepResiliencyPolicies(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	conn := client.ResilienceHubConn(ctx)
+	input := &resiliencehub.ListResiliencyPoliciesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListResiliencyPoliciesPagesWithContext(ctx, input, func(page *resiliencehub.ListResiliencyPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResiliencyPolicies {
+			r := resourceResiliencyPolicy()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.PolicyArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Resilience Hub Resiliency Policy sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Resilience Hub Resiliency Policies (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Resilience Hub Resiliency Policies (%s): %w", region, err)
+	}
+
+	return nil
+}
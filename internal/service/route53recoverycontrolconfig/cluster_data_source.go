@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53recoverycontrolconfig
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_route53recoverycontrolconfig_cluster")
+func DataSourceCluster() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceClusterRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"arn", "name"},
+			},
+			"cluster_endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"arn", "name"},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53RecoveryControlConfigConn(ctx)
+
+	var cluster *r53rcc.Cluster
+
+	if v, ok := d.GetOk("arn"); ok {
+		output, err := conn.DescribeClusterWithContext(ctx, &r53rcc.DescribeClusterInput{
+			ClusterArn: aws.String(v.(string)),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Route53 Recovery Control Config Cluster (%s): %s", v, err)
+		}
+
+		if output == nil || output.Cluster == nil {
+			return sdkdiag.AppendErrorf(diags, "reading Route53 Recovery Control Config Cluster (%s): empty response", v)
+		}
+
+		cluster = output.Cluster
+	} else {
+		name := d.Get("name").(string)
+		var clusters []*r53rcc.Cluster
+
+		err := conn.ListClustersPagesWithContext(ctx, &r53rcc.ListClustersInput{}, func(page *r53rcc.ListClustersOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.Clusters {
+				if v != nil && aws.StringValue(v.Name) == name {
+					clusters = append(clusters, v)
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "listing Route53 Recovery Control Config Clusters: %s", err)
+		}
+
+		cluster, err = tfresource.AssertSinglePtrResult(clusters)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("Route53 Recovery Control Config Cluster", err))
+		}
+	}
+
+	d.SetId(aws.StringValue(cluster.ClusterArn))
+	d.Set("arn", cluster.ClusterArn)
+	d.Set("name", cluster.Name)
+	d.Set("status", cluster.Status)
+
+	if err := d.Set("cluster_endpoints", flattenClusterEndpoints(cluster.ClusterEndpoints)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting cluster_endpoints: %s", err)
+	}
+
+	return diags
+}
@@ -19,6 +19,10 @@ func TestAccRoute53RecoveryControlConfig_serial(t *testing.T) {
 			"basic":      testAccCluster_basic,
 			"disappears": testAccCluster_disappears,
 		},
+		"ClusterDataSource": {
+			"arn":  testAccClusterDataSource_arn,
+			"name": testAccClusterDataSource_name,
+		},
 		"ControlPanel": {
 			"basic":      testAccControlPanel_basic,
 			"disappears": testAccControlPanel_disappears,
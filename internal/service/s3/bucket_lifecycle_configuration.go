@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -36,6 +37,8 @@ func ResourceBucketLifecycleConfiguration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceBucketLifecycleConfigurationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:         schema.TypeString,
@@ -426,6 +429,111 @@ func resourceBucketLifecycleConfigurationDelete(ctx context.Context, d *schema.R
 	return nil
 }
 
+// resourceBucketLifecycleConfigurationCustomizeDiff validates combinations of rule arguments
+// that the S3 API rejects with a MalformedXML or InvalidRequest error during apply.
+func resourceBucketLifecycleConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for i, tfMapRaw := range d.Get("rule").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if err := validateLifecycleRule(tfMap, expiredObjectDeleteMarkerConfigured(d.GetRawConfig(), i)); err != nil {
+			return fmt.Errorf("rule (%s): %w", tfMap["id"], err)
+		}
+	}
+
+	return nil
+}
+
+// expiredObjectDeleteMarkerConfigured returns whether the specified rule's expiration.expired_object_delete_marker
+// is set to true in configuration. The attribute is Computed, so its planned value may come from state.
+func expiredObjectDeleteMarkerConfigured(rawConfig cty.Value, i int) bool {
+	if !rawConfig.IsKnown() || rawConfig.IsNull() {
+		return false
+	}
+
+	rules := rawConfig.GetAttr("rule")
+
+	if !rules.IsKnown() || rules.IsNull() || rules.LengthInt() <= i {
+		return false
+	}
+
+	rule := rules.Index(cty.NumberIntVal(int64(i)))
+
+	if !rule.IsKnown() || rule.IsNull() {
+		return false
+	}
+
+	expiration := rule.GetAttr("expiration")
+
+	if !expiration.IsKnown() || expiration.IsNull() || expiration.LengthInt() == 0 {
+		return false
+	}
+
+	expiration = expiration.Index(cty.NumberIntVal(0))
+
+	if !expiration.IsKnown() || expiration.IsNull() {
+		return false
+	}
+
+	v := expiration.GetAttr("expired_object_delete_marker")
+
+	return v.IsKnown() && !v.IsNull() && v.True()
+}
+
+func validateLifecycleRule(tfMap map[string]interface{}, expiredObjectDeleteMarker bool) error {
+	hasTagFilter := false
+
+	if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		filter := v[0].(map[string]interface{})
+
+		if v, ok := filter["tag"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			hasTagFilter = true
+		}
+
+		if v, ok := filter["and"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if v, ok := v[0].(map[string]interface{})["tags"].(map[string]interface{}); ok && len(v) > 0 {
+				hasTagFilter = true
+			}
+		}
+	}
+
+	if v, ok := tfMap["abort_incomplete_multipart_upload"].([]interface{}); ok && len(v) > 0 && v[0] != nil && hasTagFilter {
+		return fmt.Errorf("abort_incomplete_multipart_upload cannot be specified with a tag-based filter")
+	}
+
+	if v, ok := tfMap["expiration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		expiration := v[0].(map[string]interface{})
+
+		if expiration["date"].(string) != "" && expiration["days"].(int) > 0 {
+			return fmt.Errorf("only one of expiration.date or expiration.days can be specified")
+		}
+
+		// expired_object_delete_marker is ignored if date or days is specified.
+		if expiredObjectDeleteMarker && hasTagFilter {
+			return fmt.Errorf("expiration.expired_object_delete_marker cannot be specified with a tag-based filter")
+		}
+	}
+
+	if v, ok := tfMap["transition"].(*schema.Set); ok {
+		for _, tfMapRaw := range v.List() {
+			transition, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			if transition["date"].(string) != "" && transition["days"].(int) > 0 {
+				return fmt.Errorf("only one of transition.date or transition.days can be specified")
+			}
+		}
+	}
+
+	return nil
+}
+
 // suppressMissingFilterConfigurationBlock suppresses the diff that results from an omitted
 // filter configuration block and one returned from the S3 API.
 // To work around the issue, https://github.com/hashicorp/terraform-plugin-sdk/issues/743,
@@ -5,23 +5,30 @@ package s3
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3control"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKResource("aws_s3_bucket_replication_configuration")
+// @ARNAttributes("role")
 func ResourceBucketReplicationConfiguration() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBucketReplicationConfigurationCreate,
@@ -32,6 +39,11 @@ func ResourceBucketReplicationConfiguration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:         schema.TypeString,
@@ -39,6 +51,72 @@ func ResourceBucketReplicationConfiguration() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 63),
 			},
+			"replicate_existing_objects": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"job_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"job_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"object_replication_statuses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(s3control.ReplicationStatus_Values(), false),
+							},
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      10,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"report": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+									"report_scope": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      s3control.JobReportScopeAllTasks,
+										ValidateFunc: validation.StringInSlice(s3control.JobReportScope_Values(), false),
+									},
+								},
+							},
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"wait_for_completion": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
 			"role": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -357,6 +435,12 @@ func resourceBucketReplicationConfigurationCreate(ctx context.Context, d *schema
 		return sdkdiag.AppendErrorf(diags, "waiting for S3 Replication creation on bucket (%s): %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("replicate_existing_objects"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if err := replicateExistingObjects(ctx, d, meta, v.([]interface{})[0].(map[string]interface{}), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceBucketReplicationConfigurationRead(ctx, d, meta)...)
 }
 
@@ -384,6 +468,28 @@ func resourceBucketReplicationConfigurationRead(ctx context.Context, d *schema.R
 		return sdkdiag.AppendErrorf(diags, "setting rule: %s", err)
 	}
 
+	// The Batch Replication job is not part of the replication configuration, so only its status is refreshed.
+	if v, ok := d.GetOk("replicate_existing_objects"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		if jobID, ok := tfMap["job_id"].(string); ok && jobID != "" {
+			job, err := findReplicationJobByTwoPartKey(ctx, meta.(*conns.AWSClient).S3ControlConn(ctx), meta.(*conns.AWSClient).AccountID, jobID)
+
+			switch {
+			case tfresource.NotFound(err):
+				log.Printf("[WARN] S3 Batch Replication job (%s) for bucket (%s) not found, keeping last known status", jobID, d.Id())
+			case err != nil:
+				return sdkdiag.AppendErrorf(diags, "reading S3 Batch Replication job (%s) for bucket (%s): %s", jobID, d.Id(), err)
+			default:
+				tfMap["job_status"] = aws.StringValue(job.Status)
+
+				if err := d.Set("replicate_existing_objects", []interface{}{tfMap}); err != nil {
+					return sdkdiag.AppendErrorf(diags, "setting replicate_existing_objects: %s", err)
+				}
+			}
+		}
+	}
+
 	return diags
 }
 
@@ -424,6 +530,14 @@ func resourceBucketReplicationConfigurationUpdate(ctx context.Context, d *schema
 		return sdkdiag.AppendErrorf(diags, "updating S3 replication configuration for bucket (%s): %s", d.Id(), err)
 	}
 
+	if d.HasChange("replicate_existing_objects") {
+		if v, ok := d.GetOk("replicate_existing_objects"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if err := replicateExistingObjects(ctx, d, meta, v.([]interface{})[0].(map[string]interface{}), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
 	return append(diags, resourceBucketReplicationConfigurationRead(ctx, d, meta)...)
 }
 
@@ -472,3 +586,121 @@ func FindBucketReplicationConfigurationByID(ctx context.Context, conn *s3.S3, id
 
 	return out, nil
 }
+
+// replicateExistingObjects starts an S3 Batch Replication job that backfills objects
+// which existed in the bucket before the replication configuration was applied.
+func replicateExistingObjects(ctx context.Context, d *schema.ResourceData, meta interface{}, tfMap map[string]interface{}, timeout time.Duration) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn(ctx)
+	accountID := meta.(*conns.AWSClient).AccountID
+
+	sourceBucketARN := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "s3",
+		Resource:  d.Id(),
+	}.String()
+
+	filter := &s3control.JobManifestGeneratorFilter{
+		EligibleForReplication: aws.Bool(true),
+	}
+
+	if v, ok := tfMap["object_replication_statuses"].(*schema.Set); ok && v.Len() > 0 {
+		filter.ObjectReplicationStatuses = flex.ExpandStringSet(v)
+	}
+
+	input := &s3control.CreateJobInput{
+		AccountId:            aws.String(accountID),
+		ClientRequestToken:   aws.String(id.UniqueId()),
+		ConfirmationRequired: aws.Bool(false),
+		Description:          aws.String(fmt.Sprintf("Replicate existing objects in %s", d.Id())),
+		ManifestGenerator: &s3control.JobManifestGenerator{
+			S3JobManifestGenerator: &s3control.S3JobManifestGenerator{
+				EnableManifestOutput: aws.Bool(false),
+				Filter:               filter,
+				SourceBucket:         aws.String(sourceBucketARN),
+			},
+		},
+		Operation: &s3control.JobOperation{
+			S3ReplicateObject: &s3control.S3ReplicateObjectOperation{},
+		},
+		Priority: aws.Int64(int64(tfMap["priority"].(int))),
+		Report:   expandReplicationJobReport(tfMap["report"].([]interface{})),
+		RoleArn:  aws.String(tfMap["role_arn"].(string)),
+	}
+
+	// The job role is often created in the same configuration.
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateJobWithContext(ctx, input)
+	}, s3control.ErrCodeBadRequestException, "role")
+
+	if err != nil {
+		return fmt.Errorf("creating S3 Batch Replication job for bucket (%s): %w", d.Id(), err)
+	}
+
+	jobID := aws.StringValue(outputRaw.(*s3control.CreateJobOutput).JobId)
+	tfMap["job_id"] = jobID
+
+	if err := d.Set("replicate_existing_objects", []interface{}{tfMap}); err != nil {
+		return fmt.Errorf("setting replicate_existing_objects: %w", err)
+	}
+
+	if tfMap["wait_for_completion"].(bool) {
+		_, err = waitReplicationJobCompleted(ctx, conn, accountID, jobID, timeout)
+	} else {
+		_, err = waitReplicationJobStarted(ctx, conn, accountID, jobID, timeout)
+	}
+
+	if err != nil {
+		return fmt.Errorf("waiting for S3 Batch Replication job (%s) for bucket (%s): %w", jobID, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandReplicationJobReport(tfList []interface{}) *s3control.JobReport {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return &s3control.JobReport{
+			Enabled: aws.Bool(false),
+		}
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &s3control.JobReport{
+		Bucket:      aws.String(tfMap["bucket"].(string)),
+		Enabled:     aws.Bool(true),
+		Format:      aws.String(s3control.JobReportFormatReportCsv20180820),
+		ReportScope: aws.String(tfMap["report_scope"].(string)),
+	}
+
+	if v, ok := tfMap["prefix"].(string); ok && v != "" {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func findReplicationJobByTwoPartKey(ctx context.Context, conn *s3control.S3Control, accountID, jobID string) (*s3control.JobDescriptor, error) {
+	input := &s3control.DescribeJobInput{
+		AccountId: aws.String(accountID),
+		JobId:     aws.String(jobID),
+	}
+
+	output, err := conn.DescribeJobWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, s3control.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Job == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Job, nil
+}
@@ -815,6 +815,40 @@ func TestAccS3BucketReplicationConfiguration_existingObjectReplication(t *testin
 }
 
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/23487
+func TestAccS3BucketReplicationConfiguration_replicateExistingObjects(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_bucket_replication_configuration.test"
+	batchRoleResourceName := "aws_iam_role.batch"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameDestination := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketReplicationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketReplicationConfigurationConfig_replicateExistingObjects(rName, rNameDestination),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketReplicationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replicate_existing_objects.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "replicate_existing_objects.0.job_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "replicate_existing_objects.0.job_status"),
+					resource.TestCheckResourceAttr(resourceName, "replicate_existing_objects.0.priority", "10"),
+					resource.TestCheckResourceAttrPair(resourceName, "replicate_existing_objects.0.role_arn", batchRoleResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"replicate_existing_objects"},
+			},
+		},
+	})
+}
+
 func TestAccS3BucketReplicationConfiguration_filter_emptyConfigurationBlock(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_s3_bucket_replication_configuration.test"
@@ -2077,6 +2111,141 @@ resource "aws_s3_bucket_replication_configuration" "test" {
 `, rName, rNameDestination)
 }
 
+func testAccBucketReplicationConfigurationConfig_replicateExistingObjects(rName, rNameDestination string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "s3.${data.aws_partition.current.dns_suffix}"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role" "batch" {
+  name = "%[1]s-batch"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "batchoperations.s3.${data.aws_partition.current.dns_suffix}"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy" "batch" {
+  role = aws_iam_role.batch.id
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": [
+        "s3:InitiateReplication",
+        "s3:GetReplicationConfiguration",
+        "s3:PutInventoryConfiguration"
+      ],
+      "Effect": "Allow",
+      "Resource": [
+        "${aws_s3_bucket.source.arn}",
+        "${aws_s3_bucket.source.arn}/*"
+      ]
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_s3_bucket" "destination" {
+  bucket = %[2]q
+}
+
+resource "aws_s3_bucket_versioning" "destination" {
+  bucket = aws_s3_bucket.destination.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket" "source" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "source" {
+  bucket = aws_s3_bucket.source.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_object" "test" {
+  depends_on = [aws_s3_bucket_versioning.source]
+
+  bucket  = aws_s3_bucket.source.id
+  key     = "testprefix/existing"
+  content = "existing object"
+}
+
+resource "aws_s3_bucket_replication_configuration" "test" {
+  depends_on = [
+    aws_iam_role_policy.batch,
+    aws_s3_bucket_versioning.source,
+    aws_s3_bucket_versioning.destination,
+    aws_s3_object.test,
+  ]
+
+  bucket = aws_s3_bucket.source.id
+  role   = aws_iam_role.test.arn
+
+  rule {
+    id     = "testid"
+    status = "Enabled"
+
+    filter {
+      prefix = "testprefix"
+    }
+
+    delete_marker_replication {
+      status = "Enabled"
+    }
+
+    destination {
+      bucket        = aws_s3_bucket.destination.arn
+      storage_class = "STANDARD"
+    }
+  }
+
+  replicate_existing_objects {
+    role_arn                    = aws_iam_role.batch.arn
+    object_replication_statuses = ["NONE", "FAILED"]
+  }
+}
+`, rName, rNameDestination)
+}
+
 func testAccBucketReplicationConfigurationConfig_filterEmptyBlock(rName string) string {
 	return acctest.ConfigCompose(
 		testAccBucketReplicationConfigurationBase(rName),
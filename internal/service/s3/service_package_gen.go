@@ -124,8 +124,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_s3_bucket_public_access_block",
 		},
 		{
-			Factory:  ResourceBucketReplicationConfiguration,
-			TypeName: "aws_s3_bucket_replication_configuration",
+			Factory:       ResourceBucketReplicationConfiguration,
+			TypeName:      "aws_s3_bucket_replication_configuration",
+			ARNAttributes: []string{"role"},
 		},
 		{
 			Factory:  ResourceBucketRequestPaymentConfiguration,
@@ -5,9 +5,14 @@ package s3
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3control"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -64,3 +69,69 @@ func waitForBucketVersioningStatus(ctx context.Context, conn *s3.S3, bucket, exp
 
 	return nil, err
 }
+
+func waitReplicationJobStarted(ctx context.Context, conn *s3control.S3Control, accountID, jobID string, timeout time.Duration) (*s3control.JobDescriptor, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{s3control.JobStatusNew, s3control.JobStatusPreparing},
+		Target:  []string{s3control.JobStatusReady, s3control.JobStatusActive, s3control.JobStatusCompleting, s3control.JobStatusComplete},
+		Refresh: replicationJobStatus(ctx, conn, accountID, jobID),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*s3control.JobDescriptor); ok {
+		tfresource.SetLastError(err, replicationJobError(output))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReplicationJobCompleted(ctx context.Context, conn *s3control.S3Control, accountID, jobID string, timeout time.Duration) (*s3control.JobDescriptor, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{s3control.JobStatusNew, s3control.JobStatusPreparing, s3control.JobStatusReady, s3control.JobStatusActive, s3control.JobStatusCompleting},
+		Target:  []string{s3control.JobStatusComplete},
+		Refresh: replicationJobStatus(ctx, conn, accountID, jobID),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*s3control.JobDescriptor); ok {
+		tfresource.SetLastError(err, replicationJobError(output))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func replicationJobError(apiObject *s3control.JobDescriptor) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	var errs []string
+
+	for _, v := range apiObject.FailureReasons {
+		if v == nil {
+			continue
+		}
+
+		errs = append(errs, fmt.Sprintf("%s: %s", aws.StringValue(v.FailureCode), aws.StringValue(v.FailureReason)))
+	}
+
+	if v := aws.StringValue(apiObject.StatusUpdateReason); v != "" {
+		errs = append(errs, v)
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(errs, "; "))
+}
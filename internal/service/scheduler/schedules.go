@@ -0,0 +1,474 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// schedulesConcurrency is the maximum number of in-flight Scheduler API calls made by aws_scheduler_schedules.
+const schedulesConcurrency = 10
+
+// @SDKResource("aws_scheduler_schedules", name="Schedules")
+func resourceSchedules() *schema.Resource {
+	// The schedule group-level defaults share their schema with aws_scheduler_schedule.
+	scheduleSchema := resourceSchedule().Schema
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSchedulesCreate,
+		ReadWithoutTimeout:   resourceSchedulesRead,
+		UpdateWithoutTimeout: resourceSchedulesUpdate,
+		DeleteWithoutTimeout: resourceSchedulesDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"flexible_time_window": scheduleSchema["flexible_time_window"],
+			"group_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 64)),
+			},
+			"kms_key_arn": scheduleSchema["kms_key_arn"],
+			"schedule": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": scheduleSchema["description"],
+						"flexible_time_window": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     scheduleSchema["flexible_time_window"].Elem,
+						},
+						"input": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: scheduleSchema["name"].ValidateDiagFunc,
+						},
+						"schedule_expression":          scheduleSchema["schedule_expression"],
+						"schedule_expression_timezone": scheduleSchema["schedule_expression_timezone"],
+						"state": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          types.ScheduleStateEnabled,
+							ValidateDiagFunc: enum.Validate[types.ScheduleState](),
+						},
+					},
+				},
+			},
+			"target": scheduleSchema["target"],
+		},
+
+		CustomizeDiff: customizeDiffSchedulesUniqueNames,
+	}
+}
+
+const (
+	ResNameSchedules = "Schedules"
+)
+
+func resourceSchedulesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SchedulerClient(ctx)
+
+	groupName := d.Get("group_name").(string)
+	tfMaps := schedulesByName(d.Get("schedule").(*schema.Set))
+
+	d.SetId(groupName)
+
+	err := forEachSchedule(ctx, mapKeys(tfMaps), func(ctx context.Context, name string) error {
+		return createScheduleItem(ctx, conn, d, tfMaps[name])
+	})
+
+	if err != nil {
+		return create.DiagError(names.Scheduler, create.ErrActionCreating, ResNameSchedules, groupName, err)
+	}
+
+	return resourceSchedulesRead(ctx, d, meta)
+}
+
+func resourceSchedulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SchedulerClient(ctx)
+
+	groupName := d.Id()
+	tfMaps := schedulesByName(d.Get("schedule").(*schema.Set))
+
+	var mu sync.Mutex
+	outputs := make(map[string]*scheduler.GetScheduleOutput, len(tfMaps))
+
+	err := forEachSchedule(ctx, mapKeys(tfMaps), func(ctx context.Context, name string) error {
+		output, err := findScheduleByTwoPartKey(ctx, conn, groupName, name)
+
+		if tfresource.NotFound(err) {
+			log.Printf("[WARN] EventBridge Scheduler Schedule (%s/%s) not found, removing from %s", groupName, name, ResNameSchedules)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading EventBridge Scheduler Schedule (%s/%s): %w", groupName, name, err)
+		}
+
+		mu.Lock()
+		outputs[name] = output
+		mu.Unlock()
+
+		return nil
+	})
+
+	if err != nil {
+		return create.DiagError(names.Scheduler, create.ErrActionReading, ResNameSchedules, d.Id(), err)
+	}
+
+	if !d.IsNewResource() && len(outputs) == 0 {
+		log.Printf("[WARN] EventBridge Scheduler Schedules (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	defaultFlexibleTimeWindow := expandFlexibleTimeWindow(d.Get("flexible_time_window").([]interface{})[0].(map[string]interface{}))
+	defaultInput := d.Get("target.0.input").(string)
+
+	// Schedule group-level defaults are refreshed from the first schedule by name so that drift is detected.
+	outputNames := mapKeys(outputs)
+	first := outputs[outputNames[0]]
+
+	d.Set("group_name", first.GroupName)
+	d.Set("kms_key_arn", first.KmsKeyArn)
+
+	if tfMap := tfMaps[outputNames[0]]; tfMap["flexible_time_window"] == nil || len(tfMap["flexible_time_window"].([]interface{})) == 0 {
+		if err := d.Set("flexible_time_window", []interface{}{flattenFlexibleTimeWindow(first.FlexibleTimeWindow)}); err != nil {
+			return create.DiagError(names.Scheduler, create.ErrActionSetting, ResNameSchedules, d.Id(), err)
+		}
+	}
+
+	target := flattenTarget(ctx, first.Target)
+	if tfMap := tfMaps[outputNames[0]]; tfMap["input"].(string) != "" {
+		target["input"] = defaultInput
+	}
+
+	if err := d.Set("target", []interface{}{target}); err != nil {
+		return create.DiagError(names.Scheduler, create.ErrActionSetting, ResNameSchedules, d.Id(), err)
+	}
+
+	tfList := make([]interface{}, 0, len(outputs))
+
+	for _, name := range outputNames {
+		tfList = append(tfList, flattenScheduleItem(outputs[name], tfMaps[name], defaultFlexibleTimeWindow, defaultInput))
+	}
+
+	if err := d.Set("schedule", tfList); err != nil {
+		return create.DiagError(names.Scheduler, create.ErrActionSetting, ResNameSchedules, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceSchedulesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SchedulerClient(ctx)
+
+	o, n := d.GetChange("schedule")
+	os, ns := schedulesByName(o.(*schema.Set)), schedulesByName(n.(*schema.Set))
+	defaultsChanged := d.HasChanges("flexible_time_window", "kms_key_arn", "target")
+
+	var del, add, upd []string
+
+	for name := range os {
+		if _, ok := ns[name]; !ok {
+			del = append(del, name)
+		}
+	}
+
+	for name, tfMap := range ns {
+		if v, ok := os[name]; !ok {
+			add = append(add, name)
+		} else if defaultsChanged || !reflect.DeepEqual(v, tfMap) {
+			upd = append(upd, name)
+		}
+	}
+
+	err := forEachSchedule(ctx, del, func(ctx context.Context, name string) error {
+		return deleteScheduleItem(ctx, conn, d.Id(), name)
+	})
+
+	if err != nil {
+		return create.DiagError(names.Scheduler, create.ErrActionUpdating, ResNameSchedules, d.Id(), err)
+	}
+
+	err = forEachSchedule(ctx, add, func(ctx context.Context, name string) error {
+		return createScheduleItem(ctx, conn, d, ns[name])
+	})
+
+	if err != nil {
+		return create.DiagError(names.Scheduler, create.ErrActionUpdating, ResNameSchedules, d.Id(), err)
+	}
+
+	err = forEachSchedule(ctx, upd, func(ctx context.Context, name string) error {
+		return updateScheduleItem(ctx, conn, d, ns[name])
+	})
+
+	if err != nil {
+		return create.DiagError(names.Scheduler, create.ErrActionUpdating, ResNameSchedules, d.Id(), err)
+	}
+
+	return resourceSchedulesRead(ctx, d, meta)
+}
+
+func resourceSchedulesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SchedulerClient(ctx)
+
+	log.Printf("[INFO] Deleting EventBridge Scheduler Schedules %s", d.Id())
+
+	tfMaps := schedulesByName(d.Get("schedule").(*schema.Set))
+
+	err := forEachSchedule(ctx, mapKeys(tfMaps), func(ctx context.Context, name string) error {
+		return deleteScheduleItem(ctx, conn, d.Id(), name)
+	})
+
+	if err != nil {
+		return create.DiagError(names.Scheduler, create.ErrActionDeleting, ResNameSchedules, d.Id(), err)
+	}
+
+	return nil
+}
+
+func customizeDiffSchedulesUniqueNames(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	seen := make(map[string]bool)
+
+	for _, tfMapRaw := range d.Get("schedule").(*schema.Set).List() {
+		name := tfMapRaw.(map[string]interface{})["name"].(string)
+
+		if name == "" {
+			continue
+		}
+
+		if seen[name] {
+			return fmt.Errorf("duplicate schedule name: %s", name)
+		}
+
+		seen[name] = true
+	}
+
+	return nil
+}
+
+func createScheduleItem(ctx context.Context, conn *scheduler.Client, d *schema.ResourceData, tfMap map[string]interface{}) error {
+	name := tfMap["name"].(string)
+
+	in := &scheduler.CreateScheduleInput{
+		FlexibleTimeWindow: expandScheduleItemFlexibleTimeWindow(d, tfMap),
+		GroupName:          aws.String(d.Get("group_name").(string)),
+		Name:               aws.String(name),
+		ScheduleExpression: aws.String(tfMap["schedule_expression"].(string)),
+		State:              types.ScheduleState(tfMap["state"].(string)),
+		Target:             expandScheduleItemTarget(ctx, d, tfMap),
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		in.Description = aws.String(v)
+	}
+
+	if v, ok := d.Get("kms_key_arn").(string); ok && v != "" {
+		in.KmsKeyArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["schedule_expression_timezone"].(string); ok && v != "" {
+		in.ScheduleExpressionTimezone = aws.String(v)
+	}
+
+	_, err := retryWhenIAMNotPropagated(ctx, func() (*scheduler.CreateScheduleOutput, error) {
+		return conn.CreateSchedule(ctx, in)
+	})
+
+	if err != nil {
+		return fmt.Errorf("creating EventBridge Scheduler Schedule (%s): %w", name, err)
+	}
+
+	return nil
+}
+
+func updateScheduleItem(ctx context.Context, conn *scheduler.Client, d *schema.ResourceData, tfMap map[string]interface{}) error {
+	name := tfMap["name"].(string)
+
+	in := &scheduler.UpdateScheduleInput{
+		FlexibleTimeWindow: expandScheduleItemFlexibleTimeWindow(d, tfMap),
+		GroupName:          aws.String(d.Id()),
+		Name:               aws.String(name),
+		ScheduleExpression: aws.String(tfMap["schedule_expression"].(string)),
+		State:              types.ScheduleState(tfMap["state"].(string)),
+		Target:             expandScheduleItemTarget(ctx, d, tfMap),
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		in.Description = aws.String(v)
+	}
+
+	if v, ok := d.Get("kms_key_arn").(string); ok && v != "" {
+		in.KmsKeyArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["schedule_expression_timezone"].(string); ok && v != "" {
+		in.ScheduleExpressionTimezone = aws.String(v)
+	}
+
+	_, err := retryWhenIAMNotPropagated(ctx, func() (*scheduler.UpdateScheduleOutput, error) {
+		return conn.UpdateSchedule(ctx, in)
+	})
+
+	if err != nil {
+		return fmt.Errorf("updating EventBridge Scheduler Schedule (%s): %w", name, err)
+	}
+
+	return nil
+}
+
+func deleteScheduleItem(ctx context.Context, conn *scheduler.Client, groupName, name string) error {
+	_, err := conn.DeleteSchedule(ctx, &scheduler.DeleteScheduleInput{
+		GroupName: aws.String(groupName),
+		Name:      aws.String(name),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting EventBridge Scheduler Schedule (%s/%s): %w", groupName, name, err)
+	}
+
+	return nil
+}
+
+// forEachSchedule calls f for each of the specified schedule names, limiting the number of concurrent calls.
+// All calls are made and their errors are combined.
+func forEachSchedule(ctx context.Context, names []string, f func(context.Context, string) error) error {
+	var (
+		errs *multierror.Error
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+	)
+	sem := make(chan struct{}, schedulesConcurrency)
+
+	for _, name := range names {
+		name := name
+
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := f(ctx, name); err != nil {
+				mu.Lock()
+				errs = multierror.Append(errs, err)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return errs.ErrorOrNil()
+}
+
+func schedulesByName(s *schema.Set) map[string]map[string]interface{} {
+	m := make(map[string]map[string]interface{}, s.Len())
+
+	for _, tfMapRaw := range s.List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		m[tfMap["name"].(string)] = tfMap
+	}
+
+	return m
+}
+
+func mapKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// expandScheduleItemFlexibleTimeWindow returns the schedule's flexible time window, or the schedule group-level default.
+func expandScheduleItemFlexibleTimeWindow(d *schema.ResourceData, tfMap map[string]interface{}) *types.FlexibleTimeWindow {
+	if v, ok := tfMap["flexible_time_window"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		return expandFlexibleTimeWindow(v[0].(map[string]interface{}))
+	}
+
+	return expandFlexibleTimeWindow(d.Get("flexible_time_window").([]interface{})[0].(map[string]interface{}))
+}
+
+// expandScheduleItemTarget returns the schedule group-level target with any schedule-specific input.
+func expandScheduleItemTarget(ctx context.Context, d *schema.ResourceData, tfMap map[string]interface{}) *types.Target {
+	target := expandTarget(ctx, d.Get("target").([]interface{})[0].(map[string]interface{}))
+
+	if v, ok := tfMap["input"].(string); ok && v != "" {
+		target.Input = aws.String(v)
+	}
+
+	return target
+}
+
+// flattenScheduleItem flattens a schedule into a `schedule` element.
+// Values matching the schedule group-level defaults are only set if they were previously configured on the schedule.
+func flattenScheduleItem(apiObject *scheduler.GetScheduleOutput, prior map[string]interface{}, defaultFlexibleTimeWindow *types.FlexibleTimeWindow, defaultInput string) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"description":                  aws.ToString(apiObject.Description),
+		"input":                        "",
+		"name":                         aws.ToString(apiObject.Name),
+		"schedule_expression":          aws.ToString(apiObject.ScheduleExpression),
+		"schedule_expression_timezone": aws.ToString(apiObject.ScheduleExpressionTimezone),
+		"state":                        string(apiObject.State),
+	}
+
+	if v, ok := prior["flexible_time_window"].([]interface{}); (ok && len(v) > 0) || !reflect.DeepEqual(apiObject.FlexibleTimeWindow, defaultFlexibleTimeWindow) {
+		tfMap["flexible_time_window"] = []interface{}{flattenFlexibleTimeWindow(apiObject.FlexibleTimeWindow)}
+	}
+
+	if apiObject.Target != nil {
+		if v := aws.ToString(apiObject.Target.Input); prior["input"].(string) != "" || v != defaultInput {
+			tfMap["input"] = v
+		}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scheduler_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfscheduler "github.com/hashicorp/terraform-provider-aws/internal/service/scheduler"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSchedulerSchedules_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedules.test"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchedulesDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchedulesConfig_basic(name, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchedulesExist(ctx, t, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "group_name", "aws_scheduler_schedule_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.0.mode", "FLEXIBLE"),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.0.maximum_window_in_minutes", "15"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "schedule.*", map[string]string{
+						"name":                         fmt.Sprintf("%s-0", name),
+						"schedule_expression":          "rate(1 hour)",
+						"schedule_expression_timezone": "UTC",
+						"state":                        "ENABLED",
+						"input":                        `{"index":0}`,
+						"flexible_time_window.#":       "0",
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.arn", "aws_sqs_queue.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				Config: testAccSchedulesConfig_basic(name, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchedulesExist(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "5"),
+				),
+			},
+			{
+				Config: testAccSchedulesConfig_basic(name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchedulesExist(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedules_flexibleTimeWindowOverride(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedules.test"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchedulesDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchedulesConfig_flexibleTimeWindowOverride(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchedulesExist(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "schedule.*", map[string]string{
+						"name":                   fmt.Sprintf("%s-default", name),
+						"flexible_time_window.#": "0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "schedule.*", map[string]string{
+						"name":                        fmt.Sprintf("%s-override", name),
+						"flexible_time_window.#":      "1",
+						"flexible_time_window.0.mode": "OFF",
+						"flexible_time_window.0.maximum_window_in_minutes": "0",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSchedulesDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(t).SchedulerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_scheduler_schedules" {
+				continue
+			}
+
+			for k, v := range rs.Primary.Attributes {
+				if !scheduleNameAttributeRegexp.MatchString(k) {
+					continue
+				}
+
+				_, err := tfscheduler.FindScheduleByTwoPartKey(ctx, conn, rs.Primary.ID, v)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("%s %s %s/%s still exists", names.Scheduler, tfscheduler.ResNameSchedule, rs.Primary.ID, v)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSchedulesExist(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No %s ID is set", tfscheduler.ResNameSchedules)
+		}
+
+		conn := acctest.ProviderMeta(t).SchedulerClient(ctx)
+
+		for k, v := range rs.Primary.Attributes {
+			if !scheduleNameAttributeRegexp.MatchString(k) {
+				continue
+			}
+
+			if _, err := tfscheduler.FindScheduleByTwoPartKey(ctx, conn, rs.Primary.ID, v); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+var scheduleNameAttributeRegexp = regexp.MustCompile(`^schedule\.\d+\.name$`)
+
+func testAccSchedulesConfig_basic(name string, count int) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
+
+resource "aws_scheduler_schedule_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_scheduler_schedules" "test" {
+  group_name = aws_scheduler_schedule_group.test.name
+
+  flexible_time_window {
+    mode                      = "FLEXIBLE"
+    maximum_window_in_minutes = 15
+  }
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+
+  dynamic "schedule" {
+    for_each = range(%[2]d)
+
+    content {
+      name                = "%[1]s-${schedule.value}"
+      schedule_expression = "rate(1 hour)"
+      input               = jsonencode({ index = schedule.value })
+    }
+  }
+}
+`, name, count),
+	)
+}
+
+func testAccSchedulesConfig_flexibleTimeWindowOverride(name string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
+
+resource "aws_scheduler_schedule_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_scheduler_schedules" "test" {
+  group_name = aws_scheduler_schedule_group.test.name
+
+  flexible_time_window {
+    mode                      = "FLEXIBLE"
+    maximum_window_in_minutes = 15
+  }
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+
+  schedule {
+    name                = "%[1]s-default"
+    schedule_expression = "rate(1 hour)"
+  }
+
+  schedule {
+    name                = "%[1]s-override"
+    schedule_expression = "rate(1 hour)"
+
+    flexible_time_window {
+      mode = "OFF"
+    }
+  }
+}
+`, name),
+	)
+}
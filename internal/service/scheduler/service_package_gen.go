@@ -40,6 +40,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  resourceSchedules,
+			TypeName: "aws_scheduler_schedules",
+			Name:     "Schedules",
+		},
 	}
 }
 
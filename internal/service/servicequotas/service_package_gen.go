@@ -42,6 +42,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceServiceQuota,
 			TypeName: "aws_servicequotas_service_quota",
 		},
+		{
+			Factory:  ResourceTemplate,
+			TypeName: "aws_servicequotas_template",
+			Name:     "Template",
+		},
+		{
+			Factory:  ResourceTemplateAssociation,
+			TypeName: "aws_servicequotas_template_association",
+			Name:     "Template Association",
+		},
 	}
 }
 
@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package shield
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_shield_proactive_engagement", name="Proactive Engagement")
+func ResourceProactiveEngagement() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProactiveEngagementCreate,
+		ReadWithoutTimeout:   resourceProactiveEngagementRead,
+		UpdateWithoutTimeout: resourceProactiveEngagementUpdate,
+		DeleteWithoutTimeout: resourceProactiveEngagementDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"emergency_contact": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"contact_notes": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"email_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 150),
+						},
+						"phone_number": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 16),
+						},
+					},
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceProactiveEngagementCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ShieldConn(ctx)
+
+	// AssociateProactiveEngagementDetails replaces any existing emergency contacts.
+	input := &shield.AssociateProactiveEngagementDetailsInput{
+		EmergencyContactList: expandEmergencyContacts(d.Get("emergency_contact").([]interface{})),
+	}
+
+	_, err := conn.AssociateProactiveEngagementDetailsWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "associating Shield Proactive Engagement details: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+
+	if err := updateProactiveEngagementStatus(ctx, conn, d.Get("enabled").(bool)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Shield Proactive Engagement (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceProactiveEngagementRead(ctx, d, meta)...)
+}
+
+func resourceProactiveEngagementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ShieldConn(ctx)
+
+	status, err := FindProactiveEngagementStatus(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Shield Proactive Engagement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Shield Proactive Engagement (%s): %s", d.Id(), err)
+	}
+
+	output, err := conn.DescribeEmergencyContactSettingsWithContext(ctx, &shield.DescribeEmergencyContactSettingsInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Shield Proactive Engagement (%s) emergency contacts: %s", d.Id(), err)
+	}
+
+	// Proactive engagement is removed by disabling it and clearing the emergency contacts.
+	if !d.IsNewResource() && status == shield.ProactiveEngagementStatusDisabled && len(output.EmergencyContactList) == 0 {
+		log.Printf("[WARN] Shield Proactive Engagement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err := d.Set("emergency_contact", flattenEmergencyContacts(output.EmergencyContactList)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting emergency_contact: %s", err)
+	}
+	d.Set("enabled", status == shield.ProactiveEngagementStatusEnabled)
+
+	return diags
+}
+
+func resourceProactiveEngagementUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ShieldConn(ctx)
+
+	if d.HasChange("emergency_contact") {
+		input := &shield.UpdateEmergencyContactSettingsInput{
+			EmergencyContactList: expandEmergencyContacts(d.Get("emergency_contact").([]interface{})),
+		}
+
+		_, err := conn.UpdateEmergencyContactSettingsWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Shield Proactive Engagement (%s) emergency contacts: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("enabled") {
+		if err := updateProactiveEngagementStatus(ctx, conn, d.Get("enabled").(bool)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Shield Proactive Engagement (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceProactiveEngagementRead(ctx, d, meta)...)
+}
+
+func resourceProactiveEngagementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ShieldConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Shield Proactive Engagement: %s", d.Id())
+	if err := updateProactiveEngagementStatus(ctx, conn, false); err != nil {
+		if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+			return diags
+		}
+
+		return sdkdiag.AppendErrorf(diags, "deleting Shield Proactive Engagement (%s): %s", d.Id(), err)
+	}
+
+	_, err := conn.UpdateEmergencyContactSettingsWithContext(ctx, &shield.UpdateEmergencyContactSettingsInput{
+		EmergencyContactList: []*shield.EmergencyContact{},
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Shield Proactive Engagement (%s) emergency contacts: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func updateProactiveEngagementStatus(ctx context.Context, conn *shield.Shield, enabled bool) error {
+	var err error
+
+	if enabled {
+		_, err = conn.EnableProactiveEngagementWithContext(ctx, &shield.EnableProactiveEngagementInput{})
+	} else {
+		_, err = conn.DisableProactiveEngagementWithContext(ctx, &shield.DisableProactiveEngagementInput{})
+	}
+
+	// The status is already the requested one.
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeInvalidOperationException) {
+		return nil
+	}
+
+	return err
+}
+
+func FindProactiveEngagementStatus(ctx context.Context, conn *shield.Shield) (string, error) {
+	input := &shield.DescribeSubscriptionInput{}
+
+	output, err := conn.DescribeSubscriptionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.Subscription == nil || output.Subscription.ProactiveEngagementStatus == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.StringValue(output.Subscription.ProactiveEngagementStatus), nil
+}
+
+func expandEmergencyContacts(tfList []interface{}) []*shield.EmergencyContact {
+	apiObjects := []*shield.EmergencyContact{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &shield.EmergencyContact{
+			EmailAddress: aws.String(tfMap["email_address"].(string)),
+		}
+
+		if v, ok := tfMap["contact_notes"].(string); ok && v != "" {
+			apiObject.ContactNotes = aws.String(v)
+		}
+
+		if v, ok := tfMap["phone_number"].(string); ok && v != "" {
+			apiObject.PhoneNumber = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenEmergencyContacts(apiObjects []*shield.EmergencyContact) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"contact_notes": aws.StringValue(apiObject.ContactNotes),
+			"email_address": aws.StringValue(apiObject.EmailAddress),
+			"phone_number":  aws.StringValue(apiObject.PhoneNumber),
+		})
+	}
+
+	return tfList
+}
@@ -26,6 +26,16 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceApplicationLayerAutomaticResponse,
+			TypeName: "aws_shield_application_layer_automatic_response",
+			Name:     "Application Layer Automatic Response",
+		},
+		{
+			Factory:  ResourceProactiveEngagement,
+			TypeName: "aws_shield_proactive_engagement",
+			Name:     "Proactive Engagement",
+		},
 		{
 			Factory:  ResourceProtection,
 			TypeName: "aws_shield_protection",
@@ -43,9 +43,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_sns_sms_preferences",
 		},
 		{
-			Factory:  ResourceTopic,
-			TypeName: "aws_sns_topic",
-			Name:     "Topic",
+			Factory:                ResourceTopic,
+			TypeName:               "aws_sns_topic",
+			Name:                   "Topic",
+			EndpointDriftAttribute: "id",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
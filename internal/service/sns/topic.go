@@ -216,6 +216,7 @@ var (
 )
 
 // @SDKResource("aws_sns_topic", name="Topic")
+// @EndpointDrift(arnAttribute="id")
 // @Tags(identifierAttribute="id")
 func ResourceTopic() *schema.Resource {
 	return &schema.Resource{
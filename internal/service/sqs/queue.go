@@ -177,6 +177,7 @@ var (
 )
 
 // @SDKResource("aws_sqs_queue", name="Queue")
+// @EndpointDrift(arnAttribute="arn")
 // @Tags(identifierAttribute="id")
 func ResourceQueue() *schema.Resource {
 	return &schema.Resource{
@@ -39,9 +39,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
 		{
-			Factory:  ResourceQueue,
-			TypeName: "aws_sqs_queue",
-			Name:     "Queue",
+			Factory:                ResourceQueue,
+			TypeName:               "aws_sqs_queue",
+			Name:                   "Queue",
+			EndpointDriftAttribute: "arn",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -59,6 +60,15 @@ func ResourceAssociation() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"calendar_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"detailed_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"instance_id": {
 				Type:       schema.TypeString,
 				ForceNew:   true,
@@ -92,6 +102,14 @@ func ResourceAssociation() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"last_execution_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_successful_execution_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"schedule_expression": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -121,6 +139,47 @@ func ResourceAssociation() *schema.Resource {
 					},
 				},
 			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_location": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accounts": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"execution_role_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"regions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"target_location_max_concurrency": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9][0-9]*|[1-9][0-9]%|[1-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+						},
+						"target_location_max_errors": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9][0-9]*|[0]|[1-9][0-9]%|[0-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+						},
+					},
+				},
+			},
 			"targets": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -178,6 +237,10 @@ func resourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta
 		associationInput.AssociationName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("calendar_names"); ok && v.(*schema.Set).Len() > 0 {
+		associationInput.CalendarNames = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("instance_id"); ok {
 		associationInput.InstanceId = aws.String(v.(string))
 	}
@@ -194,6 +257,10 @@ func resourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta
 		associationInput.Parameters = expandDocumentParameters(v.(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("target_location"); ok && len(v.([]interface{})) > 0 {
+		associationInput.TargetLocations = expandTargetLocations(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("targets"); ok {
 		associationInput.Targets = expandTargets(v.([]interface{}))
 	}
@@ -266,6 +333,7 @@ func resourceAssociationRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("arn", arn)
 	d.Set("apply_only_at_cron_interval", association.ApplyOnlyAtCronInterval)
 	d.Set("association_name", association.AssociationName)
+	d.Set("calendar_names", aws.StringValueSlice(association.CalendarNames))
 	d.Set("instance_id", association.InstanceId)
 	d.Set("name", association.Name)
 	d.Set("association_id", association.AssociationId)
@@ -275,11 +343,32 @@ func resourceAssociationRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("max_concurrency", association.MaxConcurrency)
 	d.Set("max_errors", association.MaxErrors)
 	d.Set("automation_target_parameter_name", association.AutomationTargetParameterName)
+	if association.LastExecutionDate != nil {
+		d.Set("last_execution_date", aws.TimeValue(association.LastExecutionDate).Format(time.RFC3339))
+	} else {
+		d.Set("last_execution_date", nil)
+	}
+	if association.LastSuccessfulExecutionDate != nil {
+		d.Set("last_successful_execution_date", aws.TimeValue(association.LastSuccessfulExecutionDate).Format(time.RFC3339))
+	} else {
+		d.Set("last_successful_execution_date", nil)
+	}
+	if association.Overview != nil {
+		d.Set("detailed_status", association.Overview.DetailedStatus)
+		d.Set("status", association.Overview.Status)
+	} else {
+		d.Set("detailed_status", nil)
+		d.Set("status", nil)
+	}
 
 	if err := d.Set("parameters", flattenParameters(association.Parameters)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading SSM Association (%s): %s", d.Id(), err)
 	}
 
+	if err := d.Set("target_location", flattenTargetLocations(association.TargetLocations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting target_location error: %s", err)
+	}
+
 	if err := d.Set("targets", flattenTargets(association.Targets)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting targets error: %s", err)
 	}
@@ -310,6 +399,10 @@ func resourceAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta
 		associationInput.AssociationName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("calendar_names"); ok && v.(*schema.Set).Len() > 0 {
+		associationInput.CalendarNames = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("document_version"); ok {
 		associationInput.DocumentVersion = aws.String(v.(string))
 	}
@@ -322,6 +415,10 @@ func resourceAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta
 		associationInput.Parameters = expandDocumentParameters(v.(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("target_location"); ok && len(v.([]interface{})) > 0 {
+		associationInput.TargetLocations = expandTargetLocations(v.([]interface{}))
+	}
+
 	if _, ok := d.GetOk("targets"); ok {
 		associationInput.Targets = expandTargets(d.Get("targets").([]interface{}))
 	}
@@ -434,3 +531,63 @@ func flattenAssociationOutputLocation(location *ssm.InstanceAssociationOutputLoc
 
 	return result
 }
+
+func expandTargetLocations(tfList []interface{}) []*ssm.TargetLocation {
+	var apiObjects []*ssm.TargetLocation
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ssm.TargetLocation{}
+
+		if v, ok := tfMap["accounts"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Accounts = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["execution_role_name"].(string); ok && v != "" {
+			apiObject.ExecutionRoleName = aws.String(v)
+		}
+
+		if v, ok := tfMap["regions"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Regions = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["target_location_max_concurrency"].(string); ok && v != "" {
+			apiObject.TargetLocationMaxConcurrency = aws.String(v)
+		}
+
+		if v, ok := tfMap["target_location_max_errors"].(string); ok && v != "" {
+			apiObject.TargetLocationMaxErrors = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenTargetLocations(apiObjects []*ssm.TargetLocation) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"accounts":                        aws.StringValueSlice(apiObject.Accounts),
+			"execution_role_name":             aws.StringValue(apiObject.ExecutionRoleName),
+			"regions":                         aws.StringValueSlice(apiObject.Regions),
+			"target_location_max_concurrency": aws.StringValue(apiObject.TargetLocationMaxConcurrency),
+			"target_location_max_errors":      aws.StringValue(apiObject.TargetLocationMaxErrors),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
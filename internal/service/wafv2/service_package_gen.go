@@ -33,6 +33,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceRegexPatternSet,
 			TypeName: "aws_wafv2_regex_pattern_set",
 		},
+		{
+			Factory:  DataSourceRuleCapacity,
+			TypeName: "aws_wafv2_rule_capacity",
+		},
 		{
 			Factory:  DataSourceRuleGroup,
 			TypeName: "aws_wafv2_rule_group",
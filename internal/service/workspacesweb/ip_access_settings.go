@@ -0,0 +1,284 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_workspacesweb_ip_access_settings", name="IP Access Settings")
+// @Tags(identifierAttribute="arn")
+func ResourceIPAccessSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIPAccessSettingsCreate,
+		ReadWithoutTimeout:   resourceIPAccessSettingsRead,
+		UpdateWithoutTimeout: resourceIPAccessSettingsUpdate,
+		DeleteWithoutTimeout: resourceIPAccessSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"additional_encryption_context": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associated_portal_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"customer_managed_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"ip_rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"ip_range": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceIPAccessSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn(ctx)
+
+	input := &workspacesweb.CreateIpAccessSettingsInput{
+		IpRules: expandIPRules(d.Get("ip_rule").([]interface{})),
+		Tags:    getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("additional_encryption_context"); ok && len(v.(map[string]interface{})) > 0 {
+		input.AdditionalEncryptionContext = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("customer_managed_key"); ok {
+		input.CustomerManagedKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateIpAccessSettingsWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating WorkSpaces Web IP Access Settings: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.IpAccessSettingsArn))
+
+	return append(diags, resourceIPAccessSettingsRead(ctx, d, meta)...)
+}
+
+func resourceIPAccessSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn(ctx)
+
+	ipAccessSettings, err := FindIPAccessSettingsByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web IP Access Settings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WorkSpaces Web IP Access Settings (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", ipAccessSettings.IpAccessSettingsArn)
+	d.Set("associated_portal_arns", aws.StringValueSlice(ipAccessSettings.AssociatedPortalArns))
+	d.Set("description", ipAccessSettings.Description)
+	d.Set("display_name", ipAccessSettings.DisplayName)
+	if err := d.Set("ip_rule", flattenIPRules(ipAccessSettings.IpRules)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting ip_rule: %s", err)
+	}
+
+	// The customer managed key and additional encryption context are not returned by the API.
+
+	return diags
+}
+
+func resourceIPAccessSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn(ctx)
+
+	if d.HasChanges("description", "display_name", "ip_rule") {
+		input := &workspacesweb.UpdateIpAccessSettingsInput{
+			IpAccessSettingsArn: aws.String(d.Id()),
+			IpRules:             expandIPRules(d.Get("ip_rule").([]interface{})),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("display_name"); ok {
+			input.DisplayName = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateIpAccessSettingsWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating WorkSpaces Web IP Access Settings (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceIPAccessSettingsRead(ctx, d, meta)...)
+}
+
+func resourceIPAccessSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn(ctx)
+
+	log.Printf("[DEBUG] Deleting WorkSpaces Web IP Access Settings: %s", d.Id())
+	_, err := conn.DeleteIpAccessSettingsWithContext(ctx, &workspacesweb.DeleteIpAccessSettingsInput{
+		IpAccessSettingsArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting WorkSpaces Web IP Access Settings (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindIPAccessSettingsByARN(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, arn string) (*workspacesweb.IpAccessSettings, error) {
+	input := &workspacesweb.GetIpAccessSettingsInput{
+		IpAccessSettingsArn: aws.String(arn),
+	}
+
+	output, err := conn.GetIpAccessSettingsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.IpAccessSettings == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.IpAccessSettings, nil
+}
+
+func expandIPRules(tfList []interface{}) []*workspacesweb.IpRule {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*workspacesweb.IpRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &workspacesweb.IpRule{
+			IpRange: aws.String(tfMap["ip_range"].(string)),
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenIPRules(apiObjects []*workspacesweb.IpRule) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"description": aws.StringValue(apiObject.Description),
+			"ip_range":    aws.StringValue(apiObject.IpRange),
+		})
+	}
+
+	return tfList
+}
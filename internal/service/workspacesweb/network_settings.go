@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_workspacesweb_network_settings", name="Network Settings")
+// @Tags(identifierAttribute="arn")
+func ResourceNetworkSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceNetworkSettingsCreate,
+		ReadWithoutTimeout:   resourceNetworkSettingsRead,
+		UpdateWithoutTimeout: resourceNetworkSettingsUpdate,
+		DeleteWithoutTimeout: resourceNetworkSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associated_portal_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 5,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 2,
+				MaxItems: 3,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceNetworkSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn(ctx)
+
+	input := &workspacesweb.CreateNetworkSettingsInput{
+		SecurityGroupIds: flex.ExpandStringSet(d.Get("security_group_ids").(*schema.Set)),
+		SubnetIds:        flex.ExpandStringSet(d.Get("subnet_ids").(*schema.Set)),
+		Tags:             getTagsIn(ctx),
+		VpcId:            aws.String(d.Get("vpc_id").(string)),
+	}
+
+	output, err := conn.CreateNetworkSettingsWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating WorkSpaces Web Network Settings: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.NetworkSettingsArn))
+
+	return append(diags, resourceNetworkSettingsRead(ctx, d, meta)...)
+}
+
+func resourceNetworkSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn(ctx)
+
+	networkSettings, err := FindNetworkSettingsByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web Network Settings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WorkSpaces Web Network Settings (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", networkSettings.NetworkSettingsArn)
+	d.Set("associated_portal_arns", aws.StringValueSlice(networkSettings.AssociatedPortalArns))
+	d.Set("security_group_ids", aws.StringValueSlice(networkSettings.SecurityGroupIds))
+	d.Set("subnet_ids", aws.StringValueSlice(networkSettings.SubnetIds))
+	d.Set("vpc_id", networkSettings.VpcId)
+
+	return diags
+}
+
+func resourceNetworkSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		// The VPC, subnets and security groups must be consistent with each other, so all are sent.
+		input := &workspacesweb.UpdateNetworkSettingsInput{
+			NetworkSettingsArn: aws.String(d.Id()),
+			SecurityGroupIds:   flex.ExpandStringSet(d.Get("security_group_ids").(*schema.Set)),
+			SubnetIds:          flex.ExpandStringSet(d.Get("subnet_ids").(*schema.Set)),
+			VpcId:              aws.String(d.Get("vpc_id").(string)),
+		}
+
+		_, err := conn.UpdateNetworkSettingsWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating WorkSpaces Web Network Settings (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceNetworkSettingsRead(ctx, d, meta)...)
+}
+
+func resourceNetworkSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn(ctx)
+
+	log.Printf("[DEBUG] Deleting WorkSpaces Web Network Settings: %s", d.Id())
+	_, err := conn.DeleteNetworkSettingsWithContext(ctx, &workspacesweb.DeleteNetworkSettingsInput{
+		NetworkSettingsArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting WorkSpaces Web Network Settings (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindNetworkSettingsByARN(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, arn string) (*workspacesweb.NetworkSettings, error) {
+	input := &workspacesweb.GetNetworkSettingsInput{
+		NetworkSettingsArn: aws.String(arn),
+	}
+
+	output, err := conn.GetNetworkSettingsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.NetworkSettings == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.NetworkSettings, nil
+}
@@ -0,0 +1,386 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_workspacesweb_portal", name="Portal")
+// @Tags(identifierAttribute="arn")
+func ResourcePortal() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePortalCreate,
+		ReadWithoutTimeout:   resourcePortalRead,
+		UpdateWithoutTimeout: resourcePortalUpdate,
+		DeleteWithoutTimeout: resourcePortalDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"additional_encryption_context": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authentication_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(workspacesweb.AuthenticationType_Values(), false),
+			},
+			"browser_settings_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"browser_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"customer_managed_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"ip_access_settings_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"network_settings_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"portal_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"portal_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"renderer_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"trust_store_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"user_settings_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePortalCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn(ctx)
+
+	input := &workspacesweb.CreatePortalInput{
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("additional_encryption_context"); ok && len(v.(map[string]interface{})) > 0 {
+		input.AdditionalEncryptionContext = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("authentication_type"); ok {
+		input.AuthenticationType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("customer_managed_key"); ok {
+		input.CustomerManagedKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	output, err := conn.CreatePortalWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating WorkSpaces Web Portal: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.PortalArn))
+
+	for _, v := range portalAssociations {
+		if arn := d.Get(v.attribute).(string); arn != "" {
+			if err := v.associate(ctx, conn, d.Id(), arn); err != nil {
+				return sdkdiag.AppendErrorf(diags, "associating WorkSpaces Web Portal (%s) %s (%s): %s", d.Id(), v.name, arn, err)
+			}
+		}
+	}
+
+	return append(diags, resourcePortalRead(ctx, d, meta)...)
+}
+
+func resourcePortalRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn(ctx)
+
+	portal, err := FindPortalByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web Portal (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WorkSpaces Web Portal (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", portal.PortalArn)
+	d.Set("authentication_type", portal.AuthenticationType)
+	d.Set("browser_settings_arn", portal.BrowserSettingsArn)
+	d.Set("browser_type", portal.BrowserType)
+	if portal.CreationDate != nil {
+		d.Set("creation_date", aws.TimeValue(portal.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set("creation_date", nil)
+	}
+	d.Set("display_name", portal.DisplayName)
+	d.Set("ip_access_settings_arn", portal.IpAccessSettingsArn)
+	d.Set("network_settings_arn", portal.NetworkSettingsArn)
+	d.Set("portal_endpoint", portal.PortalEndpoint)
+	d.Set("portal_status", portal.PortalStatus)
+	d.Set("renderer_type", portal.RendererType)
+	d.Set("trust_store_arn", portal.TrustStoreArn)
+	d.Set("user_settings_arn", portal.UserSettingsArn)
+
+	// The customer managed key and additional encryption context are not returned by the API.
+
+	return diags
+}
+
+func resourcePortalUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn(ctx)
+
+	if d.HasChanges("authentication_type", "display_name") {
+		input := &workspacesweb.UpdatePortalInput{
+			PortalArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("authentication_type") {
+			input.AuthenticationType = aws.String(d.Get("authentication_type").(string))
+		}
+
+		if d.HasChange("display_name") {
+			input.DisplayName = aws.String(d.Get("display_name").(string))
+		}
+
+		_, err := conn.UpdatePortalWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating WorkSpaces Web Portal (%s): %s", d.Id(), err)
+		}
+	}
+
+	for _, v := range portalAssociations {
+		if !d.HasChange(v.attribute) {
+			continue
+		}
+
+		// Associating replaces any existing association, so only an unset ARN requires a disassociation.
+		if arn := d.Get(v.attribute).(string); arn != "" {
+			if err := v.associate(ctx, conn, d.Id(), arn); err != nil {
+				return sdkdiag.AppendErrorf(diags, "associating WorkSpaces Web Portal (%s) %s (%s): %s", d.Id(), v.name, arn, err)
+			}
+		} else {
+			if err := v.disassociate(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "disassociating WorkSpaces Web Portal (%s) %s: %s", d.Id(), v.name, err)
+			}
+		}
+	}
+
+	return append(diags, resourcePortalRead(ctx, d, meta)...)
+}
+
+func resourcePortalDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn(ctx)
+
+	log.Printf("[DEBUG] Deleting WorkSpaces Web Portal: %s", d.Id())
+	_, err := conn.DeletePortalWithContext(ctx, &workspacesweb.DeletePortalInput{
+		PortalArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting WorkSpaces Web Portal (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// portalAssociation describes how a portal is associated with, and disassociated from, one kind of settings resource.
+type portalAssociation struct {
+	attribute    string
+	name         string
+	associate    func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN, arn string) error
+	disassociate func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN string) error
+}
+
+var portalAssociations = []portalAssociation{
+	{
+		attribute: "browser_settings_arn",
+		name:      "Browser Settings",
+		associate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN, arn string) error {
+			_, err := conn.AssociateBrowserSettingsWithContext(ctx, &workspacesweb.AssociateBrowserSettingsInput{
+				BrowserSettingsArn: aws.String(arn),
+				PortalArn:          aws.String(portalARN),
+			})
+			return err
+		},
+		disassociate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN string) error {
+			_, err := conn.DisassociateBrowserSettingsWithContext(ctx, &workspacesweb.DisassociateBrowserSettingsInput{
+				PortalArn: aws.String(portalARN),
+			})
+			return err
+		},
+	},
+	{
+		attribute: "ip_access_settings_arn",
+		name:      "IP Access Settings",
+		associate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN, arn string) error {
+			_, err := conn.AssociateIpAccessSettingsWithContext(ctx, &workspacesweb.AssociateIpAccessSettingsInput{
+				IpAccessSettingsArn: aws.String(arn),
+				PortalArn:           aws.String(portalARN),
+			})
+			return err
+		},
+		disassociate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN string) error {
+			_, err := conn.DisassociateIpAccessSettingsWithContext(ctx, &workspacesweb.DisassociateIpAccessSettingsInput{
+				PortalArn: aws.String(portalARN),
+			})
+			return err
+		},
+	},
+	{
+		attribute: "network_settings_arn",
+		name:      "Network Settings",
+		associate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN, arn string) error {
+			_, err := conn.AssociateNetworkSettingsWithContext(ctx, &workspacesweb.AssociateNetworkSettingsInput{
+				NetworkSettingsArn: aws.String(arn),
+				PortalArn:          aws.String(portalARN),
+			})
+			return err
+		},
+		disassociate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN string) error {
+			_, err := conn.DisassociateNetworkSettingsWithContext(ctx, &workspacesweb.DisassociateNetworkSettingsInput{
+				PortalArn: aws.String(portalARN),
+			})
+			return err
+		},
+	},
+	{
+		attribute: "trust_store_arn",
+		name:      "Trust Store",
+		associate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN, arn string) error {
+			_, err := conn.AssociateTrustStoreWithContext(ctx, &workspacesweb.AssociateTrustStoreInput{
+				PortalArn:     aws.String(portalARN),
+				TrustStoreArn: aws.String(arn),
+			})
+			return err
+		},
+		disassociate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN string) error {
+			_, err := conn.DisassociateTrustStoreWithContext(ctx, &workspacesweb.DisassociateTrustStoreInput{
+				PortalArn: aws.String(portalARN),
+			})
+			return err
+		},
+	},
+	{
+		attribute: "user_settings_arn",
+		name:      "User Settings",
+		associate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN, arn string) error {
+			_, err := conn.AssociateUserSettingsWithContext(ctx, &workspacesweb.AssociateUserSettingsInput{
+				PortalArn:       aws.String(portalARN),
+				UserSettingsArn: aws.String(arn),
+			})
+			return err
+		},
+		disassociate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN string) error {
+			_, err := conn.DisassociateUserSettingsWithContext(ctx, &workspacesweb.DisassociateUserSettingsInput{
+				PortalArn: aws.String(portalARN),
+			})
+			return err
+		},
+	},
+}
+
+func FindPortalByARN(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, arn string) (*workspacesweb.Portal, error) {
+	input := &workspacesweb.GetPortalInput{
+		PortalArn: aws.String(arn),
+	}
+
+	output, err := conn.GetPortalWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Portal == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Portal, nil
+}
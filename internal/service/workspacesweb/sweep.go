@@ -0,0 +1,328 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build sweep
+// +build sweep
+
+package workspacesweb
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_workspacesweb_browser_settings", &resource.Sweeper{
+		Name: "aws_workspacesweb_browser_settings",
+		F:    sweepBrowserSettings,
+		Dependencies: []string{
+			"aws_workspacesweb_portal",
+		},
+	})
+
+	resource.AddTestSweepers("aws_workspacesweb_ip_access_settings", &resource.Sweeper{
+		Name: "aws_workspacesweb_ip_access_settings",
+		F:    sweepIPAccessSettings,
+		Dependencies: []string{
+			"aws_workspacesweb_portal",
+		},
+	})
+
+	resource.AddTestSweepers("aws_workspacesweb_network_settings", &resource.Sweeper{
+		Name: "aws_workspacesweb_network_settings",
+		F:    sweepNetworkSettings,
+		Dependencies: []string{
+			"aws_workspacesweb_portal",
+		},
+	})
+
+	resource.AddTestSweepers("aws_workspacesweb_portal", &resource.Sweeper{
+		Name: "aws_workspacesweb_portal",
+		F:    sweepPortals,
+	})
+
+	resource.AddTestSweepers("aws_workspacesweb_trust_store", &resource.Sweeper{
+		Name: "aws_workspacesweb_trust_store",
+		F:    sweepTrustStores,
+		Dependencies: []string{
+			"aws_workspacesweb_portal",
+		},
+	})
+
+	resource.AddTestSweepers("aws_workspacesweb_user_settings", &resource.Sweeper{
+		Name: "aws_workspacesweb_user_settings",
+		F:    sweepUserSettings,
+		Dependencies: []string{
+			"aws_workspacesweb_portal",
+		},
+	})
+}
+
+func sweepBrowserSettings(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.WorkSpacesWebConn(ctx)
+	input := &workspacesweb.ListBrowserSettingsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListBrowserSettingsPagesWithContext(ctx, input, func(page *workspacesweb.ListBrowserSettingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.BrowserSettings {
+			r := ResourceBrowserSettings()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.BrowserSettingsArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping WorkSpaces Web Browser Settings sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing WorkSpaces Web Browser Settings (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WorkSpaces Web Browser Settings (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepIPAccessSettings(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.WorkSpacesWebConn(ctx)
+	input := &workspacesweb.ListIpAccessSettingsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListIpAccessSettingsPagesWithContext(ctx, input, func(page *workspacesweb.ListIpAccessSettingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.IpAccessSettings {
+			r := ResourceIPAccessSettings()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.IpAccessSettingsArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping WorkSpaces Web IP Access Settings sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing WorkSpaces Web IP Access Settings (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WorkSpaces Web IP Access Settings (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepNetworkSettings(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.WorkSpacesWebConn(ctx)
+	input := &workspacesweb.ListNetworkSettingsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListNetworkSettingsPagesWithContext(ctx, input, func(page *workspacesweb.ListNetworkSettingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.NetworkSettings {
+			r := ResourceNetworkSettings()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.NetworkSettingsArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping WorkSpaces Web Network Settings sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing WorkSpaces Web Network Settings (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WorkSpaces Web Network Settings (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepPortals(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.WorkSpacesWebConn(ctx)
+	input := &workspacesweb.ListPortalsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListPortalsPagesWithContext(ctx, input, func(page *workspacesweb.ListPortalsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Portals {
+			r := ResourcePortal()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.PortalArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping WorkSpaces Web Portal sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing WorkSpaces Web Portals (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WorkSpaces Web Portals (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepTrustStores(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.WorkSpacesWebConn(ctx)
+	input := &workspacesweb.ListTrustStoresInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListTrustStoresPagesWithContext(ctx, input, func(page *workspacesweb.ListTrustStoresOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TrustStores {
+			r := ResourceTrustStore()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.TrustStoreArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping WorkSpaces Web Trust Store sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing WorkSpaces Web Trust Stores (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WorkSpaces Web Trust Stores (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepUserSettings(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.WorkSpacesWebConn(ctx)
+	input := &workspacesweb.ListUserSettingsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListUserSettingsPagesWithContext(ctx, input, func(page *workspacesweb.ListUserSettingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.UserSettings {
+			r := ResourceUserSettings()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.UserSettingsArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping WorkSpaces Web User Settings sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing WorkSpaces Web User Settings (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WorkSpaces Web User Settings (%s): %w", region, err)
+	}
+
+	return nil
+}
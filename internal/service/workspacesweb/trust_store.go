@@ -0,0 +1,344 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_workspacesweb_trust_store", name="Trust Store")
+// @Tags(identifierAttribute="arn")
+func ResourceTrustStore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTrustStoreCreate,
+		ReadWithoutTimeout:   resourceTrustStoreRead,
+		UpdateWithoutTimeout: resourceTrustStoreUpdate,
+		DeleteWithoutTimeout: resourceTrustStoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associated_portal_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"certificate": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"issuer": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"not_valid_after": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"not_valid_before": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subject": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"thumbprint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"certificate_list": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceTrustStoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn(ctx)
+
+	input := &workspacesweb.CreateTrustStoreInput{
+		CertificateList: expandCertificates(d.Get("certificate_list").(*schema.Set).List()),
+		Tags:            getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateTrustStoreWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating WorkSpaces Web Trust Store: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.TrustStoreArn))
+
+	return append(diags, resourceTrustStoreRead(ctx, d, meta)...)
+}
+
+func resourceTrustStoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn(ctx)
+
+	trustStore, err := FindTrustStoreByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web Trust Store (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WorkSpaces Web Trust Store (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", trustStore.TrustStoreArn)
+	d.Set("associated_portal_arns", aws.StringValueSlice(trustStore.AssociatedPortalArns))
+
+	certificates, err := findTrustStoreCertificatesByARN(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WorkSpaces Web Trust Store (%s) certificates: %s", d.Id(), err)
+	}
+
+	if err := d.Set("certificate", flattenCertificateSummaries(certificates)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting certificate: %s", err)
+	}
+
+	// certificate_list is not set from the API, which only returns certificate metadata.
+
+	return diags
+}
+
+func resourceTrustStoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn(ctx)
+
+	if d.HasChange("certificate_list") {
+		o, n := d.GetChange("certificate_list")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		add, del := ns.Difference(os).List(), os.Difference(ns).List()
+
+		input := &workspacesweb.UpdateTrustStoreInput{
+			TrustStoreArn: aws.String(d.Id()),
+		}
+
+		if len(add) > 0 {
+			input.CertificatesToAdd = expandCertificates(add)
+		}
+
+		if len(del) > 0 {
+			thumbprints, err := findTrustStoreCertificateThumbprints(ctx, conn, d.Id(), expandCertificates(del))
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading WorkSpaces Web Trust Store (%s) certificates: %s", d.Id(), err)
+			}
+
+			input.CertificatesToDelete = aws.StringSlice(thumbprints)
+		}
+
+		_, err := conn.UpdateTrustStoreWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating WorkSpaces Web Trust Store (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTrustStoreRead(ctx, d, meta)...)
+}
+
+func resourceTrustStoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn(ctx)
+
+	log.Printf("[DEBUG] Deleting WorkSpaces Web Trust Store: %s", d.Id())
+	_, err := conn.DeleteTrustStoreWithContext(ctx, &workspacesweb.DeleteTrustStoreInput{
+		TrustStoreArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting WorkSpaces Web Trust Store (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindTrustStoreByARN(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, arn string) (*workspacesweb.TrustStore, error) {
+	input := &workspacesweb.GetTrustStoreInput{
+		TrustStoreArn: aws.String(arn),
+	}
+
+	output, err := conn.GetTrustStoreWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TrustStore == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TrustStore, nil
+}
+
+func findTrustStoreCertificatesByARN(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, arn string) ([]*workspacesweb.CertificateSummary, error) {
+	input := &workspacesweb.ListTrustStoreCertificatesInput{
+		TrustStoreArn: aws.String(arn),
+	}
+	var output []*workspacesweb.CertificateSummary
+
+	err := conn.ListTrustStoreCertificatesPagesWithContext(ctx, input, func(page *workspacesweb.ListTrustStoreCertificatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CertificateList {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// findTrustStoreCertificateThumbprints returns the thumbprints of the trust store's certificates
+// whose bodies match any of the specified certificates.
+func findTrustStoreCertificateThumbprints(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, arn string, certificates [][]byte) ([]string, error) {
+	summaries, err := findTrustStoreCertificatesByARN(ctx, conn, arn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var thumbprints []string
+
+	for _, summary := range summaries {
+		thumbprint := aws.StringValue(summary.Thumbprint)
+		output, err := conn.GetTrustStoreCertificateWithContext(ctx, &workspacesweb.GetTrustStoreCertificateInput{
+			Thumbprint:    aws.String(thumbprint),
+			TrustStoreArn: aws.String(arn),
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil || output.Certificate == nil {
+			continue
+		}
+
+		body := certificateDER(output.Certificate.Body)
+
+		for _, v := range certificates {
+			if bytes.Equal(body, certificateDER(v)) {
+				thumbprints = append(thumbprints, thumbprint)
+				break
+			}
+		}
+	}
+
+	return thumbprints, nil
+}
+
+// certificateDER returns the DER encoding of a PEM or DER encoded certificate.
+func certificateDER(v []byte) []byte {
+	if block, _ := pem.Decode(v); block != nil {
+		return block.Bytes
+	}
+
+	return v
+}
+
+func expandCertificates(tfList []interface{}) [][]byte {
+	var apiObjects [][]byte
+
+	for _, v := range tfList {
+		if v, ok := v.(string); ok && v != "" {
+			apiObjects = append(apiObjects, []byte(v))
+		}
+	}
+
+	return apiObjects
+}
+
+func flattenCertificateSummaries(apiObjects []*workspacesweb.CertificateSummary) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"issuer":     aws.StringValue(apiObject.Issuer),
+			"subject":    aws.StringValue(apiObject.Subject),
+			"thumbprint": aws.StringValue(apiObject.Thumbprint),
+		}
+
+		if v := apiObject.NotValidAfter; v != nil {
+			tfMap["not_valid_after"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.NotValidBefore; v != nil {
+			tfMap["not_valid_before"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
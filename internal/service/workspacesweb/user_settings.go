@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_workspacesweb_user_settings", name="User Settings")
+// @Tags(identifierAttribute="arn")
+func ResourceUserSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUserSettingsCreate,
+		ReadWithoutTimeout:   resourceUserSettingsRead,
+		UpdateWithoutTimeout: resourceUserSettingsUpdate,
+		DeleteWithoutTimeout: resourceUserSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associated_portal_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"copy_allowed": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(workspacesweb.EnabledType_Values(), false),
+			},
+			"disconnect_timeout_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 600),
+			},
+			"download_allowed": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(workspacesweb.EnabledType_Values(), false),
+			},
+			"idle_disconnect_timeout_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 60),
+			},
+			"paste_allowed": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(workspacesweb.EnabledType_Values(), false),
+			},
+			"print_allowed": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(workspacesweb.EnabledType_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"upload_allowed": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(workspacesweb.EnabledType_Values(), false),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceUserSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn(ctx)
+
+	input := &workspacesweb.CreateUserSettingsInput{
+		CopyAllowed:     aws.String(d.Get("copy_allowed").(string)),
+		DownloadAllowed: aws.String(d.Get("download_allowed").(string)),
+		PasteAllowed:    aws.String(d.Get("paste_allowed").(string)),
+		PrintAllowed:    aws.String(d.Get("print_allowed").(string)),
+		Tags:            getTagsIn(ctx),
+		UploadAllowed:   aws.String(d.Get("upload_allowed").(string)),
+	}
+
+	if v, ok := d.GetOk("disconnect_timeout_in_minutes"); ok {
+		input.DisconnectTimeoutInMinutes = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOkExists("idle_disconnect_timeout_in_minutes"); ok {
+		input.IdleDisconnectTimeoutInMinutes = aws.Int64(int64(v.(int)))
+	}
+
+	output, err := conn.CreateUserSettingsWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating WorkSpaces Web User Settings: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.UserSettingsArn))
+
+	return append(diags, resourceUserSettingsRead(ctx, d, meta)...)
+}
+
+func resourceUserSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn(ctx)
+
+	userSettings, err := FindUserSettingsByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web User Settings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WorkSpaces Web User Settings (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", userSettings.UserSettingsArn)
+	d.Set("associated_portal_arns", aws.StringValueSlice(userSettings.AssociatedPortalArns))
+	d.Set("copy_allowed", userSettings.CopyAllowed)
+	d.Set("disconnect_timeout_in_minutes", userSettings.DisconnectTimeoutInMinutes)
+	d.Set("download_allowed", userSettings.DownloadAllowed)
+	d.Set("idle_disconnect_timeout_in_minutes", userSettings.IdleDisconnectTimeoutInMinutes)
+	d.Set("paste_allowed", userSettings.PasteAllowed)
+	d.Set("print_allowed", userSettings.PrintAllowed)
+	d.Set("upload_allowed", userSettings.UploadAllowed)
+
+	return diags
+}
+
+func resourceUserSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &workspacesweb.UpdateUserSettingsInput{
+			CopyAllowed:     aws.String(d.Get("copy_allowed").(string)),
+			DownloadAllowed: aws.String(d.Get("download_allowed").(string)),
+			PasteAllowed:    aws.String(d.Get("paste_allowed").(string)),
+			PrintAllowed:    aws.String(d.Get("print_allowed").(string)),
+			UploadAllowed:   aws.String(d.Get("upload_allowed").(string)),
+			UserSettingsArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("disconnect_timeout_in_minutes") {
+			input.DisconnectTimeoutInMinutes = aws.Int64(int64(d.Get("disconnect_timeout_in_minutes").(int)))
+		}
+
+		if d.HasChange("idle_disconnect_timeout_in_minutes") {
+			input.IdleDisconnectTimeoutInMinutes = aws.Int64(int64(d.Get("idle_disconnect_timeout_in_minutes").(int)))
+		}
+
+		_, err := conn.UpdateUserSettingsWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating WorkSpaces Web User Settings (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceUserSettingsRead(ctx, d, meta)...)
+}
+
+func resourceUserSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn(ctx)
+
+	log.Printf("[DEBUG] Deleting WorkSpaces Web User Settings: %s", d.Id())
+	_, err := conn.DeleteUserSettingsWithContext(ctx, &workspacesweb.DeleteUserSettingsInput{
+		UserSettingsArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting WorkSpaces Web User Settings (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindUserSettingsByARN(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, arn string) (*workspacesweb.UserSettings, error) {
+	input := &workspacesweb.GetUserSettingsInput{
+		UserSettingsArn: aws.String(arn),
+	}
+
+	output, err := conn.GetUserSettingsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.UserSettings == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.UserSettings, nil
+}
@@ -5,6 +5,17 @@ package sweep
 
 import "context"
 
+type contextKeyType int
+
+var regionKey contextKeyType
+
 func Context(region string) context.Context {
-	return context.Background()
+	return context.WithValue(context.Background(), regionKey, region)
+}
+
+// regionFromContext returns the Region passed to Context.
+func regionFromContext(ctx context.Context) (string, bool) {
+	region, ok := ctx.Value(regionKey).(string)
+
+	return region, ok
 }
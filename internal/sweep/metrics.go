@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"path"
+	"runtime"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	metricNameDuration         = "Duration"
+	metricNameFailures         = "Failures"
+	metricNameResourcesDeleted = "ResourcesDeleted"
+
+	metricDimensionRegion  = "Region"
+	metricDimensionSweeper = "Sweeper"
+)
+
+// sweepRun summarizes a single SweepOrchestratorWithContext call.
+type sweepRun struct {
+	sweeper  string
+	region   string
+	deleted  int
+	failures int
+	duration time.Duration
+}
+
+func (r sweepRun) metricData(timestamp time.Time) []*cloudwatch.MetricDatum {
+	dimensions := []*cloudwatch.Dimension{
+		{
+			Name:  aws.String(metricDimensionRegion),
+			Value: aws.String(r.region),
+		},
+		{
+			Name:  aws.String(metricDimensionSweeper),
+			Value: aws.String(r.sweeper),
+		},
+	}
+
+	return []*cloudwatch.MetricDatum{
+		{
+			Dimensions: dimensions,
+			MetricName: aws.String(metricNameResourcesDeleted),
+			Timestamp:  aws.Time(timestamp),
+			Unit:       aws.String(cloudwatch.StandardUnitCount),
+			Value:      aws.Float64(float64(r.deleted)),
+		},
+		{
+			Dimensions: dimensions,
+			MetricName: aws.String(metricNameFailures),
+			Timestamp:  aws.Time(timestamp),
+			Unit:       aws.String(cloudwatch.StandardUnitCount),
+			Value:      aws.Float64(float64(r.failures)),
+		},
+		{
+			Dimensions: dimensions,
+			MetricName: aws.String(metricNameDuration),
+			Timestamp:  aws.Time(timestamp),
+			Unit:       aws.String(cloudwatch.StandardUnitSeconds),
+			Value:      aws.Float64(r.duration.Seconds()),
+		},
+	}
+}
+
+// publishMetrics publishes a sweep run's metrics to CloudWatch in the sweep Region.
+// Failure to publish is logged and does not fail the sweep.
+func publishMetrics(ctx context.Context, namespace string, run sweepRun) {
+	client, err := SharedRegionalSweepClient(ctx, run.region)
+
+	if err != nil {
+		tflog.Warn(ctx, "Unable to publish sweep metrics", map[string]any{
+			"err": err.Error(),
+		})
+		return
+	}
+
+	input := &cloudwatch.PutMetricDataInput{
+		MetricData: run.metricData(time.Now()),
+		Namespace:  aws.String(namespace),
+	}
+
+	if _, err := client.CloudWatchConn(ctx).PutMetricDataWithContext(ctx, input); err != nil {
+		tflog.Warn(ctx, "Unable to publish sweep metrics", map[string]any{
+			"err": err.Error(),
+		})
+	}
+}
+
+// callerName returns the package-qualified name of the function skip frames above the caller, e.g. "ec2.sweepVPCs".
+func callerName(skip int) string {
+	pc, _, _, ok := runtime.Caller(skip + 1)
+
+	if !ok {
+		return "unknown"
+	}
+
+	f := runtime.FuncForPC(pc)
+
+	if f == nil {
+		return "unknown"
+	}
+
+	return path.Base(f.Name())
+}
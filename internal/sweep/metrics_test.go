@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+func TestSweepRunMetricData(t *testing.T) {
+	t.Parallel()
+
+	run := sweepRun{
+		sweeper:  "ec2.sweepVPCs",
+		region:   "us-west-2",
+		deleted:  3,
+		failures: 1,
+		duration: 90 * time.Second,
+	}
+	timestamp := time.Now()
+
+	got := run.metricData(timestamp)
+
+	expected := map[string]float64{
+		metricNameDuration:         90,
+		metricNameFailures:         1,
+		metricNameResourcesDeleted: 3,
+	}
+
+	if len(got) != len(expected) {
+		t.Fatalf("got %d metrics, expected %d", len(got), len(expected))
+	}
+
+	for _, v := range got {
+		name := aws.StringValue(v.MetricName)
+
+		if want, ok := expected[name]; !ok {
+			t.Errorf("unexpected metric %s", name)
+		} else if value := aws.Float64Value(v.Value); value != want {
+			t.Errorf("metric %s: got %v, expected %v", name, value, want)
+		}
+
+		if !aws.TimeValue(v.Timestamp).Equal(timestamp) {
+			t.Errorf("metric %s: got timestamp %s, expected %s", name, aws.TimeValue(v.Timestamp), timestamp)
+		}
+
+		dimensions := make(map[string]string)
+		for _, d := range v.Dimensions {
+			dimensions[aws.StringValue(d.Name)] = aws.StringValue(d.Value)
+		}
+
+		if got, want := dimensions[metricDimensionRegion], run.region; got != want {
+			t.Errorf("metric %s: got Region %s, expected %s", name, got, want)
+		}
+
+		if got, want := dimensions[metricDimensionSweeper], run.sweeper; got != want {
+			t.Errorf("metric %s: got Sweeper %s, expected %s", name, got, want)
+		}
+	}
+}
+
+func TestCallerName(t *testing.T) {
+	t.Parallel()
+
+	if got, want := callerName(0), "sweep.TestCallerName"; got != want {
+		t.Errorf("got %s, expected %s", got, want)
+	}
+}
+
+func TestRegionFromContext(t *testing.T) {
+	t.Parallel()
+
+	region, ok := regionFromContext(Context("us-west-2"))
+
+	if !ok || region != "us-west-2" {
+		t.Errorf("got (%q, %t), expected (%q, true)", region, ok, "us-west-2")
+	}
+}
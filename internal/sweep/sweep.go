@@ -104,9 +104,13 @@ type Sweepable interface {
 	Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error
 }
 
+// SweepOrchestratorWithContext deletes the sweepables concurrently.
+// If TF_AWS_SWEEP_METRICS_NAMESPACE is set and ctx was returned by Context, metrics for the run are published to CloudWatch.
 func SweepOrchestratorWithContext(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
 	var g multierror.Group
 
+	start := time.Now()
+
 	for _, sweepable := range sweepables {
 		sweepable := sweepable
 
@@ -115,7 +119,26 @@ func SweepOrchestratorWithContext(ctx context.Context, sweepables []Sweepable, o
 		})
 	}
 
-	return g.Wait().ErrorOrNil()
+	errs := g.Wait()
+
+	if namespace := os.Getenv(envvar.SweepMetricsNamespace); namespace != "" {
+		if region, ok := regionFromContext(ctx); ok {
+			run := sweepRun{
+				sweeper:  callerName(1),
+				region:   region,
+				duration: time.Since(start),
+			}
+
+			if errs != nil {
+				run.failures = len(errs.Errors)
+			}
+			run.deleted = len(sweepables) - run.failures
+
+			publishMetrics(ctx, namespace, run)
+		}
+	}
+
+	return errs.ErrorOrNil()
 }
 
 // Check sweeper API call error for reasons to skip sweeping
@@ -26,6 +26,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/backup"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/batch"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/budgets"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cloud9"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
@@ -83,9 +84,11 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/internetmonitor"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ivschat"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kafkaconnect"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kendraranking"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/keyspaces"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kinesis"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kinesisanalytics"
@@ -108,7 +111,9 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/opensearchserverless"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/paymentcryptography"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/pinpointsmsvoicev2"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/pipes"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
@@ -116,6 +121,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/resiliencehub"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/resourceexplorer2"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroups"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
@@ -149,6 +155,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
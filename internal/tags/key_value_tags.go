@@ -33,6 +33,8 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+	// NormalizeKeyCase treats tag keys that differ only in case as the same key when merging.
+	NormalizeKeyCase bool
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -41,6 +43,12 @@ type IgnoreConfig struct {
 	KeyPrefixes KeyValueTags
 }
 
+// PolicyConfig contains tag requirements enforced across all resources.
+type PolicyConfig struct {
+	RequiredKeys  []string
+	ValuePatterns map[string]*regexp.Regexp
+}
+
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
 // The AWS Go SDK is split into multiple service packages, each service with
 // its own Go struct type representing a resource tag. To standardize logic
@@ -59,14 +67,32 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
+// If NormalizeKeyCase is set, keys are matched case-insensitively.
 func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
 	if dc == nil || dc.Tags == nil {
 		return tags
 	}
 
+	if dc.NormalizeKeyCase {
+		return dc.Tags.MergeCaseInsensitive(tags)
+	}
+
 	return dc.Tags.Merge(tags)
 }
 
+// ForResource returns the DefaultConfig to use for the specified resource.
+// Tag key case is always normalized for resources whose tag keys AWS treats case-insensitively.
+func (dc *DefaultConfig) ForResource(serviceName, resourceName string) *DefaultConfig {
+	if dc == nil || dc.NormalizeKeyCase || !caseInsensitiveKeys(serviceName, resourceName) {
+		return dc
+	}
+
+	return &DefaultConfig{
+		Tags:             dc.Tags,
+		NormalizeKeyCase: true,
+	}
+}
+
 // TagsEqual returns true if the given configuration's Tags
 // are equal to those passed in as an argument;
 // otherwise returns false
@@ -86,6 +112,45 @@ func (dc *DefaultConfig) TagsEqual(tags KeyValueTags) bool {
 	return dc.Tags.ContainsAll(tags)
 }
 
+// Validate returns an error describing every way in which the given tags
+// violate the policy, or nil if the tags comply.
+func (pc *PolicyConfig) Validate(tags KeyValueTags) error {
+	if pc == nil {
+		return nil
+	}
+
+	var errs []string
+
+	for _, k := range pc.RequiredKeys {
+		if _, ok := tags[k]; !ok {
+			errs = append(errs, fmt.Sprintf("missing required tag %q", k))
+		}
+	}
+
+	keys := make([]string, 0, len(pc.ValuePatterns))
+	for k := range pc.ValuePatterns {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v, ok := tags[k]
+		if !ok || v == nil {
+			continue
+		}
+
+		if re := pc.ValuePatterns[k]; !re.MatchString(v.ValueString()) {
+			errs = append(errs, fmt.Sprintf("tag %q value %q does not match pattern %q", k, v.ValueString(), re.String()))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("tag policy violated: %s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
 // IgnoreAWS returns non-AWS tag keys.
 func (tags KeyValueTags) IgnoreAWS() KeyValueTags { // nosemgrep:ci.aws-in-func-name
 	result := make(KeyValueTags)
@@ -190,6 +255,17 @@ func (tags KeyValueTags) IgnoreSystem(serviceName string) KeyValueTags {
 	}
 }
 
+// caseInsensitiveKeys returns whether AWS treats the tag keys of the specified resource case-insensitively.
+func caseInsensitiveKeys(serviceName, resourceName string) bool {
+	switch serviceName {
+	case names.IAM:
+		// IAM user and role tag keys are not case sensitive, other IAM resource tag keys are.
+		return resourceName == "Role" || resourceName == "User"
+	default:
+		return false
+	}
+}
+
 // Ignore returns non-matching tag keys.
 func (tags KeyValueTags) Ignore(ignoreTags KeyValueTags) KeyValueTags {
 	result := make(KeyValueTags)
@@ -355,6 +431,40 @@ func (tags KeyValueTags) Merge(mergeTags KeyValueTags) KeyValueTags {
 	return result
 }
 
+// MergeCaseInsensitive adds missing and updates existing tags,
+// treating tag keys that differ only in case as the same key.
+// Keys from mergeTags take precedence over existing keys. Within each set of tags
+// the key that sorts first is kept, e.g. `Name` is kept in preference to `name`.
+func (tags KeyValueTags) MergeCaseInsensitive(mergeTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+	folded := make(map[string]string)
+
+	for _, kvtags := range []KeyValueTags{tags, mergeTags} {
+		keys := kvtags.Keys()
+		sort.Strings(keys)
+
+		seen := make(map[string]bool)
+
+		for _, k := range keys {
+			f := strings.ToLower(k)
+
+			if seen[f] {
+				continue
+			}
+			seen[f] = true
+
+			if existing, ok := folded[f]; ok {
+				delete(result, existing)
+			}
+
+			folded[f] = k
+			result[k] = kvtags[k]
+		}
+	}
+
+	return result
+}
+
 // Only returns matching tag keys.
 func (tags KeyValueTags) Only(onlyTags KeyValueTags) KeyValueTags {
 	result := make(KeyValueTags)
@@ -5,6 +5,7 @@ package tags
 
 import (
 	"context"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -190,6 +191,38 @@ func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 				"key6": "value6",
 			},
 		},
+		{
+			name: "keys differing in case",
+			tags: New(ctx, map[string]string{
+				"name": "value1",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"Name": "value2",
+				}),
+			},
+			want: map[string]string{
+				"name": "value1",
+				"Name": "value2",
+			},
+		},
+		{
+			name: "keys differing in case normalized",
+			tags: New(ctx, map[string]string{
+				"name": "value1",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"Name": "value2",
+					"key2": "value2",
+				}),
+				NormalizeKeyCase: true,
+			},
+			want: map[string]string{
+				"name": "value1",
+				"key2": "value2",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -203,6 +236,92 @@ func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		serviceName   string
+		resourceName  string
+		want          bool
+	}{
+		{
+			name:          "no config",
+			defaultConfig: nil,
+			serviceName:   names.IAM,
+			resourceName:  "Role",
+		},
+		{
+			name: "case sensitive",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{"Name": "value1"}),
+			},
+			serviceName:  names.EC2,
+			resourceName: "VPC",
+		},
+		{
+			name: "case sensitive normalized",
+			defaultConfig: &DefaultConfig{
+				Tags:             New(ctx, map[string]string{"Name": "value1"}),
+				NormalizeKeyCase: true,
+			},
+			serviceName:  names.EC2,
+			resourceName: "VPC",
+			want:         true,
+		},
+		{
+			name: "IAM policy",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{"Name": "value1"}),
+			},
+			serviceName:  names.IAM,
+			resourceName: "Policy",
+		},
+		{
+			name: "IAM role",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{"Name": "value1"}),
+			},
+			serviceName:  names.IAM,
+			resourceName: "Role",
+			want:         true,
+		},
+		{
+			name: "IAM user",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{"Name": "value1"}),
+			},
+			serviceName:  names.IAM,
+			resourceName: "User",
+			want:         true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResource(testCase.serviceName, testCase.resourceName)
+
+			if testCase.defaultConfig == nil {
+				if got != nil {
+					t.Errorf("got %v, want nil", got)
+				}
+				return
+			}
+
+			if got.NormalizeKeyCase != testCase.want {
+				t.Errorf("got NormalizeKeyCase %t, want %t", got.NormalizeKeyCase, testCase.want)
+			}
+
+			testKeyValueTagsVerifyMap(t, got.Tags.Map(), testCase.defaultConfig.Tags.Map())
+		})
+	}
+}
+
 func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	t.Parallel()
 
@@ -317,6 +436,90 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	}
 }
 
+func TestPolicyConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policyConfig := &PolicyConfig{
+		RequiredKeys: []string{"CostCenter", "Owner"},
+		ValuePatterns: map[string]*regexp.Regexp{
+			"CostCenter":  regexp.MustCompile(`^[0-9]{4}$`),
+			"Environment": regexp.MustCompile(`^(dev|prod)$`),
+		},
+	}
+	testCases := []struct {
+		name         string
+		tags         KeyValueTags
+		policyConfig *PolicyConfig
+		wantErr      string
+	}{
+		{
+			name:         "no config",
+			tags:         New(ctx, map[string]string{}),
+			policyConfig: nil,
+		},
+		{
+			name: "compliant",
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "prod",
+				"Owner":       "team",
+			}),
+			policyConfig: policyConfig,
+		},
+		{
+			name: "pattern key absent",
+			tags: New(ctx, map[string]string{
+				"CostCenter": "1234",
+				"Owner":      "team",
+			}),
+			policyConfig: policyConfig,
+		},
+		{
+			name: "missing required key",
+			tags: New(ctx, map[string]string{
+				"CostCenter": "1234",
+			}),
+			policyConfig: policyConfig,
+			wantErr:      `tag policy violated: missing required tag "Owner"`,
+		},
+		{
+			name: "multiple violations",
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "abc",
+				"Environment": "test",
+			}),
+			policyConfig: policyConfig,
+			wantErr:      `tag policy violated: missing required tag "Owner"; tag "CostCenter" value "abc" does not match pattern "^[0-9]{4}$"; tag "Environment" value "test" does not match pattern "^(dev|prod)$"`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.policyConfig.Validate(testCase.tags)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.wantErr)
+			}
+
+			if got := err.Error(); got != testCase.wantErr {
+				t.Errorf("got error %q, want %q", got, testCase.wantErr)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAWS(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
@@ -1543,6 +1746,82 @@ func TestKeyValueTagsMerge(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsMergeCaseInsensitive(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name      string
+		tags      KeyValueTags
+		mergeTags KeyValueTags
+		want      map[string]string
+	}{
+		{
+			name:      "empty",
+			tags:      New(ctx, map[string]string{}),
+			mergeTags: New(ctx, map[string]string{}),
+			want:      map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			mergeTags: New(ctx, map[string]string{
+				"key1": "value1updated",
+				"key3": "value3",
+			}),
+			want: map[string]string{
+				"key1": "value1updated",
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "merge tag key case wins",
+			tags: New(ctx, map[string]string{
+				"Name":  "value1",
+				"Owner": "value2",
+			}),
+			mergeTags: New(ctx, map[string]string{
+				"name": "value1updated",
+			}),
+			want: map[string]string{
+				"name":  "value1updated",
+				"Owner": "value2",
+			},
+		},
+		{
+			name: "dedupe within tags",
+			tags: New(ctx, map[string]string{
+				"name": "value1",
+				"Name": "value2",
+				"NAME": "value3",
+			}),
+			mergeTags: New(ctx, map[string]string{
+				"owner": "value4",
+				"Owner": "value5",
+			}),
+			want: map[string]string{
+				"NAME":  "value3",
+				"Owner": "value5",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.tags.MergeCaseInsensitive(testCase.mergeTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsOnly(t *testing.T) {
 	t.Parallel()
 
@@ -36,17 +36,22 @@ type ServicePackageFrameworkResource struct {
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
 // implemented by a service package.
 type ServicePackageSDKDataSource struct {
-	Factory  func() *schema.Resource
-	TypeName string
-	Name     string
-	Tags     *ServicePackageResourceTags
+	Factory    func() *schema.Resource
+	TypeName   string
+	Name       string
+	Tags       *ServicePackageResourceTags
+	Partitions []string // The AWS partitions in which the data source is available. Empty means all partitions
 }
 
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
 // implemented by a service package.
 type ServicePackageSDKResource struct {
-	Factory  func() *schema.Resource
-	TypeName string
-	Name     string
-	Tags     *ServicePackageResourceTags
+	Factory                func() *schema.Resource
+	TypeName               string
+	Name                   string
+	Tags                   *ServicePackageResourceTags
+	NotFoundOperation      string   // The AWS API operation reading the resource. A NotFound error from it removes the resource from state when Read fails
+	EndpointDriftAttribute string   // The attribute ("id" for the resource ID) holding the resource's own ARN. If set, the resource is kept in state when Read removes it but the ARN's partition or account differs from the provider's
+	Partitions             []string // The AWS partitions in which the resource is available. Empty means all partitions
+	ARNAttributes          []string // Top-level attributes whose ARN values must be in the AWS partition the provider is configured for
 }
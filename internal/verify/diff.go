@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -27,6 +29,11 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
+	// Use any resource-specific default tags configuration.
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		defaultTagsConfig = inContext.DefaultConfig
+	}
+
 	resourceTags := tftags.New(ctx, diff.Get("tags").(map[string]interface{}))
 
 	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
@@ -159,3 +166,55 @@ func DiffStringMaps(oldMap, newMap map[string]interface{}) (map[string]*string,
 
 	return add, remove, unchanged
 }
+
+// ARNPartitionDiff returns a CustomizeDiff function that fails the plan if the value of any of the
+// specified top-level attributes is an ARN in an AWS partition other than the one the provider is configured for.
+// String attributes and lists and sets of strings are checked. Values that are unknown or are not ARNs are ignored.
+func ARNPartitionDiff(attributes ...string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		c, ok := meta.(*conns.AWSClient)
+
+		if !ok || c.Partition == "" {
+			return nil
+		}
+
+		var errs *multierror.Error
+		check := ValidARNPartition(c.Partition)
+
+		for _, k := range attributes {
+			if !diff.NewValueKnown(k) {
+				continue
+			}
+
+			var values []interface{}
+
+			switch v := diff.Get(k).(type) {
+			case string:
+				values = []interface{}{v}
+			case []interface{}:
+				values = v
+			case *schema.Set:
+				values = v.List()
+			}
+
+			for _, v := range values {
+				v, ok := v.(string)
+
+				if !ok {
+					continue
+				}
+
+				parsedARN, err := arn.Parse(v)
+
+				if err != nil {
+					continue
+				}
+
+				_, es := check(v, k, parsedARN)
+				errs = multierror.Append(errs, es...)
+			}
+		}
+
+		return errs.ErrorOrNil()
+	}
+}
@@ -123,6 +123,20 @@ func ValidARNCheck(f ...ARNCheckFunc) schema.SchemaValidateFunc {
 	}
 }
 
+// ValidARNPartition returns an ARNCheckFunc that validates that a parsed ARN is in the specified AWS partition.
+// An empty partition matches any ARN.
+func ValidARNPartition(partition string) ARNCheckFunc {
+	return func(v any, k string, parsedARN arn.ARN) (ws []string, errors []error) {
+		if partition == "" || parsedARN.Partition == partition {
+			return ws, errors
+		}
+
+		errors = append(errors, fmt.Errorf("%q (%s) is an ARN in AWS partition %q, but the provider is configured for AWS partition %q", k, v, parsedARN.Partition, partition))
+
+		return ws, errors
+	}
+}
+
 func ValidAccountID(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
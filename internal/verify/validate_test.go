@@ -223,6 +223,41 @@ func TestValidARN(t *testing.T) {
 	}
 }
 
+func TestValidARNPartition(t *testing.T) {
+	t.Parallel()
+
+	f := ValidARNCheck(ValidARNPartition("aws-us-gov"))
+
+	validNames := []string{
+		"",
+		"arn:aws-us-gov:ec2:us-gov-west-1:123456789012:instance/i-12345678", // lintignore:AWSAT003,AWSAT005 // GovCloud EC2 ARN
+		"arn:aws-us-gov:iam::123456789012:role/example",                     // lintignore:AWSAT005          // GovCloud IAM role
+	}
+	for _, v := range validNames {
+		_, errors := f(v, "arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid ARN in partition aws-us-gov: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"arn:aws:iam::123456789012:role/example",     // lintignore:AWSAT005 // Commercial IAM role
+		"arn:aws-cn:s3:::bucket/object",              // lintignore:AWSAT005 // China S3 ARN
+		"arn:aws-iso:iam::123456789012:role/example", // lintignore:AWSAT005 // C2S IAM role
+	}
+	for _, v := range invalidNames {
+		_, errors := f(v, "arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid ARN in partition aws-us-gov", v)
+		}
+	}
+
+	_, errors := ValidARNCheck(ValidARNPartition(""))("arn:aws-cn:s3:::bucket/object", "arn") // lintignore:AWSAT005
+	if len(errors) != 0 {
+		t.Fatalf("an empty partition should match any ARN: %q", errors)
+	}
+}
+
 func TestValidCIDRNetworkAddress(t *testing.T) {
 	t.Parallel()
 
@@ -162,6 +162,7 @@ const (
 	Kafka                        = "kafka"
 	KafkaConnect                 = "kafkaconnect"
 	Kendra                       = "kendra"
+	KendraRanking                = "kendraranking"
 	Keyspaces                    = "keyspaces"
 	Kinesis                      = "kinesis"
 	KinesisAnalytics             = "kinesisanalytics"
@@ -222,12 +223,14 @@ const (
 	Outposts                     = "outposts"
 	PI                           = "pi"
 	Panorama                     = "panorama"
+	PaymentCryptography          = "paymentcryptography"
 	Personalize                  = "personalize"
 	PersonalizeEvents            = "personalizeevents"
 	PersonalizeRuntime           = "personalizeruntime"
 	Pinpoint                     = "pinpoint"
 	PinpointEmail                = "pinpointemail"
 	PinpointSMSVoice             = "pinpointsmsvoice"
+	PinpointSMSVoiceV2           = "pinpointsmsvoicev2"
 	Pipes                        = "pipes"
 	Polly                        = "polly"
 	Pricing                      = "pricing"